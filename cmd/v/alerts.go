@@ -0,0 +1,101 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/go-faster/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/go-faster/vega/internal/cli"
+	"github.com/go-faster/vega/internal/oas"
+)
+
+// alertSummary returns human-readable alert description.
+func alertSummary(r oas.AlertRule) string {
+	for _, annotations := range []oas.LabelMap{firstAlertAnnotations(r), r.Annotations} {
+		for _, key := range []string{"summary", "description", "message"} {
+			if v := annotations[key]; v != "" {
+				return strings.Join(strings.Fields(v), " ")
+			}
+		}
+	}
+	return ""
+}
+
+func firstAlertAnnotations(r oas.AlertRule) oas.LabelMap {
+	if len(r.Alerts) == 0 {
+		return nil
+	}
+	return r.Alerts[0].Annotations
+}
+
+func alertsTable(rules []oas.AlertRule) cli.Table {
+	t := cli.Table{
+		Columns: []cli.Column{
+			{Name: "STATE"},
+			{Name: "NAME"},
+			{Name: "SEVERITY"},
+			{Name: "ALERTS"},
+			{Name: "SINCE"},
+			{Name: "GROUP", Wide: true},
+			{Name: "SUMMARY"},
+		},
+	}
+	for _, r := range rules {
+		var since time.Time
+		for _, a := range r.Alerts {
+			if v, ok := a.Since.Get(); ok && (since.IsZero() || v.Before(since)) {
+				since = v
+			}
+		}
+		var sinceText string
+		if !since.IsZero() {
+			sinceText = humanize.Time(since)
+		}
+		t.Rows = append(t.Rows, []string{
+			string(r.State),
+			r.Name,
+			r.Labels["severity"],
+			strconv.Itoa(len(r.Alerts)),
+			sinceText,
+			r.Group,
+			alertSummary(r),
+		})
+	}
+	return t
+}
+
+func newAlertsCmd(a *Application) *cobra.Command {
+	var arg struct {
+		Active bool
+	}
+	cmd := &cobra.Command{
+		Use:   "alerts <app>",
+		Short: "Show alerting rules and active alerts of an application",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			res, err := a.client.GetApplicationAlerts(ctx, oas.GetApplicationAlertsParams{
+				Name: args[0],
+			})
+			if err != nil {
+				return errors.Wrap(err, "GetApplicationAlerts")
+			}
+			if arg.Active {
+				var active []oas.AlertRule
+				for _, r := range res.Rules {
+					if r.State != oas.AlertRuleStateInactive {
+						active = append(active, r)
+					}
+				}
+				res.Rules = active
+			}
+			return a.print(cmd, res, alertsTable(res.Rules))
+		},
+	}
+	cmd.Flags().BoolVar(&arg.Active, "active", false, "Show only firing and pending rules")
+	return cmd
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/go-faster/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/go-faster/vega/internal/cli"
+	"github.com/go-faster/vega/internal/oas"
+)
+
+// shortImage shortens digest of image id.
+func shortImage(image string) string {
+	const digest = "sha256:"
+	i := strings.Index(image, digest)
+	if i < 0 || len(image) <= i+len(digest)+12 {
+		return image
+	}
+	return image[:i+len(digest)+12]
+}
+
+func imagesTable(images []oas.ImageBaseline) cli.Table {
+	t := cli.Table{
+		Columns: []cli.Column{
+			{Name: "IMAGE"},
+			{Name: "STATUS"},
+			{Name: "BINARIES"},
+			{Name: "PAIRS"},
+			{Name: "TRAINED UNTIL"},
+			{Name: "FIRST SEEN", Wide: true},
+		},
+	}
+	for _, img := range images {
+		status := "trained"
+		if img.Training {
+			status = "training"
+		}
+		t.Rows = append(t.Rows, []string{
+			shortImage(img.Image),
+			status,
+			strconv.Itoa(img.Binaries),
+			strconv.Itoa(img.Pairs),
+			humanize.Time(img.TrainedUntil),
+			humanize.Time(img.FirstSeen),
+		})
+	}
+	return t
+}
+
+func anomaliesTable(anomalies []oas.Anomaly) cli.Table {
+	t := cli.Table{
+		Columns: []cli.Column{
+			{Name: "KIND"},
+			{Name: "BINARY"},
+			{Name: "PARENT"},
+			{Name: "COUNT"},
+			{Name: "POD"},
+			{Name: "LAST SEEN"},
+			{Name: "IMAGE", Wide: true},
+			{Name: "ARGS", Wide: true},
+		},
+	}
+	for _, v := range anomalies {
+		t.Rows = append(t.Rows, []string{
+			string(v.Kind),
+			v.Binary,
+			v.ParentBinary.Or("<none>"),
+			strconv.FormatInt(v.Count, 10),
+			v.Pod,
+			humanize.Time(v.LastSeen),
+			shortImage(v.Image),
+			v.Arguments,
+		})
+	}
+	return t
+}
+
+func (a *Application) printAnomalies(w io.Writer, res *oas.AnomalyReport) error {
+	sections := []struct {
+		Name  string
+		Empty bool
+		Table cli.Table
+	}{
+		{"Images", len(res.Images) == 0, imagesTable(res.Images)},
+		{"Anomalies", len(res.Anomalies) == 0, anomaliesTable(res.Anomalies)},
+	}
+	for i, s := range sections {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintf(w, "%s:\n", s.Name)
+		if s.Empty {
+			fmt.Fprintln(w, "  <none>")
+			continue
+		}
+		if err := a.printer.Print(w, nil, s.Table); err != nil {
+			return errors.Wrap(err, "print")
+		}
+	}
+	return nil
+}
+
+func newAnomaliesCmd(a *Application) *cobra.Command {
+	var arg struct {
+		Window   time.Duration
+		Training time.Duration
+	}
+	cmd := &cobra.Command{
+		Use:   "anomalies <app>",
+		Short: "Show execs outside of container image baseline",
+		Long: `Show process execs of an application that are outside of baseline of
+container image, grouped by binary and parent binary.
+
+Baseline of image consists of binaries and parent-to-child binary pairs
+executed during training window, that starts with the first exec in image.
+Binary that was not executed during training is reported as "binary"
+anomaly, and known binary executed by another parent as "parent" anomaly:
+
+  v anomalies api --training 24h`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			if arg.Window < time.Minute || arg.Window > 24*time.Hour {
+				return errors.Errorf("window %s should be between 1m and 24h", arg.Window)
+			}
+			if arg.Training < time.Minute || arg.Training > 30*24*time.Hour {
+				return errors.Errorf("training %s should be between 1m and 720h", arg.Training)
+			}
+			res, err := a.client.GetApplicationAnomalies(ctx, oas.GetApplicationAnomaliesParams{
+				Name:     args[0],
+				Window:   oas.NewOptInt(int(arg.Window.Seconds())),
+				Training: oas.NewOptInt(int(arg.Training.Seconds())),
+			})
+			if err != nil {
+				return errors.Wrap(err, "GetApplicationAnomalies")
+			}
+			if a.printer.Structured() {
+				return a.print(cmd, res, cli.Table{})
+			}
+			return a.printAnomalies(cmd.OutOrStdout(), res)
+		},
+	}
+	cmd.Flags().DurationVar(&arg.Window, "window", time.Hour, "Window of checked execs")
+	cmd.Flags().DurationVar(&arg.Training, "training", time.Hour, "Training window of image baseline")
+	return cmd
+}
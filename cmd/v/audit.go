@@ -0,0 +1,101 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/go-faster/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/go-faster/vega/internal/cli"
+	"github.com/go-faster/vega/internal/oas"
+)
+
+func auditTable(changes []oas.AuditChange) cli.Table {
+	t := cli.Table{
+		Columns: []cli.Column{
+			{Name: "STATUS"},
+			{Name: "DIRECTION"},
+			{Name: "PEER"},
+			{Name: "PORT"},
+			{Name: "POLICY"},
+			{Name: "COUNT"},
+			{Name: "BASELINE"},
+			{Name: "LAST SEEN"},
+			{Name: "LABELS", Wide: true},
+		},
+	}
+	for _, c := range changes {
+		d := c.Flows
+		t.Rows = append(t.Rows, []string{
+			string(c.Status),
+			string(d.Direction),
+			d.Peer,
+			fmt.Sprintf("%d/%s", d.Port, d.Protocol),
+			d.Policy.Or("<default>"),
+			strconv.FormatInt(d.Count, 10),
+			strconv.FormatInt(c.BaselineCount, 10),
+			humanize.Time(d.LastSeen),
+			strings.Join(d.PeerLabels, ","),
+		})
+	}
+	return t
+}
+
+func newAuditCmd(a *Application) *cobra.Command {
+	var arg struct {
+		Window         time.Duration
+		BaselineOffset time.Duration
+		Changes        bool
+	}
+	cmd := &cobra.Command{
+		Use:   "audit <app>",
+		Short: "Show flows that policy in audit mode would drop",
+		Long: `Show flows of an application with AUDIT verdict, i.e. flows that would be
+dropped once policy is enforced, grouped by direction, peer identity, port
+and policy.
+
+Groups are compared with baseline window of the same length, so flows that
+appeared (new) or disappeared (gone) after policy change are visible:
+
+  v audit api --window 30m --baseline-offset 2h --changes`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			if arg.Window < time.Minute {
+				return errors.Errorf("window %s is less than 1m", arg.Window)
+			}
+			params := oas.GetApplicationAuditParams{
+				Name:   args[0],
+				Window: oas.NewOptInt(int(arg.Window.Seconds())),
+			}
+			if arg.BaselineOffset != 0 {
+				if arg.BaselineOffset < time.Minute {
+					return errors.Errorf("baseline offset %s is less than 1m", arg.BaselineOffset)
+				}
+				params.BaselineOffset = oas.NewOptInt(int(arg.BaselineOffset.Seconds()))
+			}
+			res, err := a.client.GetApplicationAudit(ctx, params)
+			if err != nil {
+				return errors.Wrap(err, "GetApplicationAudit")
+			}
+			if arg.Changes {
+				changes := res.Changes[:0]
+				for _, c := range res.Changes {
+					if c.Status != oas.AuditChangeStatusSame {
+						changes = append(changes, c)
+					}
+				}
+				res.Changes = changes
+			}
+			return a.print(cmd, res, auditTable(res.Changes))
+		},
+	}
+	cmd.Flags().DurationVar(&arg.Window, "window", time.Hour, "Window of audited flows")
+	cmd.Flags().DurationVar(&arg.BaselineOffset, "baseline-offset", 0, "End of baseline window before now, equal to --window by default")
+	cmd.Flags().BoolVar(&arg.Changes, "changes", false, "Show only new and gone flows")
+	return cmd
+}
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/go-faster/vega/internal/cli"
+)
+
+func newConfigCmd(a *Application) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Manage contexts in config file",
+		Long:  "Manage contexts in config file.\n\nConfig path can be overridden by VEGA_CONFIG, URL by VEGA_URL.",
+	}
+	cmd.AddCommand(
+		newConfigGetContextsCmd(a),
+		newConfigCurrentContextCmd(a),
+		newConfigUseContextCmd(a),
+		newConfigSetContextCmd(a),
+		newConfigDeleteContextCmd(a),
+	)
+	return cmd
+}
+
+func newConfigGetContextsCmd(a *Application) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get-contexts",
+		Short: "List contexts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			type contextInfo struct {
+				Name    string `json:"name"`
+				URL     string `json:"url"`
+				Current bool   `json:"current"`
+				Token   bool   `json:"token"`
+			}
+			var (
+				list []contextInfo
+				t    = cli.Table{
+					Columns: []cli.Column{
+						{Name: "CURRENT"},
+						{Name: "NAME"},
+						{Name: "URL"},
+						{Name: "TOKEN", Wide: true},
+					},
+				}
+			)
+			for name, c := range a.config.Contexts {
+				list = append(list, contextInfo{
+					Name:    name,
+					URL:     c.URL,
+					Current: name == a.config.CurrentContext,
+					// Never print token itself.
+					Token: c.Token != nil && c.Token.AccessToken != "",
+				})
+			}
+			slices.SortFunc(list, func(a, b contextInfo) int {
+				return strings.Compare(a.Name, b.Name)
+			})
+			for _, c := range list {
+				var current, token string
+				if c.Current {
+					current = "*"
+				}
+				if c.Token {
+					token = "yes"
+				}
+				t.Rows = append(t.Rows, []string{current, c.Name, c.URL, token})
+			}
+			return a.print(cmd, list, t)
+		},
+	}
+}
+
+func newConfigCurrentContextCmd(a *Application) *cobra.Command {
+	return &cobra.Command{
+		Use:   "current-context",
+		Short: "Print current context",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if a.config.CurrentContext == "" {
+				return errors.New("current context is not set")
+			}
+			cmd.Println(a.config.CurrentContext)
+			return nil
+		},
+	}
+}
+
+func newConfigUseContextCmd(a *Application) *cobra.Command {
+	return &cobra.Command{
+		Use:   "use-context <name>",
+		Short: "Set current context",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			if _, ok := a.config.Contexts[name]; !ok {
+				return errors.Errorf("context %q not found", name)
+			}
+			a.config.CurrentContext = name
+			if err := a.config.Save(a.configPath); err != nil {
+				return errors.Wrap(err, "save config")
+			}
+			cmd.Printf("Switched to context %q\n", name)
+			return nil
+		},
+	}
+}
+
+func newConfigSetContextCmd(a *Application) *cobra.Command {
+	var arg struct {
+		URL   string
+		Token string
+	}
+	cmd := &cobra.Command{
+		Use:   "set-context <name>",
+		Short: "Create or update context",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			c, ok := a.config.Contexts[name]
+			if !ok {
+				c = &cli.Context{}
+				a.config.Contexts[name] = c
+			}
+			if cmd.Flags().Changed("url") {
+				c.URL = arg.URL
+			}
+			if cmd.Flags().Changed("token") {
+				c.Token = nil
+				if arg.Token != "" {
+					c.Token = &cli.Token{
+						AccessToken: arg.Token,
+						ObtainedAt:  time.Now(),
+					}
+				}
+			}
+			if a.config.CurrentContext == "" {
+				a.config.CurrentContext = name
+			}
+			if err := a.config.Save(a.configPath); err != nil {
+				return errors.Wrap(err, "save config")
+			}
+			if ok {
+				cmd.Printf("Context %q modified\n", name)
+			} else {
+				cmd.Printf("Context %q created\n", name)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&arg.URL, "url", "", "Vega API URL")
+	cmd.Flags().StringVar(&arg.Token, "token", "", "Bearer token, empty value removes token")
+	return cmd
+}
+
+func newConfigDeleteContextCmd(a *Application) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete-context <name>",
+		Short: "Delete context",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			if _, ok := a.config.Contexts[name]; !ok {
+				return errors.Errorf("context %q not found", name)
+			}
+			delete(a.config.Contexts, name)
+			if a.config.CurrentContext == name {
+				a.config.CurrentContext = ""
+			}
+			if err := a.config.Save(a.configPath); err != nil {
+				return errors.Wrap(err, "save config")
+			}
+			cmd.Printf("Context %q deleted\n", name)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,218 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+	"github.com/go-faster/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/go-faster/vega/internal/cli"
+	"github.com/go-faster/vega/internal/oas"
+)
+
+// terminationText returns short description of container termination,
+// like "exit 137 OOMKilled".
+func terminationText(t oas.ContainerTermination) string {
+	parts := []string{"exit " + strconv.Itoa(int(t.ExitCode))}
+	if v, ok := t.Signal.Get(); ok {
+		parts = append(parts, "signal "+strconv.Itoa(int(v)))
+	}
+	if v, ok := t.Reason.Get(); ok {
+		parts = append(parts, v)
+	}
+	return strings.Join(parts, " ")
+}
+
+// diagnoseContainer returns problems of single container.
+func diagnoseContainer(pod oas.Pod, c oas.ContainerStatus) []string {
+	var (
+		out   []string
+		where = fmt.Sprintf("container %s of pod %s", c.Name, pod.Name)
+	)
+	switch c.State {
+	case oas.ContainerStatusStateWaiting:
+		reason, ok := c.Reason.Get()
+		if !ok || reason == "ContainerCreating" || reason == "PodInitializing" {
+			break
+		}
+		msg := reason + " in " + where
+		if t, ok := c.LastTermination.Get(); ok {
+			msg += ": " + terminationText(t)
+		} else if v, ok := c.Message.Get(); ok {
+			msg += ": " + v
+		}
+		out = append(out, msg)
+	case oas.ContainerStatusStateTerminated:
+		t := c.Terminated.Value
+		if t.ExitCode == 0 {
+			break
+		}
+		out = append(out, fmt.Sprintf("Terminated %s: %s", where, terminationText(t)))
+	case oas.ContainerStatusStateRunning:
+		if t, ok := c.LastTermination.Get(); ok && c.OomKilled {
+			out = append(out, fmt.Sprintf("%s was OOMKilled, last run: %s", where, terminationText(t)))
+		}
+	}
+	if c.Restarts > 0 && len(out) == 0 {
+		msg := fmt.Sprintf("%s restarted %d times", where, c.Restarts)
+		if t, ok := c.LastTermination.Get(); ok {
+			msg += ", last: " + terminationText(t)
+		}
+		out = append(out, msg)
+	}
+	return out
+}
+
+// diagnose returns human-readable list of application problems.
+func diagnose(app *oas.ApplicationSummary) []string {
+	var out []string
+	for _, pod := range app.Pods {
+		if v, ok := pod.Reason.Get(); ok {
+			out = append(out, fmt.Sprintf("Pod %s is %s: %s", pod.Name, pod.Status, v))
+		}
+		var containers []string
+		for _, c := range pod.Containers {
+			containers = append(containers, diagnoseContainer(pod, c)...)
+		}
+		if len(containers) == 0 && (pod.Status == "Pending" || pod.Status == "Failed") && !pod.Reason.Set {
+			out = append(out, fmt.Sprintf("Pod %s is %s", pod.Name, pod.Status))
+		}
+		out = append(out, containers...)
+	}
+	seen := map[string]struct{}{}
+	for _, e := range app.Events {
+		if e.Type != "Warning" {
+			continue
+		}
+		key := e.Object + "/" + e.Reason
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		out = append(out, fmt.Sprintf("%s %s: %s", e.Reason, e.Object, e.Message))
+	}
+	return out
+}
+
+func containersTable(pods []oas.Pod) cli.Table {
+	t := cli.Table{
+		Columns: []cli.Column{
+			{Name: "POD"},
+			{Name: "CONTAINER"},
+			{Name: "READY"},
+			{Name: "STATE"},
+			{Name: "REASON"},
+			{Name: "RESTARTS"},
+			{Name: "LAST TERMINATION"},
+			{Name: "STARTED", Wide: true},
+		},
+	}
+	for _, pod := range pods {
+		for _, c := range pod.Containers {
+			name := c.Name
+			if c.Init {
+				name += " (init)"
+			}
+			var last, started string
+			if v, ok := c.LastTermination.Get(); ok {
+				last = terminationText(v)
+			}
+			if v, ok := c.StartedAt.Get(); ok {
+				started = humanize.Time(v)
+			}
+			t.Rows = append(t.Rows, []string{
+				pod.Name,
+				name,
+				strconv.FormatBool(c.Ready),
+				string(c.State),
+				c.Reason.Or(""),
+				strconv.Itoa(c.Restarts),
+				last,
+				started,
+			})
+		}
+	}
+	return t
+}
+
+func eventsTable(events []oas.KubeEvent) cli.Table {
+	t := cli.Table{
+		Columns: []cli.Column{
+			{Name: "LAST SEEN"},
+			{Name: "TYPE"},
+			{Name: "REASON"},
+			{Name: "OBJECT"},
+			{Name: "COUNT", Wide: true},
+			{Name: "MESSAGE"},
+		},
+	}
+	for _, e := range events {
+		t.Rows = append(t.Rows, []string{
+			humanize.Time(e.Time),
+			e.Type,
+			e.Reason,
+			e.Object,
+			strconv.Itoa(e.Count),
+			strings.Join(strings.Fields(e.Message), " "),
+		})
+	}
+	return t
+}
+
+func (a *Application) describe(w io.Writer, app *oas.ApplicationSummary) error {
+	_, _ = fmt.Fprintf(w, "Name:      %s\nNamespace: %s\n", app.Name, app.Namespace)
+	sections := []struct {
+		Name  string
+		Empty bool
+		Table cli.Table
+	}{
+		{"Pods", len(app.Pods) == 0, podsTable(app.Pods)},
+		{"Containers", len(app.Pods) == 0, containersTable(app.Pods)},
+		{"Events", len(app.Events) == 0, eventsTable(app.Events)},
+	}
+	for _, s := range sections {
+		_, _ = fmt.Fprintf(w, "\n%s:\n", s.Name)
+		if s.Empty {
+			_, _ = fmt.Fprintln(w, "  <none>")
+			continue
+		}
+		if err := a.printer.Print(w, nil, s.Table); err != nil {
+			return errors.Wrap(err, "print")
+		}
+	}
+	_, _ = fmt.Fprintln(w, "\nDiagnosis:")
+	problems := diagnose(app)
+	if len(problems) == 0 {
+		_, _ = fmt.Fprintln(w, "  No problems found")
+	}
+	for _, p := range problems {
+		_, _ = fmt.Fprintf(w, "  - %s\n", p)
+	}
+	return nil
+}
+
+func newDescribeCmd(a *Application) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "describe <app>",
+		Short: "Show application pods, containers, events and diagnosis",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			app, err := a.client.GetApplication(ctx, oas.GetApplicationParams{
+				Name: args[0],
+			})
+			if err != nil {
+				return errors.Wrap(err, "GetApplication")
+			}
+			if a.printer.Structured() {
+				return a.print(cmd, app, cli.Table{})
+			}
+			return a.describe(cmd.OutOrStdout(), app)
+		},
+	}
+	return cmd
+}
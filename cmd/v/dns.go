@@ -0,0 +1,165 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/go-faster/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/go-faster/vega/internal/cli"
+	"github.com/go-faster/vega/internal/oas"
+)
+
+// rate formats n as percentage of total.
+func rate(n, total int64) string {
+	if n == 0 || total == 0 {
+		return "0%"
+	}
+	return fmt.Sprintf("%.1f%%", float64(n)*100/float64(total))
+}
+
+func domainsTable(domains []oas.DNSDomain) cli.Table {
+	t := cli.Table{
+		Columns: []cli.Column{
+			{Name: "DOMAIN"},
+			{Name: "LOOKUPS"},
+			{Name: "NXDOMAIN"},
+			{Name: "SERVFAIL"},
+			{Name: "ERRORS"},
+			{Name: "SLOW"},
+			{Name: "P95"},
+			{Name: "IPS", Wide: true},
+		},
+	}
+	for _, d := range domains {
+		t.Rows = append(t.Rows, []string{
+			d.Query,
+			strconv.FormatInt(d.Lookups, 10),
+			rate(d.Nxdomain, d.Lookups),
+			rate(d.Servfail, d.Lookups),
+			rate(d.Errors, d.Lookups),
+			strconv.FormatInt(d.Slow, 10),
+			ms(d.LatencyP95Ms),
+			strings.Join(d.Ips, ","),
+		})
+	}
+	return t
+}
+
+func egressTable(egress []oas.EgressDestination) cli.Table {
+	t := cli.Table{
+		Columns: []cli.Column{
+			{Name: "NEW"},
+			{Name: "IP"},
+			{Name: "NAMES"},
+			{Name: "PORT"},
+			{Name: "FLOWS"},
+			{Name: "LAST SEEN"},
+			{Name: "FIRST SEEN", Wide: true},
+		},
+	}
+	for _, e := range egress {
+		var isNew string
+		if e.New {
+			isNew = "*"
+		}
+		t.Rows = append(t.Rows, []string{
+			isNew,
+			e.IP,
+			strings.Join(e.Names, ","),
+			fmt.Sprintf("%d/%s", e.Port, e.Protocol),
+			strconv.FormatInt(e.Flows, 10),
+			humanize.Time(e.LastSeen),
+			humanize.Time(e.FirstSeen),
+		})
+	}
+	return t
+}
+
+func (a *Application) printDNS(w io.Writer, res *oas.DNSReport) error {
+	sections := []struct {
+		Name  string
+		Empty bool
+		Table cli.Table
+	}{
+		{"Domains", len(res.Domains) == 0, domainsTable(res.Domains)},
+		{"External egress", len(res.Egress) == 0, egressTable(res.Egress)},
+	}
+	for i, s := range sections {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintf(w, "%s:\n", s.Name)
+		if s.Empty {
+			fmt.Fprintln(w, "  <none>")
+			continue
+		}
+		if err := a.printer.Print(w, nil, s.Table); err != nil {
+			return errors.Wrap(err, "print")
+		}
+	}
+	var fresh int
+	for _, e := range res.Egress {
+		if e.New {
+			fresh++
+		}
+	}
+	if fresh > 0 {
+		fmt.Fprintf(w, "\n%d external destination(s) marked * were not reached before, review them.\n", fresh)
+	}
+	return nil
+}
+
+func newDNSCmd(a *Application) *cobra.Command {
+	var arg struct {
+		Window time.Duration
+		New    bool
+	}
+	cmd := &cobra.Command{
+		Use:   "dns <app>",
+		Short: "Show domains resolved and external destinations reached by an application",
+		Long: `Show domains resolved by an application with NXDOMAIN, SERVFAIL and other
+error rates, slow lookups and latency, and external destinations reached by
+application.
+
+External destinations that were not reached before window are marked as new,
+so new external dependencies can be reviewed:
+
+  v dns api --new`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			if arg.Window < time.Minute || arg.Window > 24*time.Hour {
+				return errors.Errorf("window %s should be between 1m and 24h", arg.Window)
+			}
+			res, err := a.client.GetApplicationDNS(ctx, oas.GetApplicationDNSParams{
+				Name:   args[0],
+				Window: oas.NewOptInt(int(arg.Window.Seconds())),
+			})
+			if err != nil {
+				return errors.Wrap(err, "GetApplicationDNS")
+			}
+			if arg.New {
+				egress := res.Egress[:0]
+				for _, e := range res.Egress {
+					if e.New {
+						egress = append(egress, e)
+					}
+				}
+				res.Egress = egress
+			}
+			if a.printer.Structured() {
+				return a.print(cmd, res, cli.Table{})
+			}
+			return a.printDNS(cmd.OutOrStdout(), res)
+		},
+	}
+	cmd.Flags().DurationVar(&arg.Window, "window", time.Hour, "Window of DNS lookups and egress flows")
+	cmd.Flags().BoolVar(&arg.New, "new", false, "Show only new external destinations")
+	return cmd
+}
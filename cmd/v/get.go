@@ -1,39 +1,143 @@
 package main
 
 import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/dustin/go-humanize"
 	"github.com/go-faster/errors"
 	"github.com/spf13/cobra"
 
+	"github.com/go-faster/vega/internal/cli"
 	"github.com/go-faster/vega/internal/oas"
 )
 
+// podStatus returns pod status like kubectl does: waiting or terminated
+// reason of first unhealthy container, or pod phase.
+func podStatus(pod oas.Pod) string {
+	if v, ok := pod.Reason.Get(); ok {
+		return v
+	}
+	for _, c := range pod.Containers {
+		if c.Ready || c.State == oas.ContainerStatusStateRunning {
+			continue
+		}
+		if c.State == oas.ContainerStatusStateTerminated && c.Init && c.Terminated.Value.ExitCode == 0 {
+			continue
+		}
+		if v, ok := c.Reason.Get(); ok {
+			if c.Init {
+				return "Init:" + v
+			}
+			return v
+		}
+	}
+	return pod.Status
+}
+
+// podsTable returns table of pods with resource usage.
+func podsTable(pods []oas.Pod) cli.Table {
+	t := cli.Table{
+		Columns: []cli.Column{
+			{Name: "NAME"},
+			{Name: "NAMESPACE", Wide: true},
+			{Name: "STATUS"},
+			{Name: "RESTARTS"},
+			{Name: "CPU"},
+			{Name: "MEM"},
+			{Name: "RX/S"},
+			{Name: "TX/S"},
+		},
+	}
+	for _, pod := range pods {
+		t.Rows = append(t.Rows, []string{
+			pod.Name,
+			pod.Namespace,
+			podStatus(pod),
+			strconv.Itoa(pod.Restarts),
+			strconv.FormatFloat(pod.Resources.CPUUsageTotalMillicores, 'f', 3, 64),
+			humanize.Bytes(uint64(pod.Resources.MemUsageTotalBytes)),
+			humanize.Bytes(uint64(pod.Resources.NetRxBytesPerSecond)),
+			humanize.Bytes(uint64(pod.Resources.NetTxBytesPerSecond)),
+		})
+	}
+	return t
+}
+
+// countsText returns comma-separated list of "key count" pairs.
+func countsText[T any](items []T, f func(T) (string, int64)) string {
+	parts := make([]string, 0, len(items))
+	for _, v := range items {
+		k, n := f(v)
+		parts = append(parts, fmt.Sprintf("%s %d", k, n))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// printHealth prints compact report of application network and security.
+func printHealth(w io.Writer, app *oas.ApplicationSummary) {
+	if v, ok := app.Network.Get(); ok {
+		window := time.Duration(v.Window) * time.Second
+		_, _ = fmt.Fprintf(w, "\nNetwork (%s): %d flows, %d dropped, %d DNS failures\n", window, v.Flows, v.Dropped, v.DNSFailures)
+		if len(v.DropReasons) > 0 {
+			_, _ = fmt.Fprintf(w, "  Drops: %s\n", countsText(v.DropReasons, func(v oas.DropReasonCount) (string, int64) {
+				return v.Reason, v.Count
+			}))
+		}
+		if len(v.HTTPStatuses) > 0 {
+			_, _ = fmt.Fprintf(w, "  HTTP:  %s\n", countsText(v.HTTPStatuses, func(v oas.HTTPStatusCount) (string, int64) {
+				return strconv.Itoa(v.Code), v.Count
+			}))
+		}
+		if len(v.TopDNSFailures) > 0 {
+			_, _ = fmt.Fprintf(w, "  DNS:   %s\n", countsText(v.TopDNSFailures, func(v oas.DNSFailure) (string, int64) {
+				return fmt.Sprintf("%s (rcode %d)", v.Query, v.Rcode), v.Count
+			}))
+		}
+		if len(v.TopPeers) > 0 {
+			_, _ = fmt.Fprintf(w, "  Peers: %s\n", countsText(v.TopPeers, func(v oas.PeerTraffic) (string, int64) {
+				return v.Peer, v.Flows
+			}))
+		}
+	}
+	if v, ok := app.Security.Get(); ok {
+		window := time.Duration(v.Window) * time.Second
+		_, _ = fmt.Fprintf(w, "\nSecurity (%s): %d execs of %d distinct binaries\n", window, v.Execs, v.Binaries)
+	}
+}
+
 func newGetCmd(a *Application) *cobra.Command {
+	var arg struct {
+		Window time.Duration
+	}
 	cmd := &cobra.Command{
 		Use:   "get",
 		Short: "Get an application",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
+			if arg.Window < time.Minute || arg.Window > 24*time.Hour {
+				return errors.Errorf("window %s should be between 1m and 24h", arg.Window)
+			}
 			app, err := a.client.GetApplication(ctx, oas.GetApplicationParams{
-				Name: args[0],
+				Name:   args[0],
+				Window: oas.NewOptInt(int(arg.Window.Seconds())),
 			})
 			if err != nil {
 				return errors.Wrap(err, "GetApplication")
 			}
-			cmd.Printf("%s (ns=%s)\n", app.Name, app.Namespace)
-			cmd.Printf("pods:\n")
-			for _, pod := range app.Pods {
-				cmd.Printf("  %s (mem=%s, cpu=%f, rx=%s/s, tx=%s/s)\n",
-					pod.Name,
-					humanize.Bytes(uint64(pod.Resources.MemUsageTotalBytes)),
-					pod.Resources.CPUUsageTotalMillicores,
-					humanize.Bytes(uint64(pod.Resources.NetRxBytesPerSecond)),
-					humanize.Bytes(uint64(pod.Resources.NetTxBytesPerSecond)),
-				)
+			if err := a.print(cmd, app, podsTable(app.Pods)); err != nil {
+				return err
+			}
+			if !a.printer.Structured() {
+				printHealth(cmd.OutOrStdout(), app)
 			}
 			return nil
 		},
 	}
+	cmd.Flags().DurationVar(&arg.Window, "window", 15*time.Minute, "Window of network and security summary")
 	return cmd
 }
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/go-faster/vega/internal/cli"
+	"github.com/go-faster/vega/internal/oas"
+)
+
+// ms formats milliseconds as duration.
+func ms(v float64) string {
+	return time.Duration(v * float64(time.Millisecond)).Round(time.Microsecond).String()
+}
+
+func httpTable(endpoints []oas.HTTPEndpoint) cli.Table {
+	t := cli.Table{
+		Columns: []cli.Column{
+			{Name: "METHOD"},
+			{Name: "ROUTE"},
+			{Name: "RPS"},
+			{Name: "ERRORS"},
+			{Name: "P50"},
+			{Name: "P90"},
+			{Name: "P99"},
+			{Name: "REQUESTS", Wide: true},
+			{Name: "4XX", Wide: true},
+			{Name: "5XX", Wide: true},
+		},
+	}
+	for _, e := range endpoints {
+		t.Rows = append(t.Rows, []string{
+			e.Method,
+			e.Route,
+			fmt.Sprintf("%.2f", e.Rate),
+			fmt.Sprintf("%.1f%%", e.ErrorRatio*100),
+			ms(e.LatencyP50Ms),
+			ms(e.LatencyP90Ms),
+			ms(e.LatencyP99Ms),
+			strconv.FormatInt(e.Requests, 10),
+			strconv.FormatInt(e.ClientErrors, 10),
+			strconv.FormatInt(e.Errors, 10),
+		})
+	}
+	return t
+}
+
+func newHTTPCmd(a *Application) *cobra.Command {
+	var arg struct {
+		Window time.Duration
+		Limit  int
+	}
+	cmd := &cobra.Command{
+		Use:   "http <app>",
+		Short: "Show rate, errors and latency of HTTP endpoints of an application",
+		Long: `Show rate, ratio of 5xx errors and latency percentiles of HTTP requests
+served by an application per method and route, busiest first.
+
+Metrics are computed from L7-visible hubble flows, so application does not
+need to be instrumented. Identifiers in paths are collapsed to {id} and
+{uuid}, e.g. /users/{id}.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			if arg.Window < time.Minute || arg.Window > 24*time.Hour {
+				return errors.Errorf("window %s should be between 1m and 24h", arg.Window)
+			}
+			if arg.Limit < 1 || arg.Limit > 500 {
+				return errors.Errorf("limit %d should be between 1 and 500", arg.Limit)
+			}
+			res, err := a.client.GetApplicationHTTP(ctx, oas.GetApplicationHTTPParams{
+				Name:   args[0],
+				Window: oas.NewOptInt(int(arg.Window.Seconds())),
+				Limit:  oas.NewOptInt(arg.Limit),
+			})
+			if err != nil {
+				return errors.Wrap(err, "GetApplicationHTTP")
+			}
+			return a.print(cmd, res, httpTable(res.Endpoints))
+		},
+	}
+	cmd.Flags().DurationVar(&arg.Window, "window", 15*time.Minute, "Window of requests")
+	cmd.Flags().IntVar(&arg.Limit, "limit", 20, "Maximum number of endpoints")
+	return cmd
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/go-faster/vega/internal/cli"
+	"github.com/go-faster/vega/internal/oas"
+)
+
+func kafkaTable(requests []oas.KafkaRequests) cli.Table {
+	t := cli.Table{
+		Columns: []cli.Column{
+			{Name: "TOPIC"},
+			{Name: "API KEY"},
+			{Name: "BROKER"},
+			{Name: "RPS"},
+			{Name: "ERRORS"},
+			{Name: "P50"},
+			{Name: "P99"},
+			{Name: "REQUESTS", Wide: true},
+			{Name: "ERROR CODES", Wide: true},
+		},
+	}
+	for _, r := range requests {
+		codes := make([]string, 0, len(r.ErrorCodes))
+		for _, c := range r.ErrorCodes {
+			codes = append(codes, strconv.Itoa(int(c)))
+		}
+		t.Rows = append(t.Rows, []string{
+			r.Topic,
+			r.APIKey,
+			r.Broker,
+			fmt.Sprintf("%.2f", r.Rate),
+			strconv.FormatInt(r.Errors, 10),
+			ms(r.LatencyP50Ms),
+			ms(r.LatencyP99Ms),
+			strconv.FormatInt(r.Requests, 10),
+			strings.Join(codes, ","),
+		})
+	}
+	return t
+}
+
+// topicsText formats list of topics.
+func topicsText(topics []string) string {
+	if len(topics) == 0 {
+		return "<none>"
+	}
+	return strings.Join(topics, ", ")
+}
+
+func newKafkaCmd(a *Application) *cobra.Command {
+	var arg struct {
+		Window time.Duration
+	}
+	cmd := &cobra.Command{
+		Use:   "kafka <app>",
+		Short: "Show Kafka topics an application produces to and consumes from",
+		Long: `Show Kafka topics an application produces to and consumes from, with
+request rate, errors and latency per topic, api key and broker.
+
+Requests are taken from L7-visible hubble flows, so Kafka traffic should be
+matched by L7 Kafka policy rule.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			if arg.Window < time.Minute || arg.Window > 24*time.Hour {
+				return errors.Errorf("window %s should be between 1m and 24h", arg.Window)
+			}
+			res, err := a.client.GetApplicationKafka(ctx, oas.GetApplicationKafkaParams{
+				Name:   args[0],
+				Window: oas.NewOptInt(int(arg.Window.Seconds())),
+			})
+			if err != nil {
+				return errors.Wrap(err, "GetApplicationKafka")
+			}
+			if !a.printer.Structured() {
+				w := cmd.OutOrStdout()
+				_, _ = fmt.Fprintf(w, "Produces: %s\nConsumes: %s\n\n", topicsText(res.Produces), topicsText(res.Consumes))
+			}
+			return a.print(cmd, res, kafkaTable(res.Requests))
+		},
+	}
+	cmd.Flags().DurationVar(&arg.Window, "window", 15*time.Minute, "Window of requests")
+	return cmd
+}
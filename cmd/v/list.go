@@ -3,6 +3,8 @@ package main
 import (
 	"github.com/go-faster/errors"
 	"github.com/spf13/cobra"
+
+	"github.com/go-faster/vega/internal/cli"
 )
 
 func newListCmd(a *Application) *cobra.Command {
@@ -15,10 +17,16 @@ func newListCmd(a *Application) *cobra.Command {
 			if err != nil {
 				return errors.Wrap(err, "ListThings")
 			}
+			t := cli.Table{
+				Columns: []cli.Column{
+					{Name: "NAME"},
+					{Name: "NAMESPACE"},
+				},
+			}
 			for _, app := range apps {
-				cmd.Printf("name=%s\tns=%s\n", app.Name, app.Namespace)
+				t.Rows = append(t.Rows, []string{app.Name, app.Namespace})
 			}
-			return nil
+			return a.print(cmd, apps, t)
 		},
 	}
 	return cmd
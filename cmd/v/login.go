@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"os/exec"
+	"runtime"
+
+	"github.com/go-faster/errors"
+	"github.com/spf13/cobra"
+	"golang.org/x/oauth2"
+
+	"github.com/go-faster/vega/internal/cli"
+)
+
+// openBrowser tries to open u in default browser.
+func openBrowser(ctx context.Context, u string) error {
+	var name string
+	switch runtime.GOOS {
+	case "darwin":
+		name = "open"
+	case "windows":
+		name = "explorer"
+	default:
+		name = "xdg-open"
+	}
+	return exec.CommandContext(ctx, name, u).Start() //#nosec G204
+}
+
+func newLoginCmd(a *Application) *cobra.Command {
+	var arg struct {
+		Issuer       string
+		ClientID     string
+		ClientSecret string
+		Device       bool
+		Listen       string
+		NoBrowser    bool
+	}
+	cmd := &cobra.Command{
+		Use:   "login [context]",
+		Short: "Log in to vega using OAuth2",
+		Long: `Log in to vega using OAuth2 and save token to context.
+
+Client ID of public application is required on first login, for local
+GitLab it is created by vega-gitlab-init and saved to vega.gitlab.cli secret.
+
+By default, authorization code flow with PKCE is used, redirect URL
+http://<listen>/callback should be registered for client. Use --device for
+device authorization flow, e.g. on headless hosts.
+
+Context is created if it does not exist, current context is used by default.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			name := a.config.CurrentContext
+			if len(args) > 0 {
+				name = args[0]
+			}
+			if name == "" {
+				name = "default"
+			}
+			c, ok := a.config.Contexts[name]
+			if !ok {
+				c = &cli.Context{URL: a.url}
+			}
+			if cmd.Flags().Changed("issuer") || c.Issuer == "" {
+				c.Issuer = arg.Issuer
+			}
+			if cmd.Flags().Changed("client-id") {
+				c.ClientID = arg.ClientID
+			}
+			if c.ClientID == "" {
+				return errors.New("--client-id is required: use ID of public OAuth2 application, " +
+					"see id in vega.gitlab.cli secret for local GitLab",
+				)
+			}
+			if cmd.Flags().Changed("client-secret") {
+				c.ClientSecret = arg.ClientSecret
+			}
+
+			var (
+				client = c.OAuth()
+				token  *cli.Token
+				err    error
+			)
+			if arg.Device {
+				token, err = client.LoginDevice(ctx, func(r *oauth2.DeviceAuthResponse) {
+					if r.VerificationURIComplete != "" {
+						cmd.Printf("Open %s to log in\n", r.VerificationURIComplete)
+						return
+					}
+					cmd.Printf("Open %s and enter code %s to log in\n", r.VerificationURI, r.UserCode)
+				})
+			} else {
+				token, err = client.LoginBrowser(ctx, arg.Listen, func(u string) {
+					cmd.Printf("Open %s to log in\n", u)
+					if arg.NoBrowser {
+						return
+					}
+					if err := openBrowser(ctx, u); err != nil {
+						cmd.Printf("Failed to open browser: %v\n", err)
+					}
+				})
+			}
+			if err != nil {
+				return errors.Wrap(err, "login")
+			}
+
+			c.Token = token
+			a.config.Contexts[name] = c
+			if a.config.CurrentContext == "" {
+				a.config.CurrentContext = name
+			}
+			if err := a.config.Save(a.configPath); err != nil {
+				return errors.Wrap(err, "save config")
+			}
+			cmd.Printf("Logged in, token saved to context %q\n", name)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&arg.Issuer, "issuer", cli.DefaultIssuer, "OAuth2 issuer URL")
+	cmd.Flags().StringVar(&arg.ClientID, "client-id", "", "OAuth2 client ID, saved to context")
+	cmd.Flags().StringVar(&arg.ClientSecret, "client-secret", "", "OAuth2 client secret, for confidential clients")
+	cmd.Flags().BoolVar(&arg.Device, "device", false, "Use device authorization flow")
+	cmd.Flags().StringVar(&arg.Listen, "listen", cli.DefaultCallbackAddr, "Callback server address")
+	cmd.Flags().BoolVar(&arg.NoBrowser, "no-browser", false, "Do not open browser")
+	return cmd
+}
@@ -0,0 +1,161 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/go-faster/jx"
+	"github.com/spf13/cobra"
+
+	"github.com/go-faster/vega/internal/cli"
+	"github.com/go-faster/vega/internal/oas"
+	"github.com/go-faster/vega/internal/semconv"
+)
+
+// parseTime parses relative duration like "15m" as time before now,
+// or absolute RFC 3339 time.
+func parseTime(now time.Time, s string) (time.Time, error) {
+	if d, err := time.ParseDuration(s); err == nil {
+		return now.Add(-d), nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, errors.Errorf("invalid time %q: should be duration or RFC 3339", s)
+	}
+	return t, nil
+}
+
+func newLogsCmd(a *Application) *cobra.Command {
+	var arg struct {
+		Follow     bool
+		Since      string
+		Until      string
+		Grep       string
+		Container  string
+		Limit      int
+		Timestamps bool
+	}
+	cmd := &cobra.Command{
+		Use:   "logs <app>",
+		Short: "Print logs of all application pods",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			if arg.Follow && arg.Until != "" {
+				return errors.New("--until is not allowed with --follow")
+			}
+			now := time.Now()
+			params := oas.GetApplicationLogsParams{
+				Name:  args[0],
+				Limit: oas.NewOptInt(arg.Limit),
+			}
+			if arg.Since != "" {
+				t, err := parseTime(now, arg.Since)
+				if err != nil {
+					return errors.Wrap(err, "since")
+				}
+				params.Since = oas.NewOptDateTime(t)
+			}
+			if arg.Until != "" {
+				t, err := parseTime(now, arg.Until)
+				if err != nil {
+					return errors.Wrap(err, "until")
+				}
+				params.Until = oas.NewOptDateTime(t)
+			}
+			if arg.Grep != "" {
+				params.Grep = oas.NewOptString(arg.Grep)
+			}
+			if arg.Container != "" {
+				params.Container = oas.NewOptString(arg.Container)
+			}
+
+			w := cmd.OutOrStdout()
+			printEntry := func(e oas.LogEntry) error {
+				if a.printer.Structured() {
+					return a.print(cmd, e, cli.Table{})
+				}
+				prefix := e.Pod
+				if c, ok := e.Container.Get(); ok {
+					prefix += "/" + c
+				}
+				if arg.Timestamps {
+					prefix = e.Time.Format(time.RFC3339Nano) + " " + prefix
+				}
+				_, err := fmt.Fprintf(w, "[%s] %s\n", prefix, e.Line)
+				return err
+			}
+
+			if !arg.Follow {
+				res, err := a.client.GetApplicationLogs(ctx, params)
+				if err != nil {
+					return errors.Wrap(err, "GetApplicationLogs")
+				}
+				list, ok := res.(*oas.LogEntryList)
+				if !ok {
+					return errors.Errorf("unexpected response %T", res)
+				}
+				if a.printer.Structured() {
+					return a.print(cmd, list, cli.Table{})
+				}
+				for _, e := range *list {
+					if err := printEntry(e); err != nil {
+						return errors.Wrap(err, "print")
+					}
+				}
+				return nil
+			}
+
+			q := url.Values{}
+			q.Set("follow", "true")
+			q.Set("limit", strconv.Itoa(arg.Limit))
+			if v, ok := params.Since.Get(); ok {
+				q.Set("since", v.Format(time.RFC3339Nano))
+			}
+			if v, ok := params.Grep.Get(); ok {
+				q.Set("grep", v)
+			}
+			if v, ok := params.Container.Get(); ok {
+				q.Set("container", v)
+			}
+			events, body, err := a.stream(ctx, "/applications/"+url.PathEscape(args[0])+"/logs", q)
+			if err != nil {
+				return errors.Wrap(err, "GetApplicationLogs")
+			}
+			defer func() {
+				_ = body.Close()
+			}()
+			for {
+				e, err := events.Next()
+				if errors.Is(err, io.EOF) {
+					return errors.New("stream closed by server")
+				}
+				if err != nil {
+					return errors.Wrap(err, "read event")
+				}
+				if e.Name != semconv.EventLog {
+					continue
+				}
+				var entry oas.LogEntry
+				if err := entry.Decode(jx.DecodeBytes(e.Data)); err != nil {
+					return errors.Wrap(err, "decode log entry")
+				}
+				if err := printEntry(entry); err != nil {
+					return errors.Wrap(err, "print")
+				}
+			}
+		},
+	}
+	cmd.Flags().BoolVarP(&arg.Follow, "follow", "f", false, "Follow logs of running and new pods")
+	cmd.Flags().StringVar(&arg.Since, "since", "", "Start of time window as duration (e.g. 15m) or RFC 3339 time, 1h by default")
+	cmd.Flags().StringVar(&arg.Until, "until", "", "End of time window as duration (e.g. 5m) or RFC 3339 time")
+	cmd.Flags().StringVar(&arg.Grep, "grep", "", "Print only lines that contain substring")
+	cmd.Flags().StringVarP(&arg.Container, "container", "c", "", "Print logs only of container")
+	cmd.Flags().IntVar(&arg.Limit, "limit", 1000, "Maximum number of history lines")
+	cmd.Flags().BoolVar(&arg.Timestamps, "timestamps", false, "Print timestamps")
+	return cmd
+}
@@ -1,20 +1,36 @@
 package main
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/go-faster/errors"
 	"github.com/spf13/cobra"
 
+	"github.com/go-faster/vega/internal/cli"
 	"github.com/go-faster/vega/internal/oas"
 )
 
 // Application wraps state and initialized dependencies for vega client.
 type Application struct {
 	client *oas.Client
+	http   *http.Client
+	url    string
+
+	output  string
+	printer *cli.Printer
+
+	context    string // --context flag
+	configPath string
+	config     *cli.Config
 }
 
+const defaultURL = "http://vega.localhost"
+
 func root() *cobra.Command {
 	app := &Application{}
 	cmd := &cobra.Command{
@@ -23,25 +39,122 @@ func root() *cobra.Command {
 		Long:          "TUI and CLI for vega platform",
 		SilenceErrors: true,
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
-			client, err := oas.NewClient("http://vega.localhost")
+			configPath, err := cli.ConfigPath()
+			if err != nil {
+				return errors.Wrap(err, "config path")
+			}
+			config, err := cli.LoadConfig(configPath)
+			if err != nil {
+				return errors.Wrap(err, "load config")
+			}
+			app.configPath = configPath
+			app.config = config
+
+			var (
+				transport = &cli.BearerTransport{}
+				current   *cli.Context
+			)
+			if current, err = config.Context(app.context); err != nil {
+				return errors.Wrap(err, "get context")
+			}
+			app.url = defaultURL
+			if current != nil {
+				if current.URL != "" {
+					app.url = current.URL
+				}
+				if err := app.refreshToken(cmd.Context(), current); err != nil {
+					// Not fatal, e.g. for v login itself.
+					cmd.PrintErrf("Warning: %v\n", err)
+				} else if current.Token != nil {
+					transport.Token = current.Token.Bearer()
+				}
+			}
+			if v := os.Getenv("VEGA_URL"); v != "" {
+				app.url = v
+			}
+			app.url = strings.TrimSuffix(app.url, "/")
+			app.http = &http.Client{Transport: transport}
+
+			client, err := oas.NewClient(app.url, tokenSource(transport.Token), oas.WithClient(app.http))
 			if err != nil {
 				return errors.Wrap(err, "oas.NewClient")
 			}
 			app.client = client
+			printer, err := cli.NewPrinter(app.output)
+			if err != nil {
+				return errors.Wrap(err, "output")
+			}
+			app.printer = printer
 			return nil
 		},
 	}
+	cmd.PersistentFlags().StringVar(&app.context, "context", "", "Config context to use instead of current one")
+	cmd.PersistentFlags().StringVarP(&app.output, "output", "o", cli.OutputTable,
+		"Output format, one of: "+strings.Join(cli.Outputs, ", "),
+	)
 	cmd.AddCommand(newVersionCmd(app))
 	cmd.AddCommand(newWaitCmd(app))
 	cmd.AddCommand(newListCmd(app))
 	cmd.AddCommand(newGetCmd(app))
+	cmd.AddCommand(newDescribeCmd(app))
+	cmd.AddCommand(newLogsCmd(app))
+	cmd.AddCommand(newWatchCmd(app))
+	cmd.AddCommand(newTUICmd(app))
+	cmd.AddCommand(newConfigCmd(app))
+	cmd.AddCommand(newLoginCmd(app))
+	cmd.AddCommand(newAlertsCmd(app))
+	cmd.AddCommand(newTraceCmd(app))
+	cmd.AddCommand(newNetpolCmd(app))
+	cmd.AddCommand(newAuditCmd(app))
+	cmd.AddCommand(newDNSCmd(app))
+	cmd.AddCommand(newHTTPCmd(app))
+	cmd.AddCommand(newKafkaCmd(app))
+	cmd.AddCommand(newPsCmd(app))
+	cmd.AddCommand(newAnomaliesCmd(app))
 	return cmd
 }
 
+// tokenSource provides bearer token to API client.
+type tokenSource string
+
+// BearerAuth implements oas.SecuritySource.
+func (t tokenSource) BearerAuth(ctx context.Context, operationName oas.OperationName) (oas.BearerAuth, error) {
+	return oas.BearerAuth{Token: string(t)}, nil
+}
+
+// refreshToken refreshes expired token of context and saves it to config.
+func (a *Application) refreshToken(ctx context.Context, c *cli.Context) error {
+	if c.Token == nil || !c.Token.Expired(time.Now()) {
+		return nil
+	}
+	if c.Token.RefreshToken == "" || c.Issuer == "" {
+		return errors.New("token expired, run v login")
+	}
+	token, err := c.OAuth().Refresh(ctx, c.Token)
+	if err != nil {
+		return errors.Wrap(err, "token expired and refresh failed, run v login")
+	}
+	c.Token = token
+	if err := a.config.Save(a.configPath); err != nil {
+		return errors.Wrap(err, "save config")
+	}
+	return nil
+}
+
+// print writes v to command output in format requested by --output flag.
+func (a *Application) print(cmd *cobra.Command, v any, t cli.Table) error {
+	return a.printer.Print(cmd.OutOrStdout(), v, t)
+}
+
 func main() {
 	cmd := root()
 	if err := cmd.Execute(); err != nil {
 		fmt.Fprintln(os.Stderr, err)
+		if e, ok := errors.Into[*oas.ErrorStatusCode](err); ok {
+			if id, ok := e.Response.TraceID.Get(); ok {
+				fmt.Fprintf(os.Stderr, "Inspect request with: v trace %s\n", id)
+			}
+		}
 		os.Exit(1)
 	}
 }
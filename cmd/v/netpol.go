@@ -0,0 +1,86 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/go-faster/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/go-faster/vega/internal/cli"
+	"github.com/go-faster/vega/internal/oas"
+)
+
+func dropsTable(drops []oas.FlowDrop) cli.Table {
+	t := cli.Table{
+		Columns: []cli.Column{
+			{Name: "DIRECTION"},
+			{Name: "PEER"},
+			{Name: "PORT"},
+			{Name: "REASON"},
+			{Name: "COUNT"},
+			{Name: "LAST SEEN"},
+			{Name: "POLICY", Wide: true},
+			{Name: "LABELS", Wide: true},
+		},
+	}
+	for _, d := range drops {
+		t.Rows = append(t.Rows, []string{
+			string(d.Direction),
+			d.Peer,
+			fmt.Sprintf("%d/%s", d.Port, d.Protocol),
+			d.Reason,
+			strconv.FormatInt(d.Count, 10),
+			humanize.Time(d.LastSeen),
+			d.Policy.Or(""),
+			strings.Join(d.PeerLabels, ","),
+		})
+	}
+	return t
+}
+
+func newNetpolCmd(a *Application) *cobra.Command {
+	var arg struct {
+		Window time.Duration
+		Policy bool
+	}
+	cmd := &cobra.Command{
+		Use:   "netpol <app>",
+		Short: "Show dropped flows of an application and suggest CiliumNetworkPolicy",
+		Long: `Show recent dropped flows of an application grouped by direction, peer
+identity and port.
+
+With --policy, prints candidate CiliumNetworkPolicy that allows flows denied
+by policy, so it can be reviewed and applied:
+
+  v netpol api --policy > policy.yml`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			if arg.Window < time.Minute {
+				return errors.Errorf("window %s is less than 1m", arg.Window)
+			}
+			res, err := a.client.GetApplicationNetpol(ctx, oas.GetApplicationNetpolParams{
+				Name:   args[0],
+				Window: oas.NewOptInt(int(arg.Window.Seconds())),
+			})
+			if err != nil {
+				return errors.Wrap(err, "GetApplicationNetpol")
+			}
+			if arg.Policy {
+				if res.Policy == "" {
+					return errors.Errorf("no flows denied by policy in last %s", arg.Window)
+				}
+				_, err := fmt.Fprint(cmd.OutOrStdout(), res.Policy)
+				return err
+			}
+			return a.print(cmd, res, dropsTable(res.Drops))
+		},
+	}
+	cmd.Flags().DurationVar(&arg.Window, "window", time.Hour, "Window of dropped flows")
+	cmd.Flags().BoolVar(&arg.Policy, "policy", false, "Print suggested CiliumNetworkPolicy manifest")
+	return cmd
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/go-faster/vega/internal/cli"
+	"github.com/go-faster/vega/internal/oas"
+)
+
+// processText returns process line of tree, like
+// "/bin/sh -c ls (10) exit 2 [3ms]".
+func processText(p oas.ProcessNode) string {
+	var b strings.Builder
+	b.WriteString(p.Binary)
+	if p.Arguments != "" {
+		b.WriteString(" ")
+		b.WriteString(p.Arguments)
+	}
+	fmt.Fprintf(&b, " (%d)", p.Pid)
+	switch {
+	case p.Partial:
+		b.WriteString(" started before window")
+	case p.Exited:
+		if v, ok := p.ExitSignal.Get(); ok {
+			b.WriteString(" " + v)
+		} else {
+			fmt.Fprintf(&b, " exit %d", p.ExitStatus.Or(0))
+		}
+	default:
+		b.WriteString(" running")
+	}
+	if v, ok := p.DurationMs.Get(); ok {
+		fmt.Fprintf(&b, " [%s]", ms(v))
+	}
+	if v, ok := p.Container.Get(); ok {
+		fmt.Fprintf(&b, " container=%s", v)
+	}
+	return b.String()
+}
+
+func printProcessTree(w io.Writer, nodes []oas.ProcessNode, prefix string) {
+	for i, n := range nodes {
+		branch, next := "├─ ", "│  "
+		if i == len(nodes)-1 {
+			branch, next = "└─ ", "   "
+		}
+		_, _ = fmt.Fprintf(w, "%s%s%s\n", prefix, branch, processText(n))
+		printProcessTree(w, n.Children, prefix+next)
+	}
+}
+
+func newPsCmd(a *Application) *cobra.Command {
+	var arg struct {
+		Namespace string
+		Window    time.Duration
+	}
+	cmd := &cobra.Command{
+		Use:   "ps <pod>",
+		Short: "Show process tree of an application pod",
+		Long: `Show process tree of an application pod reconstructed from tetragon
+process exec and exit events, including short-lived processes with exit
+status and duration.
+
+Processes started before window are shown only as parents of processes
+from window.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			if arg.Window < time.Minute || arg.Window > 24*time.Hour {
+				return errors.Errorf("window %s should be between 1m and 24h", arg.Window)
+			}
+			params := oas.GetPodProcessesParams{
+				Pod:    args[0],
+				Window: oas.NewOptInt(int(arg.Window.Seconds())),
+			}
+			if arg.Namespace != "" {
+				params.Namespace = oas.NewOptString(arg.Namespace)
+			}
+			res, err := a.client.GetPodProcesses(ctx, params)
+			if err != nil {
+				return errors.Wrap(err, "GetPodProcesses")
+			}
+			if a.printer.Structured() {
+				return a.print(cmd, res, cli.Table{})
+			}
+
+			w := cmd.OutOrStdout()
+			_, _ = fmt.Fprintf(w, "%s/%s\n", res.Namespace, res.Pod)
+			printProcessTree(w, res.Processes, "")
+			if len(res.Processes) == 0 {
+				_, _ = fmt.Fprintf(w, "No processes in last %s\n", arg.Window)
+			}
+			if res.Truncated.Or(false) {
+				_, _ = fmt.Fprintln(w, "Too many processes, older ones are omitted")
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&arg.Namespace, "namespace", "n", "", "Pod namespace, if pod name is ambiguous")
+	cmd.Flags().DurationVar(&arg.Window, "window", time.Hour, "Window of process events")
+	return cmd
+}
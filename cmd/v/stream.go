@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+
+	"github.com/go-faster/errors"
+	"github.com/go-faster/jx"
+
+	"github.com/go-faster/vega/internal/oas"
+	"github.com/go-faster/vega/internal/sse"
+)
+
+// stream opens server-sent events stream.
+//
+// Generated client buffers whole response body, so streaming endpoints
+// are requested directly.
+func (a *Application) stream(ctx context.Context, p string, q url.Values) (*sse.Reader, io.Closer, error) {
+	u, err := url.Parse(a.url)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "parse url")
+	}
+	u.Path = path.Join(u.Path, p)
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), http.NoBody)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "new request")
+	}
+	req.Header.Set("Accept", sse.ContentType)
+
+	res, err := a.http.Do(req)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "do")
+	}
+	if res.StatusCode != http.StatusOK {
+		defer func() {
+			_ = res.Body.Close()
+		}()
+		data, _ := io.ReadAll(res.Body)
+		var e oas.Error
+		if err := e.Decode(jx.DecodeBytes(data)); err != nil || e.ErrorMessage == "" {
+			return nil, nil, errors.Errorf("%s: %q", res.Status, data)
+		}
+		if id, ok := e.TraceID.Get(); ok {
+			return nil, nil, errors.Errorf("%s: %s (trace %s)", res.Status, e.ErrorMessage, id)
+		}
+		return nil, nil, errors.Errorf("%s: %s", res.Status, e.ErrorMessage)
+	}
+
+	return sse.NewReader(res.Body), res.Body, nil
+}
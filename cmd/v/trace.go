@@ -0,0 +1,141 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/go-faster/vega/internal/cli"
+	"github.com/go-faster/vega/internal/oas"
+)
+
+// spanTree is span with children.
+type spanTree struct {
+	span     oas.Span
+	children []*spanTree
+}
+
+// buildSpanTree returns roots of span tree. Spans with unknown parent
+// are roots, so partial traces are still printed.
+func buildSpanTree(spans []oas.Span) []*spanTree {
+	nodes := make(map[oas.SpanID]*spanTree, len(spans))
+	for _, s := range spans {
+		nodes[s.SpanID] = &spanTree{span: s}
+	}
+	var roots []*spanTree
+	for _, s := range spans {
+		node := nodes[s.SpanID]
+		if parent, ok := nodes[s.ParentSpanID.Or("")]; ok && parent != node {
+			parent.children = append(parent.children, node)
+			continue
+		}
+		roots = append(roots, node)
+	}
+	return roots
+}
+
+func spanText(s oas.Span) string {
+	var b strings.Builder
+	if s.Service != "" {
+		b.WriteString(s.Service)
+		b.WriteString(": ")
+	}
+	b.WriteString(s.Name)
+	fmt.Fprintf(&b, " [%s]", s.End.Sub(s.Start).Round(time.Microsecond))
+	if s.Kind != oas.SpanKindInternal && s.Kind != oas.SpanKindUnspecified {
+		fmt.Fprintf(&b, " %s", s.Kind)
+	}
+	if v, ok := s.Pod.Get(); ok {
+		fmt.Fprintf(&b, " pod=%s/%s", s.Namespace.Or(""), v)
+	}
+	if s.Status == oas.SpanStatusError {
+		b.WriteString(" ERROR")
+		if v, ok := s.StatusMessage.Get(); ok {
+			b.WriteString(": ")
+			b.WriteString(v)
+		}
+	}
+	return b.String()
+}
+
+func printSpanTree(w io.Writer, nodes []*spanTree, prefix string) {
+	for i, n := range nodes {
+		branch, next := "├─ ", "│  "
+		if i == len(nodes)-1 {
+			branch, next = "└─ ", "   "
+		}
+		_, _ = fmt.Fprintf(w, "%s%s%s\n", prefix, branch, spanText(n.span))
+		printSpanTree(w, n.children, prefix+next)
+	}
+}
+
+func traceFlowsTable(flows oas.FlowList) cli.Table {
+	t := cli.Table{
+		Columns: []cli.Column{
+			{Name: "TIME"},
+			{Name: "VERDICT"},
+			{Name: "SOURCE"},
+			{Name: "DESTINATION"},
+			{Name: "PROTO", Wide: true},
+			{Name: "L7"},
+		},
+	}
+	endpoint := func(e oas.FlowEndpoint) string {
+		name := e.IP
+		if v, ok := e.Pod.Get(); ok {
+			name = e.Namespace.Or("") + "/" + v
+		}
+		if v, ok := e.Port.Get(); ok {
+			name += fmt.Sprintf(":%d", v)
+		}
+		return name
+	}
+	for _, f := range flows {
+		t.Rows = append(t.Rows, []string{
+			f.Time.Format(time.StampMicro),
+			f.Verdict,
+			endpoint(f.Source),
+			endpoint(f.Destination),
+			f.Protocol,
+			f.L7.Or(""),
+		})
+	}
+	return t
+}
+
+func newTraceCmd(a *Application) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trace <trace-id>",
+		Short: "Show trace spans and network flows of the trace",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			res, err := a.client.GetTrace(ctx, oas.GetTraceParams{
+				TraceID: oas.TraceID(args[0]),
+			})
+			if err != nil {
+				return errors.Wrap(err, "GetTrace")
+			}
+			if a.printer.Structured() {
+				return a.print(cmd, res, cli.Table{})
+			}
+
+			w := cmd.OutOrStdout()
+			_, _ = fmt.Fprintf(w, "Trace %s: %d spans, %d flows\n", res.TraceID, len(res.Spans), len(res.Flows))
+			if len(res.Spans) > 0 {
+				_, _ = fmt.Fprintln(w, "\nSpans:")
+				printSpanTree(w, buildSpanTree(res.Spans), "")
+			}
+			if len(res.Flows) > 0 {
+				_, _ = fmt.Fprintln(w, "\nFlows:")
+				return a.print(cmd, res, traceFlowsTable(res.Flows))
+			}
+			return nil
+		},
+	}
+	return cmd
+}
@@ -0,0 +1,559 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/go-faster/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/go-faster/vega/internal/oas"
+	"github.com/go-faster/vega/internal/tui"
+)
+
+type tuiView int
+
+const (
+	viewApps tuiView = iota
+	viewApp
+	viewPod
+)
+
+type tuiTab int
+
+const (
+	tabPods tuiTab = iota
+	tabResources
+	tabFlows
+	tabExecs
+)
+
+var tuiTabs = [...]string{"pods", "resources", "flows", "execs"}
+
+// tuiData is result of single refresh.
+type tuiData struct {
+	gen  int // generation of navigation state that requested data
+	at   time.Time
+	err  error
+	errs [len(tuiTabs)]error // per tab, so single failed fetch does not blank other tabs
+
+	apps      oas.ApplicationList
+	summary   *oas.ApplicationSummary
+	resources *oas.ApplicationResources
+	flows     oas.FlowList
+	execs     oas.ProcessExecList
+}
+
+// tuiModel is navigation state and last fetched data.
+type tuiModel struct {
+	view   tuiView
+	tab    tuiTab
+	gen    int
+	app    string
+	pod    string
+	cursor int
+	offset int
+	data   tuiData
+
+	// Cursor of previous views to restore on navigating back.
+	appsCursor int
+	podsCursor int
+}
+
+// navigate invalidates data of previous view.
+func (m *tuiModel) navigate(view tuiView) {
+	m.view = view
+	m.gen++
+	m.offset = 0
+	if view == viewApps {
+		m.data = tuiData{apps: m.data.apps}
+	}
+}
+
+// rows returns number of selectable rows of current view.
+func (m *tuiModel) rows() int {
+	switch m.view {
+	case viewApps:
+		return len(m.data.apps)
+	case viewApp:
+		switch m.tab {
+		case tabPods:
+			if m.data.summary != nil {
+				return len(m.data.summary.Pods)
+			}
+		case tabResources:
+			if m.data.resources != nil {
+				return len(m.data.resources.Pods)
+			}
+		case tabFlows:
+			return len(m.data.flows)
+		case tabExecs:
+			return len(m.data.execs)
+		}
+	}
+	return 0
+}
+
+// key handles key press and reports whether data should be re-fetched
+// or application should quit.
+func (m *tuiModel) key(e tui.Event) (refresh, quit bool) {
+	move := func(delta int) {
+		m.cursor = max(0, min(m.cursor+delta, m.rows()-1))
+	}
+	back := func() bool {
+		switch m.view {
+		case viewApp:
+			m.navigate(viewApps)
+			m.cursor = m.appsCursor
+			return true
+		case viewPod:
+			m.navigate(viewApp)
+			m.cursor = m.podsCursor
+			return true
+		}
+		return false
+	}
+	switch e.Key {
+	case tui.KeyCtrlC:
+		return false, true
+	case tui.KeyUp:
+		move(-1)
+	case tui.KeyDown:
+		move(1)
+	case tui.KeyPageUp:
+		move(-10)
+	case tui.KeyPageDown:
+		move(10)
+	case tui.KeyHome:
+		m.cursor = 0
+	case tui.KeyEnd:
+		move(m.rows())
+	case tui.KeyTab:
+		if m.view == viewApp {
+			m.tab = (m.tab + 1) % tuiTab(len(tuiTabs))
+			m.cursor, m.offset = 0, 0
+		}
+	case tui.KeyEsc, tui.KeyBackspace, tui.KeyLeft:
+		return back(), false
+	case tui.KeyEnter, tui.KeyRight:
+		switch {
+		case m.view == viewApps && m.cursor < len(m.data.apps):
+			m.appsCursor = m.cursor
+			m.app = m.data.apps[m.cursor].Name
+			m.tab = tabPods
+			m.cursor = 0
+			m.navigate(viewApp)
+			return true, false
+		case m.view == viewApp && m.tab == tabPods && m.data.summary != nil && m.cursor < len(m.data.summary.Pods):
+			m.podsCursor = m.cursor
+			m.pod = m.data.summary.Pods[m.cursor].Name
+			m.view = viewPod
+			m.offset = 0
+		}
+	case tui.KeyRune:
+		switch e.Rune {
+		case 'q':
+			return false, true
+		case 'r':
+			return true, false
+		case 'k':
+			move(-1)
+		case 'j':
+			move(1)
+		case 'g':
+			m.cursor = 0
+		case 'G':
+			move(m.rows())
+		case 'h':
+			return back(), false
+		case '1', '2', '3', '4':
+			if m.view == viewApp {
+				m.tab = tuiTab(e.Rune - '1')
+				m.cursor, m.offset = 0, 0
+			}
+		}
+	}
+	return false, false
+}
+
+func (a *Application) tuiFetch(ctx context.Context, m tuiModel) tuiData {
+	data := tuiData{gen: m.gen}
+	defer func() {
+		data.at = time.Now()
+	}()
+	if m.view == viewApps {
+		data.apps, data.err = a.client.GetApplications(ctx)
+		return data
+	}
+	data.apps = m.data.apps
+
+	var wg sync.WaitGroup
+	fetch := func(tab tuiTab, f func() error) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			data.errs[tab] = f()
+		}()
+	}
+	fetch(tabPods, func() (err error) {
+		data.summary, err = a.client.GetApplication(ctx, oas.GetApplicationParams{Name: m.app})
+		return errors.Wrap(err, "get application")
+	})
+	fetch(tabResources, func() (err error) {
+		data.resources, err = a.client.GetApplicationResources(ctx, oas.GetApplicationResourcesParams{Name: m.app})
+		return errors.Wrap(err, "get resources")
+	})
+	fetch(tabFlows, func() (err error) {
+		data.flows, err = a.client.GetApplicationFlows(ctx, oas.GetApplicationFlowsParams{Name: m.app})
+		return errors.Wrap(err, "get flows")
+	})
+	fetch(tabExecs, func() (err error) {
+		data.execs, err = a.client.GetApplicationExecs(ctx, oas.GetApplicationExecsParams{Name: m.app})
+		return errors.Wrap(err, "get execs")
+	})
+	wg.Wait()
+	return data
+}
+
+// table renders rows with aligned columns, first line is header.
+func table(header string, rows []string) []string {
+	var buf bytes.Buffer
+	tw := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, header)
+	for _, row := range rows {
+		_, _ = fmt.Fprintln(tw, row)
+	}
+	_ = tw.Flush()
+	return strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+}
+
+func flowRow(f oas.Flow) string {
+	endpoint := func(e oas.FlowEndpoint) string {
+		s := e.IP
+		if pod, ok := e.Pod.Get(); ok {
+			s = e.Namespace.Or("") + "/" + pod
+		}
+		if port, ok := e.Port.Get(); ok {
+			s += fmt.Sprintf(":%d", port)
+		}
+		return s
+	}
+	verdict := f.Verdict
+	if v, ok := f.DropReason.Get(); ok {
+		verdict += "(" + v + ")"
+	}
+	return fmt.Sprintf("%s\t%s\t%s\t%s -> %s\t%s %s\t%s",
+		f.Time.Local().Format(time.TimeOnly),
+		verdict,
+		f.TrafficDirection,
+		endpoint(f.Source),
+		endpoint(f.Destination),
+		f.Protocol,
+		strings.Join(f.TCPFlags, ","),
+		f.L7.Or(""),
+	)
+}
+
+const flowHeader = "TIME\tVERDICT\tDIRECTION\tSOURCE -> DESTINATION\tPROTOCOL\tL7"
+
+func execRow(e oas.ProcessExec) string {
+	return fmt.Sprintf("%s\t%s\t%d\t%s %s\t%s",
+		e.Time.Local().Format(time.TimeOnly),
+		e.Pod,
+		e.UID,
+		e.Binary,
+		e.Arguments,
+		e.ParentBinary.Or(""),
+	)
+}
+
+const execHeader = "TIME\tPOD\tUID\tCOMMAND\tPARENT"
+
+// graphs renders resource sparklines of single pod.
+func graphs(series oas.PodResourcesSeries, width int) []string {
+	var cpu, mem, rx, tx []float64
+	for _, p := range series.Points {
+		cpu = append(cpu, p.Resources.CPUUsageTotalMillicores)
+		mem = append(mem, float64(p.Resources.MemUsageTotalBytes))
+		rx = append(rx, float64(p.Resources.NetRxBytesPerSecond))
+		tx = append(tx, float64(p.Resources.NetTxBytesPerSecond))
+	}
+	last := func(v []float64) float64 {
+		if len(v) == 0 {
+			return 0
+		}
+		return v[len(v)-1]
+	}
+	width = max(width-20, 10)
+	return []string{
+		fmt.Sprintf("  cpu %s %.3f", tui.Sparkline(cpu, width), last(cpu)),
+		fmt.Sprintf("  mem %s %s", tui.Sparkline(mem, width), humanize.Bytes(uint64(last(mem)))),
+		fmt.Sprintf("  rx  %s %s/s", tui.Sparkline(rx, width), humanize.Bytes(uint64(last(rx)))),
+		fmt.Sprintf("  tx  %s %s/s", tui.Sparkline(tx, width), humanize.Bytes(uint64(last(tx)))),
+	}
+}
+
+func errorLine(err error, width int) string {
+	return tui.Red(tui.Fit("error: "+err.Error(), width))
+}
+
+// body renders view content as fixed header lines and selectable rows.
+func (m *tuiModel) body(width int) (header, rows []string) {
+	d := m.data
+	switch m.view {
+	case viewApps:
+		var lines []string
+		for _, app := range d.apps {
+			lines = append(lines, app.Name+"\t"+app.Namespace)
+		}
+		t := table("NAME\tNAMESPACE", lines)
+		return t[:1], t[1:]
+	case viewApp:
+		var tabs []string
+		for i, name := range tuiTabs {
+			s := fmt.Sprintf(" %d:%s ", i+1, name)
+			if tuiTab(i) == m.tab {
+				s = tui.Reverse(s)
+			}
+			tabs = append(tabs, s)
+		}
+		header = append(header, strings.Join(tabs, " "), "")
+		if err := d.errs[m.tab]; err != nil {
+			header = append(header, errorLine(err, width), "")
+		}
+		switch m.tab {
+		case tabPods:
+			var lines []string
+			if d.summary != nil {
+				for _, pod := range d.summary.Pods {
+					lines = append(lines, fmt.Sprintf("%s\t%s\t%.3f\t%s\t%s\t%s",
+						pod.Name,
+						pod.Status,
+						pod.Resources.CPUUsageTotalMillicores,
+						humanize.Bytes(uint64(pod.Resources.MemUsageTotalBytes)),
+						humanize.Bytes(uint64(pod.Resources.NetRxBytesPerSecond)),
+						humanize.Bytes(uint64(pod.Resources.NetTxBytesPerSecond)),
+					))
+				}
+			}
+			t := table("NAME\tSTATUS\tCPU\tMEM\tRX/S\tTX/S", lines)
+			return append(header, t[0]), t[1:]
+		case tabResources:
+			if d.resources != nil {
+				for _, series := range d.resources.Pods {
+					// Each pod is single selectable row to keep scrolling simple.
+					rows = append(rows, strings.Join(append([]string{series.Pod}, graphs(series, width)...), "\n"))
+				}
+			}
+			return header, rows
+		case tabFlows:
+			var lines []string
+			for _, f := range d.flows {
+				lines = append(lines, flowRow(f))
+			}
+			t := table(flowHeader, lines)
+			return append(header, t[0]), t[1:]
+		case tabExecs:
+			var lines []string
+			for _, e := range d.execs {
+				lines = append(lines, execRow(e))
+			}
+			t := table(execHeader, lines)
+			return append(header, t[0]), t[1:]
+		}
+	case viewPod:
+		for _, err := range d.errs {
+			if err != nil {
+				header = append(header, errorLine(err, width))
+			}
+		}
+		if d.resources != nil {
+			for _, series := range d.resources.Pods {
+				if series.Pod == m.pod {
+					header = append(header, tui.Bold("resources"))
+					header = append(header, graphs(series, width)...)
+				}
+			}
+		}
+		var flows []string
+		for _, f := range d.flows {
+			if f.Source.Pod.Or("") == m.pod || f.Destination.Pod.Or("") == m.pod {
+				flows = append(flows, flowRow(f))
+			}
+		}
+		header = append(header, "", tui.Bold("flows"))
+		header = append(header, table(flowHeader, flows)...)
+		var execs []string
+		for _, e := range d.execs {
+			if e.Pod == m.pod {
+				execs = append(execs, execRow(e))
+			}
+		}
+		header = append(header, "", tui.Bold("execs"))
+		header = append(header, table(execHeader, execs)...)
+		return header, nil
+	}
+	return header, rows
+}
+
+func (m *tuiModel) render(width, height int) []string {
+	title := "vega"
+	switch m.view {
+	case viewApp:
+		title += " > " + m.app
+	case viewPod:
+		title += " > " + m.app + " > " + m.pod
+	}
+	if !m.data.at.IsZero() {
+		title += fmt.Sprintf(" (updated %s)", m.data.at.Format(time.TimeOnly))
+	}
+	out := []string{tui.Reverse(tui.Fit(title, width))}
+
+	header, rows := m.body(width)
+	for _, line := range header {
+		out = append(out, fitStyled(line, width))
+	}
+
+	footer := "q:quit r:refresh enter:open esc:back tab:next tab j/k:move"
+	if err := m.data.err; err != nil {
+		footer = errorLine(err, width)
+	} else {
+		footer = tui.Fit(footer, width)
+	}
+
+	// Scroll to keep cursor visible, rows may span multiple lines.
+	available := height - len(out) - 1
+	m.cursor = max(0, min(m.cursor, len(rows)-1))
+	if m.cursor < m.offset {
+		m.offset = m.cursor
+	}
+	for {
+		used := 0
+		for i := m.offset; i <= m.cursor && i < len(rows); i++ {
+			used += strings.Count(rows[i], "\n") + 1
+		}
+		if used <= available || m.offset >= m.cursor {
+			break
+		}
+		m.offset++
+	}
+	for i := m.offset; i < len(rows); i++ {
+		lines := strings.Split(rows[i], "\n")
+		if len(out)+len(lines) > height-1 {
+			break
+		}
+		for j, line := range lines {
+			line = tui.Fit(line, width)
+			if i == m.cursor && j == 0 && m.view != viewPod {
+				line = tui.Reverse(line)
+			}
+			out = append(out, line)
+		}
+	}
+	for len(out) < height-1 {
+		out = append(out, "")
+	}
+	if len(out) > height-1 {
+		out = out[:height-1]
+	}
+	return append(out, footer)
+}
+
+// fitStyled fits line that may contain escape sequences.
+func fitStyled(line string, width int) string {
+	if strings.Contains(line, "\x1b") {
+		return line
+	}
+	return tui.Fit(line, width)
+}
+
+func newTUICmd(a *Application) *cobra.Command {
+	var arg struct {
+		Refresh time.Duration
+	}
+	cmd := &cobra.Command{
+		Use:   "tui",
+		Short: "Interactive terminal UI",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if a.printer.Structured() {
+				return errors.New("tui does not support structured output")
+			}
+			if arg.Refresh < time.Second {
+				return errors.Errorf("refresh %s is less than 1s", arg.Refresh)
+			}
+			ctx, cancel := context.WithCancel(cmd.Context())
+			defer cancel()
+
+			t, err := tui.Open(os.Stdin, os.Stdout)
+			if err != nil {
+				return errors.Wrap(err, "open terminal")
+			}
+			defer func() {
+				_ = t.Close()
+			}()
+
+			var (
+				m       tuiModel
+				keys    = t.Keys(ctx)
+				results = make(chan tuiData, 1)
+				ticker  = time.NewTicker(arg.Refresh)
+				pending bool
+			)
+			defer ticker.Stop()
+
+			fetch := func() {
+				if pending {
+					return
+				}
+				pending = true
+				snapshot := m
+				go func() {
+					fetchCtx, fetchCancel := context.WithTimeout(ctx, arg.Refresh*2)
+					defer fetchCancel()
+					results <- a.tuiFetch(fetchCtx, snapshot)
+				}()
+			}
+			fetch()
+			for {
+				if err := t.Draw(m.render(t.Size())); err != nil {
+					return errors.Wrap(err, "draw")
+				}
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case e, ok := <-keys:
+					if !ok {
+						return nil
+					}
+					refresh, quit := m.key(e)
+					if quit {
+						return nil
+					}
+					if refresh {
+						fetch()
+					}
+				case data := <-results:
+					pending = false
+					if data.gen != m.gen {
+						// Navigated away while fetching.
+						fetch()
+						continue
+					}
+					m.data = data
+				case <-ticker.C:
+					fetch()
+				}
+			}
+		},
+	}
+	cmd.Flags().DurationVarP(&arg.Refresh, "refresh", "n", time.Second*5, "Refresh interval")
+	return cmd
+}
@@ -1,8 +1,12 @@
 package main
 
 import (
+	"time"
+
 	"github.com/go-faster/errors"
 	"github.com/spf13/cobra"
+
+	"github.com/go-faster/vega/internal/cli"
 )
 
 func newVersionCmd(a *Application) *cobra.Command {
@@ -15,10 +19,17 @@ func newVersionCmd(a *Application) *cobra.Command {
 			if err != nil {
 				return errors.Wrap(err, "GetHealth")
 			}
-			cmd.Printf("Version: %s\n", h.Version)
-			cmd.Printf("Commit: %s\n", h.Commit)
-			cmd.Printf("Build Date: %s\n", h.BuildDate)
-			return nil
+			return a.print(cmd, h, cli.Table{
+				Columns: []cli.Column{
+					{Name: "VERSION"},
+					{Name: "COMMIT"},
+					{Name: "BUILD DATE"},
+					{Name: "STATUS", Wide: true},
+				},
+				Rows: [][]string{
+					{h.Version, h.Commit, h.BuildDate.Format(time.RFC3339), h.Status},
+				},
+			})
 		},
 	}
 	return cmd
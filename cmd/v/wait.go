@@ -9,6 +9,9 @@ import (
 	"github.com/cenkalti/backoff/v4"
 	"github.com/go-faster/errors"
 	"github.com/spf13/cobra"
+
+	"github.com/go-faster/vega/internal/cli"
+	"github.com/go-faster/vega/internal/oas"
 )
 
 func newWaitCmd(a *Application) *cobra.Command {
@@ -25,15 +28,20 @@ func newWaitCmd(a *Application) *cobra.Command {
 			bo.MaxElapsedTime = arg.Duration
 			bo.InitialInterval = time.Millisecond * 100
 
-			if err := backoff.RetryNotify(func() error {
-				_, err := a.client.GetHealth(ctx)
+			var health *oas.Health
+			if err := backoff.RetryNotify(func() (err error) {
+				health, err = a.client.GetHealth(ctx)
 				return err
 			}, bo, func(err error, duration time.Duration) {
 				cmd.Printf("Waiting for vega api to be ready: %v\n", err)
 			}); err != nil {
-				res, getHealthErr := http.Get("http://vega.localhost/health")
+				req, reqErr := http.NewRequestWithContext(ctx, http.MethodGet, a.url+"/health", http.NoBody)
+				if reqErr != nil {
+					return errors.Wrap(reqErr, "create request")
+				}
+				res, getHealthErr := a.http.Do(req)
 				if getHealthErr != nil {
-					return errors.Wrap(getHealthErr, "http.Get")
+					return errors.Wrap(getHealthErr, "get health")
 				}
 				defer func() {
 					_ = res.Body.Close()
@@ -43,6 +51,9 @@ func newWaitCmd(a *Application) *cobra.Command {
 				return errors.Wrap(err, "GetHealth")
 			}
 
+			if a.printer.Structured() {
+				return a.print(cmd, health, cli.Table{})
+			}
 			cmd.Println("Vega api is ready")
 			return nil
 		},
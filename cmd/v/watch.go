@@ -0,0 +1,164 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/go-faster/errors"
+	"github.com/go-faster/jx"
+	"github.com/spf13/cobra"
+
+	"github.com/go-faster/vega/internal/cli"
+	"github.com/go-faster/vega/internal/oas"
+	"github.com/go-faster/vega/internal/semconv"
+)
+
+// watchState is application state accumulated from watch events.
+type watchState struct {
+	app     string
+	ns      string
+	updated time.Time
+	pods    map[string]oas.Pod
+	events  []string // recent pod events
+}
+
+const watchMaxEvents = 5
+
+func (s *watchState) event(name string, pod oas.Pod) {
+	line := fmt.Sprintf("%s %-11s %s", time.Now().Format(time.TimeOnly), name, pod.Name)
+	s.events = append(s.events, line)
+	if len(s.events) > watchMaxEvents {
+		s.events = s.events[len(s.events)-watchMaxEvents:]
+	}
+}
+
+func (s *watchState) apply(name string, data []byte) error {
+	s.updated = time.Now()
+	switch name {
+	case semconv.EventPodAdded, semconv.EventPodUpdated, semconv.EventPodDeleted:
+		var pod oas.Pod
+		if err := pod.Decode(jx.DecodeBytes(data)); err != nil {
+			return errors.Wrap(err, "decode pod")
+		}
+		s.ns = pod.Namespace
+		if name == semconv.EventPodDeleted {
+			delete(s.pods, pod.Name)
+		} else {
+			// Pod events do not carry resources, keep last sample.
+			pod.Resources = s.pods[pod.Name].Resources
+			s.pods[pod.Name] = pod
+		}
+		s.event(name, pod)
+	case semconv.EventResources:
+		var summary oas.ApplicationSummary
+		if err := summary.Decode(jx.DecodeBytes(data)); err != nil {
+			return errors.Wrap(err, "decode summary")
+		}
+		s.ns = summary.Namespace
+		for _, pod := range summary.Pods {
+			if _, ok := s.pods[pod.Name]; ok {
+				s.pods[pod.Name] = pod
+			}
+		}
+	}
+	return nil
+}
+
+func (s *watchState) render(w io.Writer) {
+	// Move cursor home and clear screen.
+	_, _ = fmt.Fprint(w, "\033[H\033[2J")
+	_, _ = fmt.Fprintf(w, "%s (ns=%s) pods: %d, updated: %s\n\n",
+		s.app, s.ns, len(s.pods), s.updated.Format(time.TimeOnly),
+	)
+
+	names := make([]string, 0, len(s.pods))
+	for name := range s.pods {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "NAME\tSTATUS\tCPU\tMEM\tRX/S\tTX/S")
+	for _, name := range names {
+		pod := s.pods[name]
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%.3f\t%s\t%s\t%s\n",
+			pod.Name,
+			pod.Status,
+			pod.Resources.CPUUsageTotalMillicores,
+			humanize.Bytes(uint64(pod.Resources.MemUsageTotalBytes)),
+			humanize.Bytes(uint64(pod.Resources.NetRxBytesPerSecond)),
+			humanize.Bytes(uint64(pod.Resources.NetTxBytesPerSecond)),
+		)
+	}
+	_ = tw.Flush()
+
+	if len(s.events) > 0 {
+		_, _ = fmt.Fprintf(w, "\nevents:\n  %s\n", strings.Join(s.events, "\n  "))
+	}
+}
+
+func newWatchCmd(a *Application) *cobra.Command {
+	var arg struct {
+		Interval time.Duration
+	}
+	cmd := &cobra.Command{
+		Use:   "watch <app>",
+		Short: "Watch application pods and resources",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			if arg.Interval < time.Second {
+				return errors.Errorf("interval %s is less than 1s", arg.Interval)
+			}
+			q := url.Values{}
+			q.Set("interval", strconv.Itoa(int(arg.Interval.Seconds())))
+
+			events, body, err := a.stream(ctx, "/applications/"+url.PathEscape(args[0])+"/watch", q)
+			if err != nil {
+				return errors.Wrap(err, "WatchApplication")
+			}
+			defer func() {
+				_ = body.Close()
+			}()
+
+			state := &watchState{
+				app:  args[0],
+				pods: map[string]oas.Pod{},
+			}
+			for {
+				e, err := events.Next()
+				if errors.Is(err, io.EOF) {
+					return errors.New("stream closed by server")
+				}
+				if err != nil {
+					return errors.Wrap(err, "read event")
+				}
+				if a.printer.Structured() {
+					// Print every event as-is for scripting.
+					v := struct {
+						Event string          `json:"event"`
+						Data  json.RawMessage `json:"data"`
+					}{e.Name, e.Data}
+					if err := a.print(cmd, v, cli.Table{}); err != nil {
+						return errors.Wrap(err, "print")
+					}
+					continue
+				}
+				if err := state.apply(e.Name, e.Data); err != nil {
+					return errors.Wrap(err, "apply event")
+				}
+				state.render(cmd.OutOrStdout())
+			}
+		},
+	}
+	cmd.Flags().DurationVarP(&arg.Interval, "interval", "n", time.Second*5, "Resource sampling interval")
+	return cmd
+}
@@ -221,6 +221,9 @@ type Application struct {
 	Name        string
 	RedirectURI string
 	Scopes      []string
+	// Confidential application can keep secret, public ones, like CLI,
+	// use PKCE instead.
+	Confidential bool
 }
 
 type ApplicationCredentials struct {
@@ -239,7 +242,9 @@ func (c *Client) AddApplication(ctx context.Context, app Application) (*Applicat
 	f.Set("authn_oauth_application[redirect_uri]", app.RedirectURI)
 	f.Set("authn_oauth_application[trusted]", "0")
 	f.Set("authn_oauth_application[confidential]", "0")
-	f.Set("authn_oauth_application[confidential]", "1")
+	if app.Confidential {
+		f.Set("authn_oauth_application[confidential]", "1")
+	}
 	for _, s := range app.Scopes {
 		f.Add("authn_oauth_application[scopes][]", s)
 	}
@@ -272,7 +277,7 @@ func (c *Client) AddApplication(ctx context.Context, app Application) (*Applicat
 	if creds.ID == "" {
 		return nil, errors.New("id not found")
 	}
-	if creds.Secret == "" {
+	if creds.Secret == "" && app.Confidential {
 		return nil, errors.New("secret not found")
 	}
 
@@ -460,6 +465,7 @@ func run(ctx context.Context) error {
 		Scopes: []string{
 			"openid", "profile", "email",
 		},
+		Confidential: true,
 	})
 	if err != nil {
 		return errors.Wrap(err, "add application")
@@ -485,6 +491,38 @@ func run(ctx context.Context) error {
 		color.New(color.Bold, color.FgCyan).Sprint(appSecret.Name),
 	)
 
+	cliCreds, err := client.AddApplication(ctx, Application{
+		Name:        "vega-cli",
+		RedirectURI: fmt.Sprintf("http://%s/callback", cli.DefaultCallbackAddr),
+		Scopes:      cli.DefaultScopes,
+	})
+	if err != nil {
+		return errors.Wrap(err, "add cli application")
+	}
+	cliSecret, err := kube.CoreV1().Secrets(k8s.Namespace).Apply(ctx, &apply.SecretApplyConfiguration{
+		TypeMetaApplyConfiguration: typeConfig,
+		ObjectMetaApplyConfiguration: &applyMeta.ObjectMetaApplyConfiguration{
+			Annotations: annotations,
+			Labels:      labels(vega.SecretTypeGitLabApplicationCredentials),
+			Name:        k8s.String(name + ".cli"),
+		},
+		Data: map[string][]byte{
+			"id": []byte(cliCreds.ID),
+		},
+	}, applyOptions)
+	if err != nil {
+		return errors.Wrapf(err, "apply %q secret", name+".cli")
+	}
+
+	fmt.Println(
+		color.New(color.FgGreen).Sprint("Saved OAuth2 CLI application credentials:"),
+		color.New(color.Bold, color.FgCyan).Sprint(cliSecret.Name),
+	)
+	fmt.Println(
+		color.New(color.FgGreen).Sprint("Log in with:"),
+		color.New(color.Bold).Sprintf("v login --client-id %s", cliCreds.ID),
+	)
+
 	// Installing gitlab-runner with helm.
 	if err := Helm(ctx,
 		"upgrade",
@@ -38,6 +38,15 @@ type Metrics struct {
 	EntriesRead  metric.Int64Counter `name:"entries.read"`
 	EntriesSaved metric.Int64Counter `name:"entries.saved"`
 
+	// Redactions counts masked L7 values by kind, header or url_param.
+	Redactions metric.Int64Counter `name:"redactions"`
+	// FlowsAggregated counts flows folded into existing rows.
+	FlowsAggregated metric.Int64Counter `name:"flows.aggregated"`
+	// Findings counts detection rule matches by rule and severity.
+	Findings metric.Int64Counter `name:"findings"`
+	// FindingsDropped counts findings that were not sent to webhook.
+	FindingsDropped metric.Int64Counter `name:"findings.dropped"`
+
 	OffsetRead     metric.Int64Observer `autometric:"-"`
 	OffsetCommited metric.Int64Observer `autometric:"-"`
 }
@@ -49,11 +58,21 @@ type IngesterOptions[M proto.Message, T Table] struct {
 	Servers   []Server
 	TableName string
 	DDL       string
-	Metrics   Metrics
+	// Migrations are executed after DDL to upgrade existing table.
+	Migrations []string
+	// NoTTL disables TTL of table, so rows are kept until deleted.
+	NoTTL   bool
+	Metrics Metrics
 
 	NewTable    func(tableName string) T
 	AppendEntry func(t T, e *Entry[M]) error
-	NewMessage  func() M
+	// Flush appends rows buffered by AppendEntry, if any, and is called
+	// before every batch is finished.
+	Flush func(t T, now time.Time) error
+	// Shutdown appends all rows buffered by AppendEntry before shutdown.
+	// If nil, Flush is called with far future time.
+	Shutdown   func(t T) error
+	NewMessage func() M
 }
 
 func NewIngester[M proto.Message, T Table](opt IngesterOptions[M, T]) *Ingester[M, T] {
@@ -65,10 +84,14 @@ func NewIngester[M proto.Message, T Table](opt IngesterOptions[M, T]) *Ingester[
 
 		initializeDB: true,
 		ddl:          opt.DDL,
+		migrations:   opt.Migrations,
+		noTTL:        opt.NoTTL,
 		servers:      opt.Servers,
 		tableName:    opt.TableName,
 		newTable:     opt.NewTable,
 		appendEntry:  opt.AppendEntry,
+		flush:        opt.Flush,
+		shutdown:     opt.Shutdown,
 		newMessage:   opt.NewMessage,
 
 		metrics: opt.Metrics,
@@ -84,10 +107,14 @@ type Ingester[M proto.Message, T Table] struct {
 
 	initializeDB bool
 	ddl          string
+	migrations   []string
+	noTTL        bool
 	servers      []Server
 	tableName    string
 	newTable     func(tableName string) T
 	appendEntry  func(t T, e *Entry[M]) error
+	flush        func(t T, now time.Time) error
+	shutdown     func(t T) error
 	newMessage   func() M
 
 	metrics Metrics
@@ -122,10 +149,17 @@ func (a *Ingester[M, T]) setupClickHouse(ctx context.Context, s Server) error {
 	}
 	a.log.Info("Connected to clickhouse")
 	ddl := a.ddl
-	ddl += "\nTTL toDateTime(timestamp) + INTERVAL 6 HOUR"
+	if !a.noTTL {
+		ddl += "\nTTL toDateTime(timestamp) + INTERVAL 6 HOUR"
+	}
 	if err := db.Do(ctx, ch.Query{Body: ddl}); err != nil {
 		return errors.Wrap(err, "ddl")
 	}
+	for _, m := range a.migrations {
+		if err := db.Do(ctx, ch.Query{Body: m}); err != nil {
+			return errors.Wrapf(err, "migrate: %s", m)
+		}
+	}
 
 	return nil
 }
@@ -190,6 +224,33 @@ func clickHouseServer(list []Server) Server {
 	return list[rand.Intn(len(list))] // #nosec G404
 }
 
+// flushAll is passed to Flush on shutdown if Shutdown is not set, so all
+// buffered rows are appended.
+var flushAll = time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func (a *Ingester[M, T]) shutdownEntries(t T) {
+	if a.shutdown == nil {
+		a.flushEntries(t, flushAll)
+		return
+	}
+	if err := a.shutdown(t); err != nil {
+		a.log.Warn("Shutdown entries",
+			zap.Error(err),
+		)
+	}
+}
+
+func (a *Ingester[M, T]) flushEntries(t T, now time.Time) {
+	if a.flush == nil {
+		return
+	}
+	if err := a.flush(t, now); err != nil {
+		a.log.Warn("Flush entries",
+			zap.Error(err),
+		)
+	}
+}
+
 func (a *Ingester[M, T]) Ingest(ctx context.Context) error {
 	const (
 		// ingestHardTimeout is limit for INSERT query stream duration.
@@ -207,11 +268,15 @@ func (a *Ingester[M, T]) Ingest(ctx context.Context) error {
 	softTicker := time.NewTicker(ingestSoftTimeout)
 	defer softTicker.Stop()
 
+	// Connection is not cancelled with ctx, so last batch is inserted on
+	// shutdown.
+	queryCtx := context.WithoutCancel(ctx)
 	for {
 		s := clickHouseServer(a.servers)
 		t := a.newTable(a.tableName)
+		done := false
 
-		db, err := ch.Dial(ctx, ch.Options{
+		db, err := ch.Dial(queryCtx, ch.Options{
 			Logger:      a.log.Named("entries"),
 			Address:     s.Addr,
 			User:        s.User,
@@ -228,10 +293,10 @@ func (a *Ingester[M, T]) Ingest(ctx context.Context) error {
 			return errors.Wrap(err, "clickhouse")
 		}
 
-		if err := db.Do(ctx, ch.Query{
+		if err := db.Do(queryCtx, ch.Query{
 			Body:  t.Insert(),
 			Input: t.Input(),
-			OnInput: func(ctx context.Context) error {
+			OnInput: func(context.Context) error {
 				t.Reset()
 				for {
 					if t.Rows() > ingestMaxBatch {
@@ -247,14 +312,27 @@ func (a *Ingester[M, T]) Ingest(ctx context.Context) error {
 							)
 						}
 					case <-ctx.Done():
-						return ctx.Err()
+						// Insert buffered entries and rows before shutdown.
+						for len(a.entries) > 0 {
+							if err := a.appendEntry(t, <-a.entries); err != nil {
+								a.log.Warn("Append entry",
+									zap.Error(err),
+								)
+							}
+						}
+						a.shutdownEntries(t)
+						a.metrics.EntriesSaved.Add(ctx, int64(t.Rows()))
+						done = true
+						return io.EOF
 					case <-softTicker.C:
+						a.flushEntries(t, time.Now())
 						// Finish batch.
 						if t.Rows() > 0 {
 							a.metrics.EntriesSaved.Add(ctx, int64(t.Rows()))
 							return nil
 						}
 					case <-hardTicker.C:
+						a.flushEntries(t, time.Now())
 						a.metrics.EntriesSaved.Add(ctx, int64(t.Rows()))
 						return io.EOF
 					}
@@ -267,5 +345,8 @@ func (a *Ingester[M, T]) Ingest(ctx context.Context) error {
 		if err := db.Close(); err != nil {
 			return errors.Wrap(err, "close")
 		}
+		if done {
+			return ctx.Err()
+		}
 	}
 }
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"net/http"
 	"os"
 	"strings"
 	"time"
@@ -12,10 +13,17 @@ import (
 	"github.com/go-faster/sdk/autometric"
 	"github.com/go-faster/sdk/otelsync"
 	"github.com/go-faster/tetragon/api/v1/tetragon"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/go-faster/vega/internal/baseline"
+	"github.com/go-faster/vega/internal/conn"
+	"github.com/go-faster/vega/internal/correlate"
+	"github.com/go-faster/vega/internal/detect"
 	"github.com/go-faster/vega/internal/flow"
+	"github.com/go-faster/vega/internal/redact"
 	"github.com/go-faster/vega/internal/sec"
 )
 
@@ -34,6 +42,15 @@ type App struct {
 	telemetry *app.Telemetry
 	servers   []Server
 	metrics   Metrics
+	redactor  *redact.Redactor
+	// aggregator folds hubble flows, nil if disabled.
+	aggregator *flow.Aggregator
+	tracker    *conn.Tracker
+	recorder   *baseline.Recorder
+	detector   *detect.Engine
+	// webhook receives findings, nil if disabled.
+	webhook   *detect.Webhook
+	findings  chan detect.Finding
 	ingesters []EntriesIngester
 }
 
@@ -56,17 +73,74 @@ func NewApp(lg *zap.Logger, telemetry *app.Telemetry) (*App, error) {
 		})
 	}
 
+	redactOptions, err := redact.ParseOptions(
+		os.Getenv("REDACT_HEADERS_ALLOW"),
+		os.Getenv("REDACT_HEADERS_DENY"),
+		os.Getenv("REDACT_URL_PARAMS"),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "redact options")
+	}
+
+	var aggregationWindow time.Duration
+	if v := os.Getenv("FLOW_AGGREGATION_WINDOW"); v != "" {
+		if aggregationWindow, err = time.ParseDuration(v); err != nil {
+			return nil, errors.Wrap(err, "parse FLOW_AGGREGATION_WINDOW")
+		}
+	}
+
+	var connIdleTimeout time.Duration
+	if v := os.Getenv("CONN_IDLE_TIMEOUT"); v != "" {
+		if connIdleTimeout, err = time.ParseDuration(v); err != nil {
+			return nil, errors.Wrap(err, "parse CONN_IDLE_TIMEOUT")
+		}
+	}
+
+	rules := detect.DefaultRules()
+	if name := os.Getenv("DETECT_RULES"); name != "" {
+		data, err := os.ReadFile(name) // #nosec G304
+		if err != nil {
+			return nil, errors.Wrap(err, "read DETECT_RULES")
+		}
+		if rules, err = detect.ParseRules(data); err != nil {
+			return nil, errors.Wrapf(err, "parse rules %s", name)
+		}
+	}
+	detector, err := detect.NewEngine(rules)
+	if err != nil {
+		return nil, errors.Wrap(err, "detect engine")
+	}
+
 	a := &App{
 		log:       lg,
 		telemetry: telemetry,
 		servers:   servers,
+		redactor:  redact.New(redactOptions),
+		tracker: conn.NewTracker(conn.TrackerOptions{
+			IdleTimeout: connIdleTimeout,
+		}),
+		recorder: baseline.NewRecorder(baseline.RecorderOptions{}),
+		detector: detector,
+		findings: make(chan detect.Finding, 1000),
+	}
+	if v := os.Getenv("DETECT_WEBHOOK_URL"); v != "" {
+		a.webhook = detect.NewWebhook(v, &http.Client{Timeout: 10 * time.Second})
+	}
+	if aggregationWindow > 0 {
+		a.aggregator = flow.NewAggregator(aggregationWindow, flow.DefaultMaxGroups)
 	}
 	lg.Info("Configured",
 		zap.Int("servers", len(servers)),
+		zap.Duration("flow.aggregation.window", aggregationWindow),
+		zap.Duration("conn.idle_timeout", connIdleTimeout),
+		zap.Int("detect.rules", detector.Len()),
+		zap.Bool("detect.webhook", a.webhook != nil),
+		zap.Strings("redact.headers.allow", redactOptions.AllowHeaders),
+		zap.Strings("redact.headers.deny", redactOptions.DenyHeaders),
+		zap.Stringer("redact.url_params", redactOptions.URLParams),
 	)
 	meter := telemetry.MeterProvider().Meter("")
 	adapter := otelsync.NewAdapter(meter)
-	var err error
 	if a.metrics.OffsetCommited, err = adapter.GaugeInt64("vega.ingest.offset.commited"); err != nil {
 		return nil, errors.Wrap(err, "metric adapter gauge")
 	}
@@ -92,6 +166,11 @@ func (a *App) Run(ctx context.Context) error {
 	g, ctx := errgroup.WithContext(ctx)
 	a.consume(ctx, g)
 	a.ingest(ctx, g)
+	if a.webhook != nil {
+		g.Go(func() error {
+			return a.notify(ctx)
+		})
+	}
 	return g.Wait()
 }
 
@@ -130,20 +209,127 @@ func (a *App) ingest(ctx context.Context, g *errgroup.Group) {
 	}
 }
 
+// redact masks sensitive L7 data of flow before it is stored.
+func (a *App) redact(f *observer.Flow) {
+	s := a.redactor.Flow(f)
+	if s.Total() == 0 {
+		return
+	}
+	ctx := context.Background()
+	if s.Headers > 0 {
+		a.metrics.Redactions.Add(ctx, int64(s.Headers), metric.WithAttributes(attribute.String("kind", "header")))
+	}
+	if s.URLParams > 0 {
+		a.metrics.Redactions.Add(ctx, int64(s.URLParams), metric.WithAttributes(attribute.String("kind", "url_param")))
+	}
+}
+
+// detect appends findings of tetragon event and queues them for webhook.
+func (a *App) detect(t *detect.Table, e *tetragon.GetEventsResponse) error {
+	ctx := context.Background()
+	for _, f := range a.detector.Eval(e) {
+		if err := t.Append(f); err != nil {
+			return errors.Wrap(err, "append finding")
+		}
+		a.metrics.Findings.Add(ctx, 1, metric.WithAttributes(
+			attribute.String("rule", f.Rule),
+			attribute.String("severity", string(f.Severity)),
+		))
+		if a.webhook == nil {
+			continue
+		}
+		select {
+		case a.findings <- f:
+		default:
+			a.metrics.FindingsDropped.Add(ctx, 1)
+		}
+	}
+	return nil
+}
+
+// notify sends queued findings to webhook in batches.
+func (a *App) notify(ctx context.Context) error {
+	const (
+		notifyInterval = time.Second
+		notifyMaxBatch = 100
+	)
+	ticker := time.NewTicker(notifyInterval)
+	defer ticker.Stop()
+
+	var batch []detect.Finding
+	send := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := a.webhook.Send(ctx, batch); err != nil {
+			a.log.Warn("Send findings", zap.Error(err), zap.Int("count", len(batch)))
+			a.metrics.FindingsDropped.Add(ctx, int64(len(batch)))
+		}
+		batch = batch[:0]
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case f := <-a.findings:
+			batch = append(batch, f)
+			if len(batch) >= notifyMaxBatch {
+				send()
+			}
+		case <-ticker.C:
+			send()
+		}
+	}
+}
+
+// appendFlow appends direct and inverse rows of flows group, or of single
+// flow if group counts are zero.
+func appendFlow(t *flow.Table, g *flow.Aggregate) error {
+	f := g.Flow
+	index := flow.Peer{
+		Kubernetes: flow.RowKubernetes{
+			Namespace: f.GetSource().GetNamespace(),
+			Pod:       f.GetSource().GetPodName(),
+		},
+	}
+	peer := flow.Peer{
+		Kubernetes: flow.RowKubernetes{
+			Namespace: f.GetDestination().GetNamespace(),
+			Pod:       f.GetDestination().GetPodName(),
+		},
+	}
+
+	if err := t.Append(g.Row(index, peer, false)); err != nil {
+		return errors.Wrap(err, "append index")
+	}
+	if err := t.Append(g.Row(peer, index, true)); err != nil {
+		return errors.Wrap(err, "append inverse")
+	}
+
+	return nil
+}
+
 func (a *App) initIngesters() {
 	const (
-		tetragonName = "tetragon"
-		hubbleName   = "hubble"
+		tetragonName    = "tetragon"
+		hubbleName      = "hubble"
+		connectionsName = "connections"
+		findingsName    = "findings"
+		baselineName    = "baseline"
+		// hubbleProcessName is view of hubble flows attributed to
+		// processes from tetragon.
+		hubbleProcessName = "hubble_process"
 	)
 	a.ingesters = append(a.ingesters,
 		NewIngester[*tetragon.GetEventsResponse, *sec.Table](IngesterOptions[*tetragon.GetEventsResponse, *sec.Table]{
-			Metrics:   a.metrics,
-			Telemetry: a.telemetry,
-			Servers:   a.servers,
-			TableName: tetragonName,
-			Subject:   tetragonName,
-			DDL:       sec.NewDDL(tetragonName),
-			NewTable:  sec.NewTable,
+			Metrics:    a.metrics,
+			Telemetry:  a.telemetry,
+			Servers:    a.servers,
+			TableName:  tetragonName,
+			Subject:    tetragonName,
+			DDL:        sec.NewDDL(tetragonName),
+			Migrations: sec.NewMigrations(tetragonName),
+			NewTable:   sec.NewTable,
 			AppendEntry: func(t *sec.Table, e *Entry[*tetragon.GetEventsResponse]) error {
 				return t.Append(sec.Row{Res: e.Res})
 			},
@@ -159,49 +345,103 @@ func (a *App) initIngesters() {
 			TableName: hubbleName,
 			Subject:   hubbleName,
 			DDL:       flow.NewDDL(hubbleName),
-			NewTable:  flow.NewTable,
+			// Tetragon table is set up first, so view can be created.
+			Migrations: append(flow.NewMigrations(hubbleName),
+				correlate.NewViewDDL(hubbleProcessName, hubbleName, tetragonName),
+			),
+			NewTable: flow.NewTable,
 			AppendEntry: func(t *flow.Table, e *Entry[*observer.GetFlowsResponse]) error {
 				f := e.Res.GetFlow()
 				if f == nil {
 					// Skip.
 					return nil
 				}
-
-				index := flow.Peer{
-					Kubernetes: flow.RowKubernetes{
-						Namespace: f.GetSource().GetNamespace(),
-						Pod:       f.GetSource().GetPodName(),
-					},
-				}
-				peer := flow.Peer{
-					Kubernetes: flow.RowKubernetes{
-						Namespace: f.GetDestination().GetNamespace(),
-						Pod:       f.GetDestination().GetPodName(),
-					},
+				a.redact(f)
+				if a.aggregator != nil && a.aggregator.Add(time.Now(), f) {
+					// Appended on flush.
+					return nil
 				}
-
-				if err := t.Append(flow.Row{
-					Raw:   f,
-					Index: index,
-					Peer:  peer,
-				}); err != nil {
-					return errors.Wrap(err, "append index")
+				return appendFlow(t, &flow.Aggregate{Flow: f})
+			},
+			Flush: func(t *flow.Table, now time.Time) error {
+				if a.aggregator == nil {
+					return nil
 				}
-				if err := t.Append(flow.Row{
-					Raw:     f,
-					Index:   peer,
-					Peer:    index,
-					Inverse: true,
-				}); err != nil {
-					return errors.Wrap(err, "append inverse")
+				return a.aggregator.Flush(now, func(g *flow.Aggregate) error {
+					if g.Flows > 1 {
+						a.metrics.FlowsAggregated.Add(context.Background(), int64(g.Flows-1))
+					}
+					return appendFlow(t, g)
+				})
+			},
+			NewMessage: func() *observer.GetFlowsResponse {
+				return &observer.GetFlowsResponse{}
+			},
+			Log: a.log.With(zap.String("ingester", hubbleName)),
+		}),
+		NewIngester[*observer.GetFlowsResponse, *conn.Table](IngesterOptions[*observer.GetFlowsResponse, *conn.Table]{
+			Metrics:    a.metrics,
+			Telemetry:  a.telemetry,
+			Servers:    a.servers,
+			TableName:  connectionsName,
+			Subject:    hubbleName,
+			DDL:        conn.NewDDL(connectionsName),
+			Migrations: conn.NewMigrations(connectionsName),
+			NewTable:   conn.NewTable,
+			AppendEntry: func(t *conn.Table, e *Entry[*observer.GetFlowsResponse]) error {
+				if f := e.Res.GetFlow(); f != nil {
+					// Appended on flush, when connection is closed.
+					a.tracker.Add(time.Now(), f)
 				}
-
 				return nil
 			},
+			Flush: func(t *conn.Table, now time.Time) error {
+				return a.tracker.Flush(now, t.Append)
+			},
+			Shutdown: func(t *conn.Table) error {
+				return a.tracker.Shutdown(t.Append)
+			},
 			NewMessage: func() *observer.GetFlowsResponse {
 				return &observer.GetFlowsResponse{}
 			},
-			Log: a.log.With(zap.String("ingester", hubbleName)),
+			Log: a.log.With(zap.String("ingester", connectionsName)),
+		}),
+		NewIngester[*tetragon.GetEventsResponse, *detect.Table](IngesterOptions[*tetragon.GetEventsResponse, *detect.Table]{
+			Metrics:   a.metrics,
+			Telemetry: a.telemetry,
+			Servers:   a.servers,
+			TableName: findingsName,
+			Subject:   tetragonName,
+			DDL:       detect.NewDDL(findingsName),
+			NewTable:  detect.NewTable,
+			AppendEntry: func(t *detect.Table, e *Entry[*tetragon.GetEventsResponse]) error {
+				return a.detect(t, e.Res)
+			},
+			NewMessage: func() *tetragon.GetEventsResponse {
+				return &tetragon.GetEventsResponse{}
+			},
+			Log: a.log.With(zap.String("ingester", findingsName)),
+		}),
+		NewIngester[*tetragon.GetEventsResponse, *baseline.Table](IngesterOptions[*tetragon.GetEventsResponse, *baseline.Table]{
+			Metrics:   a.metrics,
+			Telemetry: a.telemetry,
+			Servers:   a.servers,
+			TableName: baselineName,
+			Subject:   tetragonName,
+			DDL:       baseline.NewDDL(baselineName),
+			// Baseline is learned once per image and should outlive events.
+			NoTTL:    true,
+			NewTable: baseline.NewTable,
+			AppendEntry: func(t *baseline.Table, e *Entry[*tetragon.GetEventsResponse]) error {
+				if v, ok := a.recorder.Observe(e.Res); ok {
+					return t.Append(v)
+				}
+				return nil
+			},
+			NewMessage: func() *tetragon.GetEventsResponse {
+				return &tetragon.GetEventsResponse{}
+			},
+			Log: a.log.With(zap.String("ingester", baselineName)),
 		}),
 	)
 }
@@ -8,6 +8,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/ClickHouse/ch-go"
+	"github.com/ClickHouse/ch-go/chpool"
 	"github.com/go-faster/errors"
 	"github.com/go-faster/sdk/app"
 	"github.com/go-faster/sdk/zctx"
@@ -15,10 +17,18 @@ import (
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/go-faster/vega"
 	"github.com/go-faster/vega/internal/api"
+	"github.com/go-faster/vega/internal/auth"
+	"github.com/go-faster/vega/internal/cli"
 	"github.com/go-faster/vega/internal/kube"
+	"github.com/go-faster/vega/internal/loki"
+	"github.com/go-faster/vega/internal/notify"
 	"github.com/go-faster/vega/internal/oas"
 	"github.com/go-faster/vega/internal/promapi"
+	"github.com/go-faster/vega/internal/promproxy"
+	"github.com/go-faster/vega/internal/sse"
+	"github.com/go-faster/vega/internal/tempo"
 )
 
 func main() {
@@ -62,28 +72,112 @@ func main() {
 		if err != nil {
 			return errors.Wrap(err, "create client")
 		}
+		chPool, err := chpool.New(ctx, chpool.Options{
+			ClientOptions: ch.Options{
+				Address:     os.Getenv("CLICKHOUSE_ADDR"),
+				Database:    os.Getenv("CLICKHOUSE_DB"),
+				User:        os.Getenv("CLICKHOUSE_USER"),
+				Password:    os.Getenv("CLICKHOUSE_PASSWORD"),
+				Logger:      lg.Named("ch"),
+				Compression: ch.CompressionLZ4,
+
+				OpenTelemetryInstrumentation: true,
+
+				MeterProvider:  t.MeterProvider(),
+				TracerProvider: t.TracerProvider(),
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "clickhouse")
+		}
+		defer chPool.Close()
+
+		httpClient := &http.Client{
+			Transport: otelhttp.NewTransport(http.DefaultTransport,
+				otelhttp.WithMeterProvider(t.MeterProvider()),
+				otelhttp.WithTracerProvider(t.TracerProvider()),
+				otelhttp.WithPropagators(t.TextMapPropagator()),
+			),
+		}
+		var lokiClient *loki.Client
+		if lokiURL := os.Getenv("LOKI_URL"); lokiURL != "" {
+			lokiClient = loki.NewClient(lokiURL, httpClient)
+		} else {
+			lg.Warn("LOKI_URL is not set, log history is limited to current containers")
+		}
+		var tempoClient *tempo.Client
+		if tempoURL := os.Getenv("TEMPO_URL"); tempoURL != "" {
+			tempoClient = tempo.NewClient(tempoURL, httpClient)
+		} else {
+			lg.Warn("TEMPO_URL is not set, traces are limited to flows")
+		}
+		var alertmanager *notify.Alertmanager
+		if alertmanagerURL := os.Getenv("ALERTMANAGER_URL"); alertmanagerURL != "" {
+			alertmanager = notify.NewAlertmanager(alertmanagerURL, httpClient)
+		} else {
+			lg.Warn("ALERTMANAGER_URL is not set, findings are not sent to Alertmanager")
+		}
+
 		handler := api.NewHandler(
 			kubeClient,
 			client,
+			chPool,
+			lokiClient,
+			tempoClient,
 			t.TracerProvider(),
 		)
-		srv, err := oas.NewServer(handler)
+		security := api.NewSecurity(nil)
+		if cli.BoolEnv(vega.EnvAuthDisabled) {
+			lg.Warn("Authentication is disabled")
+		} else {
+			issuer := os.Getenv(vega.EnvOIDCIssuer)
+			if issuer == "" {
+				return errors.Errorf("%s is required (or set %s for local development)",
+					vega.EnvOIDCIssuer, vega.EnvAuthDisabled,
+				)
+			}
+			security = api.NewSecurity(auth.NewVerifier(auth.Options{
+				Issuer:       issuer,
+				DiscoveryURL: os.Getenv(vega.EnvOIDCDiscoveryURL),
+				ClientID:     os.Getenv(vega.EnvOIDCClientID),
+				CLIClientID:  os.Getenv(vega.EnvOIDCCLIClientID),
+				Admins:       auth.ParseAdminList(os.Getenv(vega.EnvAdminList)),
+				HTTP: &http.Client{
+					Transport: otelhttp.NewTransport(http.DefaultTransport,
+						otelhttp.WithMeterProvider(t.MeterProvider()),
+						otelhttp.WithTracerProvider(t.TracerProvider()),
+						otelhttp.WithPropagators(t.TextMapPropagator()),
+					),
+				},
+			}))
+		}
+		srv, err := oas.NewServer(handler, security)
 		if err != nil {
 			return errors.Wrap(err, "create server")
 		}
+		promSrv, err := promapi.NewServer(promproxy.New(client, kubeClient),
+			promapi.WithPathPrefix("/prom"),
+			promapi.WithTracerProvider(t.TracerProvider()),
+			promapi.WithMeterProvider(t.MeterProvider()),
+		)
+		if err != nil {
+			return errors.Wrap(err, "create prometheus proxy server")
+		}
+		mux := http.NewServeMux()
+		mux.Handle("/prom/", security.Authenticate(promSrv))
+		mux.Handle("/", security.Middleware(sse.FlushHandler(srv)))
 		h := &http.Server{
 			Addr: ":8080",
-			Handler: otelhttp.NewHandler(srv, "",
+			Handler: otelhttp.NewHandler(mux, "",
 				otelhttp.WithMeterProvider(t.MeterProvider()),
 				otelhttp.WithTracerProvider(t.TracerProvider()),
 				otelhttp.WithPropagators(t.TextMapPropagator()),
 				otelhttp.WithSpanNameFormatter(func(operation string, r *http.Request) string {
-					route, ok := srv.FindPath(r.Method, r.URL)
-					if !ok {
-						return operation
+					if route, ok := srv.FindPath(r.Method, r.URL); ok && route.Name() != "" {
+						return route.Name()
 					}
-					if v := route.Name(); v != "" {
-						return v
+					if route, ok := promSrv.FindPath(r.Method, r.URL); ok && route.Name() != "" {
+						return "prom." + route.Name()
 					}
 					return operation
 				}),
@@ -102,6 +196,16 @@ func main() {
 				return h.Shutdown(t.BaseContext())
 			}
 		})
+		if alertmanager != nil {
+			g.Go(func() error {
+				ctx, cancel := context.WithCancel(ctx)
+				defer cancel()
+				stop := context.AfterFunc(t.ShutdownContext(), cancel)
+				defer stop()
+				runNotifier(ctx, lg.Named("notify"), handler, alertmanager)
+				return nil
+			})
+		}
 		g.Go(func() error {
 			lg.Info("Server started", zap.String("addr", h.Addr))
 			if !errors.Is(h.ListenAndServe(), http.ErrServerClosed) {
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/go-faster/vega/internal/api"
+	"github.com/go-faster/vega/internal/notify"
+)
+
+// runNotifier periodically sends findings of applications to Alertmanager
+// until context is done.
+func runNotifier(ctx context.Context, lg *zap.Logger, h *api.Handler, am *notify.Alertmanager) {
+	const (
+		notifyInterval = time.Minute
+		// notifyWindow is time that finding is firing after it was
+		// observed.
+		notifyWindow = 15 * time.Minute
+	)
+	n := notify.New(notify.Options{
+		// Alerts are resolved by Alertmanager if updates fail for a while.
+		ResolveTimeout: 5 * notifyInterval,
+	})
+	ticker := time.NewTicker(notifyInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		now := time.Now()
+		findings, failed, err := h.Findings(ctx, now, notifyWindow)
+		if err != nil {
+			lg.Warn("Collect findings", zap.Error(err))
+			continue
+		}
+		var failedApps []notify.App
+		for app, err := range failed {
+			lg.Warn("Collect application findings",
+				zap.String("namespace", app.Namespace),
+				zap.String("app", app.Name),
+				zap.Error(err),
+			)
+			failedApps = append(failedApps, app)
+		}
+		alerts := n.Update(now, findings, failedApps)
+		if err := am.Send(ctx, alerts); err != nil {
+			lg.Warn("Send alerts", zap.Error(err), zap.Int("count", len(alerts)))
+			continue
+		}
+		lg.Debug("Sent alerts", zap.Int("count", len(alerts)), zap.Int("firing", n.Len()))
+	}
+}
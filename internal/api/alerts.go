@@ -0,0 +1,156 @@
+package api
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/go-faster/errors"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/go-faster/vega/internal/oas"
+	"github.com/go-faster/vega/internal/promapi"
+)
+
+// alertMatcher matches alert labels against application.
+type alertMatcher struct {
+	namespace string
+	pods      map[string]struct{}
+}
+
+func newAlertMatcher(app oas.Application, pods []v1.Pod) alertMatcher {
+	m := alertMatcher{
+		namespace: app.Namespace,
+		pods:      make(map[string]struct{}, len(pods)),
+	}
+	for _, pod := range pods {
+		m.pods[pod.Name] = struct{}{}
+	}
+	return m
+}
+
+// match reports whether labels belong to application.
+//
+// Labels without pod match whole application namespace, labels with pod
+// match only application pods.
+func (m alertMatcher) match(labels promapi.LabelSet) bool {
+	ns, hasNamespace := labels["namespace"]
+	if hasNamespace && ns != m.namespace {
+		return false
+	}
+	if pod, ok := labels["pod"]; ok {
+		_, found := m.pods[pod]
+		return found
+	}
+	return hasNamespace
+}
+
+var alertStateOrder = map[oas.AlertRuleState]int{
+	oas.AlertRuleStateFiring:   0,
+	oas.AlertRuleStatePending:  1,
+	oas.AlertRuleStateInactive: 2,
+}
+
+func convertAlertRule(group string, r promapi.AlertingRule, m alertMatcher) (oas.AlertRule, bool) {
+	out := oas.AlertRule{
+		Name:        r.Name,
+		Group:       group,
+		State:       oas.AlertRuleStateInactive,
+		Query:       r.Query,
+		Health:      string(r.Health),
+		Labels:      oas.LabelMap(r.Labels),
+		Annotations: oas.LabelMap(r.Annotations),
+		Alerts:      []oas.AlertInstance{},
+	}
+	if out.Labels == nil {
+		out.Labels = oas.LabelMap{}
+	}
+	if out.Annotations == nil {
+		out.Annotations = oas.LabelMap{}
+	}
+	if r.Duration != "" {
+		out.Duration = oas.NewOptString(r.Duration)
+	}
+	if r.LastError != "" {
+		out.LastError = oas.NewOptString(r.LastError)
+	}
+	for _, a := range r.Alerts {
+		if !m.match(a.Labels) {
+			continue
+		}
+		alert := oas.AlertInstance{
+			State:       oas.AlertInstanceStatePending,
+			Since:       oas.OptDateTime{Value: a.ActiveAt.Value, Set: a.ActiveAt.Set},
+			Labels:      oas.LabelMap(a.Labels),
+			Annotations: oas.LabelMap(a.Annotations),
+		}
+		if a.Value != "" {
+			alert.Value = oas.NewOptString(a.Value)
+		}
+		if alert.Annotations == nil {
+			alert.Annotations = oas.LabelMap{}
+		}
+		// State of rule is derived from matched alerts only, other
+		// applications may share the rule.
+		if a.State == string(oas.AlertInstanceStateFiring) {
+			alert.State = oas.AlertInstanceStateFiring
+			out.State = oas.AlertRuleStateFiring
+		} else if out.State == oas.AlertRuleStateInactive {
+			out.State = oas.AlertRuleStatePending
+		}
+		out.Alerts = append(out.Alerts, alert)
+	}
+	if len(out.Alerts) == 0 && !m.match(r.Labels) {
+		return out, false
+	}
+	return out, true
+}
+
+func (h *Handler) GetApplicationAlerts(ctx context.Context, params oas.GetApplicationAlertsParams) (*oas.ApplicationAlerts, error) {
+	app, err := h.getApplication(ctx, params.Name)
+	if err != nil {
+		return nil, err
+	}
+	pods, err := h.getApplicationPods(ctx, app)
+	if err != nil {
+		return nil, errors.Wrap(err, "get pods")
+	}
+	res, err := h.getRules(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "get rules")
+	}
+
+	out := &oas.ApplicationAlerts{
+		Name:      app.Name,
+		Namespace: app.Namespace,
+		Rules:     []oas.AlertRule{},
+	}
+	m := newAlertMatcher(app, pods)
+	for _, g := range res.Data.Groups {
+		for _, r := range g.Rules {
+			if r.Type != promapi.AlertingRuleRule {
+				continue
+			}
+			if rule, ok := convertAlertRule(g.Name.Or(""), r.AlertingRule, m); ok {
+				out.Rules = append(out.Rules, rule)
+			}
+		}
+	}
+	slices.SortFunc(out.Rules, func(a, b oas.AlertRule) int {
+		return cmp.Or(
+			cmp.Compare(alertStateOrder[a.State], alertStateOrder[b.State]),
+			cmp.Compare(a.Name, b.Name),
+			cmp.Compare(a.Group, b.Group),
+		)
+	})
+	return out, nil
+}
+
+func (h *Handler) getRules(ctx context.Context) (*promapi.RulesResponse, error) {
+	ctx, span := h.trace.Start(ctx, "getRules")
+	defer span.End()
+
+	return h.prom.GetRules(ctx, promapi.GetRulesParams{
+		Type: promapi.NewOptGetRulesType(promapi.GetRulesTypeAlert),
+	})
+}
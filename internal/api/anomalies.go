@@ -0,0 +1,247 @@
+package api
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ClickHouse/ch-go"
+	"github.com/ClickHouse/ch-go/proto"
+	"github.com/go-faster/errors"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/go-faster/vega/internal/baseline"
+	"github.com/go-faster/vega/internal/flow"
+	"github.com/go-faster/vega/internal/oas"
+)
+
+// maxExecGroups limits number of exec groups queried.
+const maxExecGroups = 5000
+
+// execGroup is group of execs with the same image, binary and parent binary.
+type execGroup struct {
+	baseline.Entry
+	Count     int64
+	FirstSeen time.Time
+	// Pod, Container, ExecID and Args are of the last exec.
+	Pod       string
+	Container string
+	ExecID    string
+	Args      string
+}
+
+// getExecGroups returns execs of pods since start, grouped by image, binary
+// and parent binary. Entry time is time of the last exec.
+func (h *Handler) getExecGroups(ctx context.Context, namespace string, pods []v1.Pod, start time.Time) ([]execGroup, error) {
+	ctx, span := h.trace.Start(ctx, "getExecGroups")
+	defer span.End()
+
+	var (
+		image        = new(proto.ColStr).LowCardinality()
+		binary       proto.ColStr
+		parentBinary proto.ColStr
+		count        proto.ColUInt64
+		firstSeen    = new(proto.ColDateTime64).WithPrecision(proto.PrecisionNano)
+		lastSeen     = new(proto.ColDateTime64).WithPrecision(proto.PrecisionNano)
+		pod          proto.ColStr
+		container    proto.ColStr
+		execID       proto.ColStr
+		args         proto.ColStr
+
+		out []execGroup
+	)
+	query := fmt.Sprintf(`SELECT k8s_image, process_binary, parent_process_binary,
+    count() AS count,
+    min(timestamp) AS first_seen,
+    max(timestamp) AS last_seen,
+    CAST(argMax(k8s_pod, timestamp), 'String') AS last_pod,
+    CAST(argMax(k8s_container, timestamp), 'String') AS last_container,
+    argMax(process_exec_id, timestamp) AS last_exec_id,
+    argMax(process_args, timestamp) AS last_args
+FROM %s
+WHERE event_type = 'ProcessExec' AND k8s_image != '' AND %s
+GROUP BY k8s_image, process_binary, parent_process_binary
+ORDER BY last_seen DESC
+LIMIT %d`,
+		secTable,
+		windowCondition(namespace, pods, start),
+		maxExecGroups,
+	)
+	if err := h.ch.Do(ctx, ch.Query{
+		Body: query,
+		Result: proto.Results{
+			{Name: "k8s_image", Data: image},
+			{Name: "process_binary", Data: &binary},
+			{Name: "parent_process_binary", Data: &parentBinary},
+			{Name: "count", Data: &count},
+			{Name: "first_seen", Data: firstSeen},
+			{Name: "last_seen", Data: lastSeen},
+			{Name: "last_pod", Data: &pod},
+			{Name: "last_container", Data: &container},
+			{Name: "last_exec_id", Data: &execID},
+			{Name: "last_args", Data: &args},
+		},
+		OnResult: func(ctx context.Context, block proto.Block) error {
+			for i := 0; i < count.Rows(); i++ {
+				out = append(out, execGroup{
+					Entry: baseline.Entry{
+						Time:         lastSeen.Row(i),
+						Image:        image.Row(i),
+						Binary:       binary.Row(i),
+						ParentBinary: parentBinary.Row(i),
+					},
+					Count:     int64(count.Row(i)), //#nosec G115
+					FirstSeen: firstSeen.Row(i),
+					Pod:       pod.Row(i),
+					Container: container.Row(i),
+					ExecID:    execID.Row(i),
+					Args:      args.Row(i),
+				})
+			}
+			return nil
+		},
+	}); err != nil {
+		return nil, errors.Wrap(err, "query")
+	}
+	return out, nil
+}
+
+// getBaseline returns baseline of images.
+func (h *Handler) getBaseline(ctx context.Context, images []string, training time.Duration) (*baseline.Baseline, error) {
+	ctx, span := h.trace.Start(ctx, "getBaseline")
+	defer span.End()
+
+	if len(images) == 0 {
+		return baseline.New(training, nil), nil
+	}
+	quoted := make([]string, 0, len(images))
+	for _, image := range images {
+		quoted = append(quoted, flow.Quote(image))
+	}
+
+	var (
+		image        = new(proto.ColStr).LowCardinality()
+		binary       proto.ColStr
+		parentBinary proto.ColStr
+		firstSeen    = new(proto.ColDateTime64).WithPrecision(proto.PrecisionNano)
+
+		entries []baseline.Entry
+	)
+	// Entry is recorded again after vega-ingest restart, so the first one
+	// is used.
+	query := fmt.Sprintf(`SELECT k8s_image, process_binary, parent_process_binary,
+    min(timestamp) AS first_seen
+FROM %s
+WHERE k8s_image IN (%s)
+GROUP BY k8s_image, process_binary, parent_process_binary`,
+		baselineTable,
+		strings.Join(quoted, ", "),
+	)
+	if err := h.ch.Do(ctx, ch.Query{
+		Body: query,
+		Result: proto.Results{
+			{Name: "k8s_image", Data: image},
+			{Name: "process_binary", Data: &binary},
+			{Name: "parent_process_binary", Data: &parentBinary},
+			{Name: "first_seen", Data: firstSeen},
+		},
+		OnResult: func(ctx context.Context, block proto.Block) error {
+			for i := 0; i < firstSeen.Rows(); i++ {
+				entries = append(entries, baseline.Entry{
+					Time:         firstSeen.Row(i),
+					Image:        image.Row(i),
+					Binary:       binary.Row(i),
+					ParentBinary: parentBinary.Row(i),
+				})
+			}
+			return nil
+		},
+	}); err != nil {
+		return nil, errors.Wrap(err, "query")
+	}
+	return baseline.New(training, entries), nil
+}
+
+// GetApplicationAnomalies implements getApplicationAnomalies operation.
+func (h *Handler) GetApplicationAnomalies(ctx context.Context, params oas.GetApplicationAnomaliesParams) (*oas.AnomalyReport, error) {
+	app, err := h.getApplication(ctx, params.Name)
+	if err != nil {
+		return nil, err
+	}
+	pods, err := h.getApplicationPods(ctx, app)
+	if err != nil {
+		return nil, errors.Wrap(err, "get pods")
+	}
+	var (
+		window   = time.Duration(params.Window.Or(3600)) * time.Second
+		training = time.Duration(params.Training.Or(3600)) * time.Second
+		now      = time.Now()
+	)
+	out := &oas.AnomalyReport{
+		Name:      app.Name,
+		Namespace: app.Namespace,
+		Start:     now.Add(-window),
+		End:       now,
+		Images:    []oas.ImageBaseline{},
+		Anomalies: []oas.Anomaly{},
+	}
+	if len(pods) == 0 {
+		return out, nil
+	}
+
+	groups, err := h.getExecGroups(ctx, app.Namespace, pods, out.Start)
+	if err != nil {
+		return nil, errors.Wrap(err, "get exec groups")
+	}
+	var images []string
+	for _, g := range groups {
+		if !slices.Contains(images, g.Image) {
+			images = append(images, g.Image)
+		}
+	}
+	b, err := h.getBaseline(ctx, images, training)
+	if err != nil {
+		return nil, errors.Wrap(err, "get baseline")
+	}
+
+	for _, img := range b.Images() {
+		out.Images = append(out.Images, oas.ImageBaseline{
+			Image:        img.Image,
+			FirstSeen:    img.FirstSeen,
+			TrainedUntil: img.TrainedUntil,
+			Training:     img.Training(now),
+			Binaries:     img.Binaries(),
+			Pairs:        img.Pairs(),
+		})
+	}
+	for _, g := range groups {
+		kind := b.Check(g.Entry)
+		if kind == baseline.KindNone {
+			continue
+		}
+		a := oas.Anomaly{
+			Kind:      oas.AnomalyKind(kind),
+			Image:     g.Image,
+			Binary:    g.Binary,
+			Count:     g.Count,
+			FirstSeen: g.FirstSeen,
+			LastSeen:  g.Time,
+			Pod:       g.Pod,
+			Container: g.Container,
+			ExecID:    g.ExecID,
+			Arguments: g.Args,
+		}
+		if g.ParentBinary != "" {
+			a.ParentBinary = oas.NewOptString(g.ParentBinary)
+		}
+		out.Anomalies = append(out.Anomalies, a)
+	}
+	// New binaries first.
+	slices.SortStableFunc(out.Anomalies, func(a, b oas.Anomaly) int {
+		return cmp.Compare(a.Kind, b.Kind)
+	})
+	return out, nil
+}
@@ -0,0 +1,61 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-faster/errors"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/go-faster/vega/internal/netpol"
+	"github.com/go-faster/vega/internal/oas"
+)
+
+// GetApplicationAudit implements getApplicationAudit operation.
+func (h *Handler) GetApplicationAudit(ctx context.Context, params oas.GetApplicationAuditParams) (*oas.AuditReport, error) {
+	app, err := h.getApplication(ctx, params.Name)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		window = time.Duration(params.Window.Or(3600)) * time.Second
+		offset = window
+		now    = time.Now()
+	)
+	if v, ok := params.BaselineOffset.Get(); ok {
+		offset = time.Duration(v) * time.Second
+	}
+	out := &oas.AuditReport{
+		Name:          app.Name,
+		Namespace:     app.Namespace,
+		Start:         now.Add(-window),
+		End:           now,
+		BaselineStart: now.Add(-offset - window),
+		BaselineEnd:   now.Add(-offset),
+		Changes:       []oas.AuditChange{},
+	}
+
+	// Flows are matched by application label, so baseline window includes
+	// pods replaced by rollout.
+	var current, baseline []netpol.Drop
+	g, gCtx := errgroup.WithContext(ctx)
+	g.Go(func() (err error) {
+		current, err = h.getVerdictFlows(gCtx, app, verdictAudit, out.Start, out.End)
+		return errors.Wrap(err, "current")
+	})
+	g.Go(func() (err error) {
+		baseline, err = h.getVerdictFlows(gCtx, app, verdictAudit, out.BaselineStart, out.BaselineEnd)
+		return errors.Wrap(err, "baseline")
+	})
+	if err := g.Wait(); err != nil {
+		return nil, errors.Wrap(err, "get audit flows")
+	}
+	for _, c := range netpol.Diff(current, baseline) {
+		out.Changes = append(out.Changes, oas.AuditChange{
+			Status:        oas.AuditChangeStatus(c.Status),
+			Flows:         convertDrop(c.Drop),
+			BaselineCount: c.BaselineCount,
+		})
+	}
+	return out, nil
+}
@@ -0,0 +1,30 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/go-faster/vega/internal/flow"
+)
+
+// ClickHouse table names, same as in vega-ingest.
+const (
+	flowTable     = "hubble"
+	secTable      = "tetragon"
+	baselineTable = "baseline"
+	findingsTable = "findings"
+)
+
+// podsCondition returns SQL condition that matches rows of given pods.
+func podsCondition(nsColumn, podColumn, namespace string, pods []v1.Pod) string {
+	names := make([]string, 0, len(pods))
+	for _, pod := range pods {
+		names = append(names, flow.Quote(pod.Name))
+	}
+	return fmt.Sprintf("%s = %s AND %s IN (%s)",
+		nsColumn, flow.Quote(namespace),
+		podColumn, strings.Join(names, ", "),
+	)
+}
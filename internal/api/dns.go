@@ -0,0 +1,207 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ClickHouse/ch-go"
+	"github.com/ClickHouse/ch-go/proto"
+	"github.com/go-faster/errors"
+	"golang.org/x/sync/errgroup"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/go-faster/vega/internal/flow"
+	"github.com/go-faster/vega/internal/oas"
+)
+
+const (
+	// maxDNSGroups limits number of domains and external destinations queried.
+	maxDNSGroups = 200
+	// slowLookup is latency of DNS lookup that is considered slow.
+	slowLookup = 100 * time.Millisecond
+)
+
+// getDomains returns domains resolved by pods since start.
+func (h *Handler) getDomains(ctx context.Context, namespace string, pods []v1.Pod, start time.Time) ([]oas.DNSDomain, error) {
+	ctx, span := h.trace.Start(ctx, "getDomains")
+	defer span.End()
+
+	var (
+		query    proto.ColStr
+		lookups  proto.ColUInt64
+		nxdomain proto.ColUInt64
+		servfail proto.ColUInt64
+		failed   proto.ColUInt64
+		slow     proto.ColUInt64
+		p95      proto.ColFloat64
+		ips      = proto.NewArray[string](new(proto.ColStr))
+
+		out = []oas.DNSDomain{}
+	)
+	// Response is sent to the client, so index pod is destination and row
+	// is INVERSE, see vega-ingest.
+	if err := h.ch.Do(ctx, ch.Query{
+		Body: fmt.Sprintf(`SELECT
+    lower(l7_dns_query) AS query,
+    count() AS lookups,
+    countIf(l7_dns_response_code = 3) AS nxdomain,
+    countIf(l7_dns_response_code = 2) AS servfail,
+    countIf(l7_dns_response_code NOT IN (0, 2, 3)) AS failed,
+    countIf(l7_latency_ns > %d) AS slow,
+    quantile(0.95)(l7_latency_ns) / 1e6 AS p95,
+    groupUniqArrayArray(8)(l7_dns_response_ips) AS ips
+FROM %s
+WHERE %s AND l7_protocol = 'DNS' AND l7_flow_type = 'RESPONSE' AND direction = 'INVERSE'
+GROUP BY query
+ORDER BY lookups DESC, query
+LIMIT %d`, slowLookup.Nanoseconds(), flowTable, windowCondition(namespace, pods, start), maxDNSGroups),
+		Result: proto.Results{
+			{Name: "query", Data: &query},
+			{Name: "lookups", Data: &lookups},
+			{Name: "nxdomain", Data: &nxdomain},
+			{Name: "servfail", Data: &servfail},
+			{Name: "failed", Data: &failed},
+			{Name: "slow", Data: &slow},
+			{Name: "p95", Data: &p95},
+			{Name: "ips", Data: ips},
+		},
+		OnResult: func(ctx context.Context, block proto.Block) error {
+			for i := 0; i < query.Rows(); i++ {
+				out = append(out, oas.DNSDomain{
+					Query:        query.Row(i),
+					Lookups:      int64(lookups.Row(i)),  //#nosec G115
+					Nxdomain:     int64(nxdomain.Row(i)), //#nosec G115
+					Servfail:     int64(servfail.Row(i)), //#nosec G115
+					Errors:       int64(failed.Row(i)),   //#nosec G115
+					Slow:         int64(slow.Row(i)),     //#nosec G115
+					LatencyP95Ms: p95.Row(i),
+					Ips:          ips.Row(i),
+				})
+			}
+			return nil
+		},
+	}); err != nil {
+		return nil, errors.Wrap(err, "query")
+	}
+	return out, nil
+}
+
+// getEgress returns external destinations reached by application in
+// [start, end).
+//
+// Destinations are matched by application label instead of pods, so
+// destinations reached by previous pods of application are not new.
+func (h *Handler) getEgress(ctx context.Context, app oas.Application, start, end time.Time) ([]oas.EgressDestination, error) {
+	ctx, span := h.trace.Start(ctx, "getEgress")
+	defer span.End()
+
+	var (
+		ip        proto.ColStr
+		names     = proto.NewArray[string](new(proto.ColStr))
+		protocol  proto.ColStr
+		port      proto.ColUInt32
+		flows     proto.ColUInt64
+		firstSeen = new(proto.ColDateTime64).WithPrecision(proto.PrecisionNano)
+		lastSeen  = new(proto.ColDateTime64).WithPrecision(proto.PrecisionNano)
+
+		out = []oas.EgressDestination{}
+	)
+	// Index pod is source for DIRECT rows, see vega-ingest.
+	if err := h.ch.Do(ctx, ch.Query{
+		Body: fmt.Sprintf(`SELECT
+    if(ip_version = 'IPv6', toString(ipv6_dst), toString(ipv4_dst)) AS ip,
+    groupUniqArrayArray(4)(CAST(dst_names, 'Array(String)')) AS names,
+    toString(l4_protocol) AS protocol,
+    l4_dst_port AS port,
+    sumIf(flow_count, timestamp >= fromUnixTimestamp64Nano(toInt64(%[1]d))) AS flows,
+    min(timestamp) AS first_seen,
+    max(timestamp_last) AS last_seen
+FROM %[2]s
+WHERE k8s_ns = %[3]s AND direction = 'DIRECT'
+    AND has(endpoint_src_labels, %[4]s)
+    AND has(endpoint_dst_labels, 'reserved:world')
+    AND NOT ifNull(is_reply, false)
+    AND timestamp < fromUnixTimestamp64Nano(toInt64(%[5]d))
+GROUP BY ip, protocol, port
+HAVING flows > 0
+ORDER BY first_seen >= fromUnixTimestamp64Nano(toInt64(%[1]d)) DESC, flows DESC, ip
+LIMIT %[6]d`,
+			start.UnixNano(),
+			flowTable,
+			flow.Quote(app.Namespace),
+			flow.Quote("k8s:"+appSelector(app.Name)),
+			end.UnixNano(),
+			maxDNSGroups,
+		),
+		Result: proto.Results{
+			{Name: "ip", Data: &ip},
+			{Name: "names", Data: names},
+			{Name: "protocol", Data: &protocol},
+			{Name: "port", Data: &port},
+			{Name: "flows", Data: &flows},
+			{Name: "first_seen", Data: firstSeen},
+			{Name: "last_seen", Data: lastSeen},
+		},
+		OnResult: func(ctx context.Context, block proto.Block) error {
+			for i := 0; i < ip.Rows(); i++ {
+				first := firstSeen.Row(i)
+				out = append(out, oas.EgressDestination{
+					IP:        ip.Row(i),
+					Names:     names.Row(i),
+					Protocol:  protocol.Row(i),
+					Port:      port.Row(i),
+					Flows:     int64(flows.Row(i)), //#nosec G115
+					FirstSeen: first,
+					LastSeen:  lastSeen.Row(i),
+					New:       !first.Before(start),
+				})
+			}
+			return nil
+		},
+	}); err != nil {
+		return nil, errors.Wrap(err, "query")
+	}
+	return out, nil
+}
+
+// GetApplicationDNS implements getApplicationDNS operation.
+func (h *Handler) GetApplicationDNS(ctx context.Context, params oas.GetApplicationDNSParams) (*oas.DNSReport, error) {
+	app, err := h.getApplication(ctx, params.Name)
+	if err != nil {
+		return nil, err
+	}
+	pods, err := h.getApplicationPods(ctx, app)
+	if err != nil {
+		return nil, errors.Wrap(err, "get pods")
+	}
+	window := params.Window.Or(3600)
+	out := &oas.DNSReport{
+		Name:      app.Name,
+		Namespace: app.Namespace,
+		Window:    window,
+		Domains:   []oas.DNSDomain{},
+		Egress:    []oas.EgressDestination{},
+	}
+	if len(pods) == 0 {
+		return out, nil
+	}
+
+	var (
+		now   = time.Now()
+		start = now.Add(-time.Duration(window) * time.Second)
+	)
+	g, gCtx := errgroup.WithContext(ctx)
+	g.Go(func() (err error) {
+		out.Domains, err = h.getDomains(gCtx, app.Namespace, pods, start)
+		return errors.Wrap(err, "get domains")
+	})
+	g.Go(func() (err error) {
+		out.Egress, err = h.getEgress(gCtx, app, start, now)
+		return errors.Wrap(err, "get egress")
+	})
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
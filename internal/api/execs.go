@@ -0,0 +1,89 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ClickHouse/ch-go"
+	"github.com/ClickHouse/ch-go/proto"
+	"github.com/go-faster/errors"
+
+	"github.com/go-faster/vega/internal/oas"
+)
+
+func (h *Handler) GetApplicationExecs(ctx context.Context, params oas.GetApplicationExecsParams) (oas.ProcessExecList, error) {
+	app, err := h.getApplication(ctx, params.Name)
+	if err != nil {
+		return nil, err
+	}
+	pods, err := h.getApplicationPods(ctx, app)
+	if err != nil {
+		return nil, errors.Wrap(err, "get pods")
+	}
+	out := oas.ProcessExecList{}
+	if len(pods) == 0 {
+		return out, nil
+	}
+
+	var (
+		timestamp    = new(proto.ColDateTime64).WithPrecision(proto.PrecisionNano)
+		pod          = new(proto.ColStr).LowCardinality()
+		container    = new(proto.ColStr).LowCardinality()
+		execID       proto.ColStr
+		binary       proto.ColStr
+		args         proto.ColStr
+		uid          proto.ColUInt32
+		parentExecID proto.ColStr
+		parentBinary proto.ColStr
+	)
+	query := fmt.Sprintf(`SELECT timestamp, k8s_pod, k8s_container,
+    process_exec_id, process_binary, process_args, process_uid,
+    parent_process_exec_id, parent_process_binary
+FROM %s
+WHERE event_type = 'ProcessExec' AND %s
+ORDER BY timestamp DESC
+LIMIT %d`,
+		secTable,
+		podsCondition("k8s_ns", "k8s_pod", app.Namespace, pods),
+		params.Limit.Or(100),
+	)
+	if err := h.ch.Do(ctx, ch.Query{
+		Body: query,
+		Result: proto.Results{
+			{Name: "timestamp", Data: timestamp},
+			{Name: "k8s_pod", Data: pod},
+			{Name: "k8s_container", Data: container},
+			{Name: "process_exec_id", Data: &execID},
+			{Name: "process_binary", Data: &binary},
+			{Name: "process_args", Data: &args},
+			{Name: "process_uid", Data: &uid},
+			{Name: "parent_process_exec_id", Data: &parentExecID},
+			{Name: "parent_process_binary", Data: &parentBinary},
+		},
+		OnResult: func(ctx context.Context, block proto.Block) error {
+			for i := 0; i < timestamp.Rows(); i++ {
+				e := oas.ProcessExec{
+					Time:      timestamp.Row(i),
+					Pod:       pod.Row(i),
+					Container: container.Row(i),
+					ExecID:    execID.Row(i),
+					Binary:    binary.Row(i),
+					Arguments: args.Row(i),
+					UID:       uid.Row(i),
+				}
+				if v := parentExecID.Row(i); v != "" {
+					e.ParentExecID = oas.NewOptString(v)
+				}
+				if v := parentBinary.Row(i); v != "" {
+					e.ParentBinary = oas.NewOptString(v)
+				}
+				out = append(out, e)
+			}
+			return nil
+		},
+	}); err != nil {
+		return nil, errors.Wrap(err, "query")
+	}
+
+	return out, nil
+}
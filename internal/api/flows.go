@@ -0,0 +1,149 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ClickHouse/ch-go"
+	"github.com/ClickHouse/ch-go/proto"
+	"github.com/cilium/cilium/api/v1/observer"
+	"github.com/go-faster/errors"
+
+	"github.com/go-faster/vega/internal/flow"
+	"github.com/go-faster/vega/internal/oas"
+)
+
+func convertFlowEndpoint(e *observer.Endpoint, ip string, port uint32) oas.FlowEndpoint {
+	out := oas.FlowEndpoint{
+		IP: ip,
+	}
+	if v := e.GetNamespace(); v != "" {
+		out.Namespace = oas.NewOptString(v)
+	}
+	if v := e.GetPodName(); v != "" {
+		out.Pod = oas.NewOptString(v)
+	}
+	if port != 0 {
+		out.Port = oas.NewOptInt(int(port))
+	}
+	return out
+}
+
+// flowL7 returns short human-readable summary of L7 record.
+func flowL7(l7 *observer.Layer7) string {
+	if v := l7.GetHttp(); v != nil {
+		s := fmt.Sprintf("%s %s %s", v.GetProtocol(), v.GetMethod(), v.GetUrl())
+		if v.GetCode() != 0 {
+			s += fmt.Sprintf(" %d", v.GetCode())
+		}
+		return s
+	}
+	if v := l7.GetDns(); v != nil {
+		s := fmt.Sprintf("DNS %s %s", strings.Join(v.GetQtypes(), ","), v.GetQuery())
+		if l7.GetType() == observer.L7FlowType_RESPONSE {
+			s += fmt.Sprintf(" rcode=%d %s", v.GetRcode(), strings.Join(v.GetIps(), ","))
+		}
+		return s
+	}
+	if v := l7.GetKafka(); v != nil {
+		return fmt.Sprintf("Kafka %s topic=%s error_code=%d", v.GetApiKey(), v.GetTopic(), v.GetErrorCode())
+	}
+	return ""
+}
+
+func convertFlow(f *observer.Flow) oas.Flow {
+	out := oas.Flow{
+		Time:             f.GetTime().AsTime(),
+		Verdict:          f.GetVerdict().String(),
+		TrafficDirection: f.GetTrafficDirection().String(),
+		Protocol:         "UNKNOWN",
+	}
+	if f.GetVerdict() == observer.Verdict_DROPPED {
+		out.DropReason = oas.NewOptString(f.GetDropReasonDesc().String())
+	}
+
+	var srcPort, dstPort uint32
+	switch l4 := f.GetL4(); {
+	case l4.GetTCP() != nil:
+		out.Protocol = "TCP"
+		srcPort, dstPort = l4.GetTCP().GetSourcePort(), l4.GetTCP().GetDestinationPort()
+		flags := l4.GetTCP().GetFlags()
+		for _, v := range []struct {
+			name string
+			set  bool
+		}{
+			{"SYN", flags.GetSYN()},
+			{"ACK", flags.GetACK()},
+			{"FIN", flags.GetFIN()},
+			{"RST", flags.GetRST()},
+			{"PSH", flags.GetPSH()},
+		} {
+			if v.set {
+				out.TCPFlags = append(out.TCPFlags, v.name)
+			}
+		}
+	case l4.GetUDP() != nil:
+		out.Protocol = "UDP"
+		srcPort, dstPort = l4.GetUDP().GetSourcePort(), l4.GetUDP().GetDestinationPort()
+	case l4.GetSCTP() != nil:
+		out.Protocol = "SCTP"
+		srcPort, dstPort = l4.GetSCTP().GetSourcePort(), l4.GetSCTP().GetDestinationPort()
+	case l4.GetICMPv4() != nil:
+		out.Protocol = "ICMPv4"
+	case l4.GetICMPv6() != nil:
+		out.Protocol = "ICMPv6"
+	}
+	out.Source = convertFlowEndpoint(f.GetSource(), f.GetIP().GetSource(), srcPort)
+	out.Destination = convertFlowEndpoint(f.GetDestination(), f.GetIP().GetDestination(), dstPort)
+
+	if v := flowL7(f.GetL7()); v != "" {
+		out.L7 = oas.NewOptString(v)
+	}
+	if id := f.GetTraceContext().GetParent().GetTraceId(); len(id) == 32 {
+		out.TraceID = oas.NewOptTraceID(oas.TraceID(id))
+	}
+	return out
+}
+
+func (h *Handler) GetApplicationFlows(ctx context.Context, params oas.GetApplicationFlowsParams) (oas.FlowList, error) {
+	app, err := h.getApplication(ctx, params.Name)
+	if err != nil {
+		return nil, err
+	}
+	pods, err := h.getApplicationPods(ctx, app)
+	if err != nil {
+		return nil, errors.Wrap(err, "get pods")
+	}
+	out := oas.FlowList{}
+	if len(pods) == 0 {
+		return out, nil
+	}
+
+	t := flow.NewTable(flowTable)
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE %s ORDER BY timestamp DESC LIMIT %d",
+		strings.Join(t.ResultColumns(), ", "),
+		flowTable,
+		podsCondition("k8s_ns", "k8s_pod", app.Namespace, pods),
+		params.Limit.Or(100),
+	)
+	if err := h.ch.Do(ctx, ch.Query{
+		Body:   query,
+		Result: t.Result(),
+		OnResult: func(ctx context.Context, block proto.Block) error {
+			return t.Each(func(row flow.Row) error {
+				v := convertFlow(row.Raw)
+				if row.FlowCount > 1 {
+					v.Count = oas.NewOptInt64(int64(row.FlowCount))
+					v.LastTime = oas.NewOptDateTime(row.LastSeen)
+				}
+				out = append(out, v)
+				return nil
+			})
+		},
+	}); err != nil {
+		return nil, errors.Wrap(err, "query")
+	}
+
+	return out, nil
+}
@@ -4,6 +4,7 @@ package api
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"runtime/debug"
 	"slices"
 	"strconv"
@@ -11,8 +12,10 @@ import (
 	"sync"
 	"time"
 
+	"github.com/ClickHouse/ch-go/chpool"
 	"github.com/go-faster/errors"
 	"github.com/go-faster/sdk/zctx"
+	"github.com/ogen-go/ogen/ogenerrors"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
@@ -21,9 +24,12 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 
+	"github.com/go-faster/vega/internal/auth"
+	"github.com/go-faster/vega/internal/loki"
 	"github.com/go-faster/vega/internal/oas"
 	"github.com/go-faster/vega/internal/promapi"
 	"github.com/go-faster/vega/internal/semconv"
+	"github.com/go-faster/vega/internal/tempo"
 )
 
 var _ oas.Handler = (*Handler)(nil)
@@ -31,6 +37,9 @@ var _ oas.Handler = (*Handler)(nil)
 type Handler struct {
 	kube  *kubernetes.Clientset
 	prom  *promapi.Client
+	ch    *chpool.Pool
+	loki  *loki.Client  // optional
+	tempo *tempo.Client // optional
 	trace trace.Tracer
 }
 
@@ -134,65 +143,109 @@ func (h *Handler) getPodResources(ctx context.Context, pod v1.Pod) (*oas.PodReso
 	return &out, nil
 }
 
-func (h *Handler) GetApplication(ctx context.Context, params oas.GetApplicationParams) (*oas.ApplicationSummary, error) {
+func (h *Handler) getApplication(ctx context.Context, name string) (oas.Application, error) {
 	appList, err := h.getApplications(ctx)
 	if err != nil {
-		return nil, errors.Wrap(err, "get applications")
+		return oas.Application{}, errors.Wrap(err, "get applications")
 	}
-
-	var app oas.Application
 	for _, a := range appList {
-		if a.Name == params.Name {
-			app = a
-			break
+		if a.Name == name {
+			return a, nil
 		}
 	}
-	if app.Name == "" {
-		return nil, &oas.ErrorStatusCode{
-			StatusCode: 404,
-			Response: oas.Error{
-				ErrorMessage: "application not found",
-			},
-		}
+	return oas.Application{}, &oas.ErrorStatusCode{
+		StatusCode: 404,
+		Response: oas.Error{
+			ErrorMessage: "application not found",
+		},
+	}
+}
+
+func (h *Handler) GetApplication(ctx context.Context, params oas.GetApplicationParams) (*oas.ApplicationSummary, error) {
+	app, err := h.getApplication(ctx, params.Name)
+	if err != nil {
+		return nil, err
 	}
 
+	pods, err := h.getApplicationPods(ctx, app)
+	if err != nil {
+		return nil, errors.Wrap(err, "get pods")
+	}
 	summary := &oas.ApplicationSummary{
 		Name:      app.Name,
 		Namespace: app.Namespace,
 	}
-	var mux sync.Mutex
+	if summary.Pods, err = h.getPods(ctx, pods); err != nil {
+		return nil, errors.Wrap(err, "getting application summary")
+	}
+	if summary.Events, err = h.getEvents(ctx, app.Namespace, pods); err != nil {
+		return nil, errors.Wrap(err, "get events")
+	}
+	window := time.Duration(params.Window.Or(900)) * time.Second
+	if err := h.getActivity(ctx, summary, pods, window); err != nil {
+		zctx.From(ctx).Warn("Get network and security summary", zap.Error(err))
+	}
+
+	return summary, nil
+}
+
+// appSelector returns label selector for application pods.
+func appSelector(name string) string {
+	return semconv.LabelVegaApp + "=" + name
+}
+
+func (h *Handler) getApplicationPods(ctx context.Context, app oas.Application) ([]v1.Pod, error) {
 	pods, err := h.kube.CoreV1().Pods(app.Namespace).List(ctx, metav1.ListOptions{
-		LabelSelector: semconv.LabelVegaApp + "=" + app.Name,
+		LabelSelector: appSelector(app.Name),
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "list pods")
 	}
+	return pods.Items, nil
+}
+
+func convertPod(pod v1.Pod, res oas.PodResources) oas.Pod {
+	containers, restarts := convertContainers(pod)
+	out := oas.Pod{
+		Name:       pod.Name,
+		Namespace:  pod.Namespace,
+		Status:     string(pod.Status.Phase),
+		Resources:  res,
+		Restarts:   restarts,
+		Containers: containers,
+	}
+	if pod.Status.Reason != "" {
+		out.Reason = oas.NewOptString(pod.Status.Reason)
+	}
+	return out
+}
+
+// getPods fetches resources of pods and returns them sorted by name.
+func (h *Handler) getPods(ctx context.Context, pods []v1.Pod) ([]oas.Pod, error) {
+	var (
+		mux sync.Mutex
+		out []oas.Pod
+	)
 	g, ctx := errgroup.WithContext(ctx)
-	for _, pod := range pods.Items {
+	for _, pod := range pods {
 		g.Go(func() error {
 			res, err := h.getPodResources(ctx, pod)
 			if err != nil {
 				return errors.Wrap(err, "get pod resources")
 			}
 			mux.Lock()
-			summary.Pods = append(summary.Pods, oas.Pod{
-				Name:      pod.Name,
-				Namespace: pod.Namespace,
-				Status:    string(pod.Status.Phase),
-				Resources: *res,
-			})
+			out = append(out, convertPod(pod, *res))
 			mux.Unlock()
 			return nil
 		})
 	}
 	if err := g.Wait(); err != nil {
-		return nil, errors.Wrap(err, "getting application summary")
+		return nil, err
 	}
-	slices.SortFunc(summary.Pods, func(a, b oas.Pod) int {
+	slices.SortFunc(out, func(a, b oas.Pod) int {
 		return strings.Compare(a.Name, b.Name)
 	})
-
-	return summary, nil
+	return out, nil
 }
 
 func (h *Handler) getApplications(ctx context.Context) ([]oas.Application, error) {
@@ -202,10 +255,23 @@ func (h *Handler) getApplications(ctx context.Context) ([]oas.Application, error
 	listOptions := metav1.ListOptions{
 		LabelSelector: semconv.LabelVegaApp,
 	}
+	user, ok := auth.UserFromContext(ctx)
+	if !ok {
+		return nil, &oas.ErrorStatusCode{
+			StatusCode: http.StatusUnauthorized,
+			Response: oas.Error{
+				ErrorMessage: "unauthenticated",
+			},
+		}
+	}
 	namespaces, err := h.kube.CoreV1().Namespaces().List(ctx, listOptions)
 	if err != nil {
 		return nil, errors.Wrap(err, "listing namespaces")
 	}
+	// Only namespaces that user can access.
+	namespaces.Items = slices.DeleteFunc(namespaces.Items, func(ns v1.Namespace) bool {
+		return !user.CanAccess(&ns)
+	})
 
 	var mux sync.Mutex
 	appMap := make(map[string]oas.Application)
@@ -284,6 +350,17 @@ func (h *Handler) NewError(ctx context.Context, err error) *oas.ErrorStatusCode
 		traceID = oas.NewOptTraceID(oas.TraceID(span.TraceID().String()))
 		spanID = oas.NewOptSpanID(oas.SpanID(span.SpanID().String()))
 	}
+	if v, ok := errors.Into[ogenerrors.Error](err); ok {
+		// Security and request decoding errors.
+		return &oas.ErrorStatusCode{
+			StatusCode: v.Code(),
+			Response: oas.Error{
+				ErrorMessage: err.Error(),
+				TraceID:      traceID,
+				SpanID:       spanID,
+			},
+		}
+	}
 	if v, ok := errors.Into[*oas.ErrorStatusCode](err); ok {
 		v.Response.TraceID = traceID
 		v.Response.SpanID = spanID
@@ -308,11 +385,17 @@ func (h *Handler) NewError(ctx context.Context, err error) *oas.ErrorStatusCode
 func NewHandler(
 	kube *kubernetes.Clientset,
 	promClient *promapi.Client,
+	chPool *chpool.Pool,
+	lokiClient *loki.Client,
+	tempoClient *tempo.Client,
 	traceProvider trace.TracerProvider,
 ) *Handler {
 	return &Handler{
 		kube:  kube,
 		prom:  promClient,
+		ch:    chPool,
+		loki:  lokiClient,
+		tempo: tempoClient,
 		trace: traceProvider.Tracer("vega.api"),
 	}
 }
@@ -0,0 +1,119 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ClickHouse/ch-go"
+	"github.com/ClickHouse/ch-go/proto"
+	"github.com/go-faster/errors"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/go-faster/vega/internal/oas"
+	"github.com/go-faster/vega/internal/route"
+)
+
+// getHTTPEndpoints returns HTTP requests served by pods since start,
+// grouped by method and route.
+func (h *Handler) getHTTPEndpoints(ctx context.Context, namespace string, pods []v1.Pod, start time.Time, limit int) ([]oas.HTTPEndpoint, error) {
+	ctx, span := h.trace.Start(ctx, "getHTTPEndpoints")
+	defer span.End()
+
+	var (
+		method       proto.ColStr
+		path         proto.ColStr
+		requests     proto.ColUInt64
+		clientErrors proto.ColUInt64
+		serverErrors proto.ColUInt64
+		p50          proto.ColFloat64
+		p90          proto.ColFloat64
+		p99          proto.ColFloat64
+
+		window = time.Since(start).Seconds()
+		out    = []oas.HTTPEndpoint{}
+	)
+	// Response is sent by the server, so index pod is source and row is
+	// DIRECT, see vega-ingest.
+	if err := h.ch.Do(ctx, ch.Query{
+		Body: fmt.Sprintf(`SELECT
+    toString(l7_http_method) AS method,
+    %s AS route,
+    count() AS requests,
+    countIf(l7_http_code >= 400 AND l7_http_code < 500) AS client_errors,
+    countIf(l7_http_code >= 500) AS server_errors,
+    quantile(0.5)(l7_latency_ns) / 1e6 AS p50,
+    quantile(0.9)(l7_latency_ns) / 1e6 AS p90,
+    quantile(0.99)(l7_latency_ns) / 1e6 AS p99
+FROM %s
+WHERE %s AND l7_protocol = 'HTTP' AND l7_flow_type = 'RESPONSE' AND direction = 'DIRECT'
+GROUP BY method, route
+ORDER BY requests DESC, route, method
+LIMIT %d`, route.SQL("l7_http_url"), flowTable, windowCondition(namespace, pods, start), limit),
+		Result: proto.Results{
+			{Name: "method", Data: &method},
+			{Name: "route", Data: &path},
+			{Name: "requests", Data: &requests},
+			{Name: "client_errors", Data: &clientErrors},
+			{Name: "server_errors", Data: &serverErrors},
+			{Name: "p50", Data: &p50},
+			{Name: "p90", Data: &p90},
+			{Name: "p99", Data: &p99},
+		},
+		OnResult: func(ctx context.Context, block proto.Block) error {
+			for i := 0; i < method.Rows(); i++ {
+				var (
+					n      = int64(requests.Row(i))     //#nosec G115
+					errs   = int64(serverErrors.Row(i)) //#nosec G115
+					result = oas.HTTPEndpoint{
+						Method:       method.Row(i),
+						Route:        path.Row(i),
+						Requests:     n,
+						Rate:         float64(n) / window,
+						ClientErrors: int64(clientErrors.Row(i)), //#nosec G115
+						Errors:       errs,
+						LatencyP50Ms: p50.Row(i),
+						LatencyP90Ms: p90.Row(i),
+						LatencyP99Ms: p99.Row(i),
+					}
+				)
+				if n > 0 {
+					result.ErrorRatio = float64(errs) / float64(n)
+				}
+				out = append(out, result)
+			}
+			return nil
+		},
+	}); err != nil {
+		return nil, errors.Wrap(err, "query")
+	}
+	return out, nil
+}
+
+// GetApplicationHTTP implements getApplicationHTTP operation.
+func (h *Handler) GetApplicationHTTP(ctx context.Context, params oas.GetApplicationHTTPParams) (*oas.HTTPReport, error) {
+	app, err := h.getApplication(ctx, params.Name)
+	if err != nil {
+		return nil, err
+	}
+	pods, err := h.getApplicationPods(ctx, app)
+	if err != nil {
+		return nil, errors.Wrap(err, "get pods")
+	}
+	window := params.Window.Or(900)
+	out := &oas.HTTPReport{
+		Name:      app.Name,
+		Namespace: app.Namespace,
+		Window:    window,
+		Endpoints: []oas.HTTPEndpoint{},
+	}
+	if len(pods) == 0 {
+		return out, nil
+	}
+
+	start := time.Now().Add(-time.Duration(window) * time.Second)
+	if out.Endpoints, err = h.getHTTPEndpoints(ctx, app.Namespace, pods, start, params.Limit.Or(50)); err != nil {
+		return nil, errors.Wrap(err, "get endpoints")
+	}
+	return out, nil
+}
@@ -0,0 +1,140 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ClickHouse/ch-go"
+	"github.com/ClickHouse/ch-go/proto"
+	"github.com/go-faster/errors"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/go-faster/vega/internal/oas"
+)
+
+// maxKafkaGroups limits number of Kafka request groups queried.
+const maxKafkaGroups = 500
+
+// Kafka api keys of producers and consumers, as reported by Cilium.
+const (
+	kafkaProduce = "produce"
+	kafkaFetch   = "fetch"
+)
+
+// getKafkaRequests returns Kafka requests of pods as clients since start,
+// grouped by topic, api key and broker.
+func (h *Handler) getKafkaRequests(ctx context.Context, namespace string, pods []v1.Pod, start time.Time) ([]oas.KafkaRequests, error) {
+	ctx, span := h.trace.Start(ctx, "getKafkaRequests")
+	defer span.End()
+
+	var (
+		topic      proto.ColStr
+		apiKey     proto.ColStr
+		broker     proto.ColStr
+		requests   proto.ColUInt64
+		failed     proto.ColUInt64
+		errorCodes = proto.NewArray[int32](new(proto.ColInt32))
+		p50        proto.ColFloat64
+		p99        proto.ColFloat64
+
+		window = time.Since(start).Seconds()
+		out    = []oas.KafkaRequests{}
+	)
+	// Response is sent to the client, so index pod is destination and row
+	// is INVERSE, see vega-ingest. Error codes are signed but stored as
+	// UInt32, see flow.Table.
+	if err := h.ch.Do(ctx, ch.Query{
+		Body: fmt.Sprintf(`SELECT
+    l7_kafka_topic AS topic,
+    lower(l7_kafka_api_key) AS api_key,
+    %s AS broker,
+    count() AS requests,
+    countIf(l7_kafka_error_code != 0) AS failed,
+    arraySort(groupUniqArrayIf(16)(toInt32(l7_kafka_error_code), l7_kafka_error_code != 0)) AS error_codes,
+    quantile(0.5)(l7_latency_ns) / 1e6 AS p50,
+    quantile(0.99)(l7_latency_ns) / 1e6 AS p99
+FROM %s
+WHERE %s AND l7_protocol = 'Kafka' AND l7_flow_type = 'RESPONSE' AND direction = 'INVERSE'
+GROUP BY topic, api_key, broker
+ORDER BY requests DESC, topic, api_key
+LIMIT %d`, flowPeerExpr, flowTable, windowCondition(namespace, pods, start), maxKafkaGroups),
+		Result: proto.Results{
+			{Name: "topic", Data: &topic},
+			{Name: "api_key", Data: &apiKey},
+			{Name: "broker", Data: &broker},
+			{Name: "requests", Data: &requests},
+			{Name: "failed", Data: &failed},
+			{Name: "error_codes", Data: errorCodes},
+			{Name: "p50", Data: &p50},
+			{Name: "p99", Data: &p99},
+		},
+		OnResult: func(ctx context.Context, block proto.Block) error {
+			for i := 0; i < topic.Rows(); i++ {
+				n := int64(requests.Row(i)) //#nosec G115
+				out = append(out, oas.KafkaRequests{
+					Topic:        topic.Row(i),
+					APIKey:       apiKey.Row(i),
+					Broker:       broker.Row(i),
+					Requests:     n,
+					Rate:         float64(n) / window,
+					Errors:       int64(failed.Row(i)), //#nosec G115
+					ErrorCodes:   errorCodes.Row(i),
+					LatencyP50Ms: p50.Row(i),
+					LatencyP99Ms: p99.Row(i),
+				})
+			}
+			return nil
+		},
+	}); err != nil {
+		return nil, errors.Wrap(err, "query")
+	}
+	return out, nil
+}
+
+// kafkaTopics returns sorted unique topics of requests with api key.
+func kafkaTopics(requests []oas.KafkaRequests, apiKey string) []string {
+	out := []string{}
+	for _, r := range requests {
+		if r.Topic == "" || !strings.EqualFold(r.APIKey, apiKey) {
+			continue
+		}
+		out = append(out, r.Topic)
+	}
+	slices.Sort(out)
+	return slices.Compact(out)
+}
+
+// GetApplicationKafka implements getApplicationKafka operation.
+func (h *Handler) GetApplicationKafka(ctx context.Context, params oas.GetApplicationKafkaParams) (*oas.KafkaReport, error) {
+	app, err := h.getApplication(ctx, params.Name)
+	if err != nil {
+		return nil, err
+	}
+	pods, err := h.getApplicationPods(ctx, app)
+	if err != nil {
+		return nil, errors.Wrap(err, "get pods")
+	}
+	window := params.Window.Or(900)
+	out := &oas.KafkaReport{
+		Name:      app.Name,
+		Namespace: app.Namespace,
+		Window:    window,
+		Produces:  []string{},
+		Consumes:  []string{},
+		Requests:  []oas.KafkaRequests{},
+	}
+	if len(pods) == 0 {
+		return out, nil
+	}
+
+	start := time.Now().Add(-time.Duration(window) * time.Second)
+	if out.Requests, err = h.getKafkaRequests(ctx, app.Namespace, pods, start); err != nil {
+		return nil, errors.Wrap(err, "get requests")
+	}
+	out.Produces = kafkaTopics(out.Requests, kafkaProduce)
+	out.Consumes = kafkaTopics(out.Requests, kafkaFetch)
+	return out, nil
+}
@@ -0,0 +1,412 @@
+package api
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/go-faster/sdk/zctx"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/go-faster/vega/internal/loki"
+	"github.com/go-faster/vega/internal/oas"
+	"github.com/go-faster/vega/internal/semconv"
+	"github.com/go-faster/vega/internal/sse"
+)
+
+// Loki labels of OTLP log resource attributes.
+const (
+	lokiLabelNamespace = "k8s_namespace_name"
+	lokiLabelPod       = "k8s_pod_name"
+	lokiLabelContainer = "k8s_container_name"
+	// lokiLabelApp is semconv.LabelVegaApp pod label, extracted by
+	// k8sattributes processor and indexed by Loki.
+	lokiLabelApp = "vega_app"
+)
+
+// maxLogLine is maximum length of log line read from pod log API.
+const maxLogLine = 1024 * 1024
+
+// logsQuery is filter of application logs.
+type logsQuery struct {
+	since     time.Time
+	until     time.Time
+	grep      string
+	container string
+	limit     int
+}
+
+func (q logsQuery) match(e oas.LogEntry) bool {
+	if e.Time.Before(q.since) || e.Time.After(q.until) {
+		return false
+	}
+	if q.container != "" && e.Container.Or("") != q.container {
+		return false
+	}
+	return strings.Contains(e.Line, q.grep)
+}
+
+func badRequest(msg string) error {
+	return &oas.ErrorStatusCode{
+		StatusCode: http.StatusBadRequest,
+		Response: oas.Error{
+			ErrorMessage: msg,
+		},
+	}
+}
+
+func (h *Handler) GetApplicationLogs(ctx context.Context, params oas.GetApplicationLogsParams) (oas.GetApplicationLogsRes, error) {
+	follow := params.Follow.Or(false)
+	if follow && params.Until.Set {
+		return nil, badRequest("until is not allowed with follow")
+	}
+	now := time.Now()
+	q := logsQuery{
+		since:     params.Since.Or(now.Add(-time.Hour)),
+		until:     params.Until.Or(now),
+		grep:      params.Grep.Or(""),
+		container: params.Container.Or(""),
+		limit:     params.Limit.Or(1000),
+	}
+	if !q.since.Before(q.until) {
+		return nil, badRequest("since should be before until")
+	}
+
+	app, err := h.getApplication(ctx, params.Name)
+	if err != nil {
+		return nil, err
+	}
+	pods, err := h.getApplicationPods(ctx, app)
+	if err != nil {
+		return nil, errors.Wrap(err, "get pods")
+	}
+	history, err := h.getLogHistory(ctx, app, pods, q)
+	if err != nil {
+		return nil, errors.Wrap(err, "get history")
+	}
+	if !follow {
+		out := oas.LogEntryList(history)
+		if out == nil {
+			out = oas.LogEntryList{}
+		}
+		return &out, nil
+	}
+
+	r, w := io.Pipe()
+	go func() {
+		err := h.tailLogs(ctx, app, history, q, sse.NewWriter(w))
+		if err != nil && ctx.Err() == nil {
+			zctx.From(ctx).Warn("Tail logs", zap.Error(err))
+		}
+		_ = w.CloseWithError(err)
+	}()
+	return &oas.GetApplicationLogsOKTextEventStream{Data: r}, nil
+}
+
+// getLogHistory returns last lines of application logs in time window,
+// ordered by time.
+func (h *Handler) getLogHistory(ctx context.Context, app oas.Application, pods []v1.Pod, q logsQuery) ([]oas.LogEntry, error) {
+	ctx, span := h.trace.Start(ctx, "getLogHistory")
+	defer span.End()
+
+	if h.loki != nil {
+		return h.getLokiLogs(ctx, app, q)
+	}
+	if len(pods) == 0 {
+		return nil, nil
+	}
+
+	// Fallback to pod log API, that has logs only of current containers.
+	var (
+		mux sync.Mutex
+		out []oas.LogEntry
+	)
+	g, ctx := errgroup.WithContext(ctx)
+	for _, pod := range pods {
+		for _, c := range pod.Spec.Containers {
+			if q.container != "" && c.Name != q.container {
+				continue
+			}
+			g.Go(func() error {
+				since := metav1.NewTime(q.since)
+				tail := int64(q.limit)
+				entries, err := h.readPodLogs(ctx, pod, c.Name, &v1.PodLogOptions{
+					SinceTime: &since,
+					TailLines: &tail,
+				}, q)
+				if err != nil {
+					return errors.Wrapf(err, "read logs of %s/%s", pod.Name, c.Name)
+				}
+				mux.Lock()
+				out = append(out, entries...)
+				mux.Unlock()
+				return nil
+			})
+		}
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	slices.SortStableFunc(out, func(a, b oas.LogEntry) int {
+		return a.Time.Compare(b.Time)
+	})
+	if len(out) > q.limit {
+		out = out[len(out)-q.limit:]
+	}
+	return out, nil
+}
+
+// getLokiLogs returns logs of application from Loki.
+//
+// Logs are selected by application label instead of pods, so logs of
+// crashed pods and pods replaced by rollout are included.
+func (h *Handler) getLokiLogs(ctx context.Context, app oas.Application, q logsQuery) ([]oas.LogEntry, error) {
+	selector := []string{
+		lokiLabelNamespace + "=" + loki.Quote(app.Namespace),
+		lokiLabelApp + "=" + loki.Quote(app.Name),
+	}
+	if q.container != "" {
+		selector = append(selector, lokiLabelContainer+"="+loki.Quote(q.container))
+	}
+	query := "{" + strings.Join(selector, ", ") + "}"
+	if q.grep != "" {
+		query += " |= " + loki.Quote(q.grep)
+	}
+	entries, err := h.loki.QueryRange(ctx, loki.QueryRangeParams{
+		Query:     query,
+		Start:     q.since,
+		End:       q.until,
+		Limit:     q.limit,
+		Direction: loki.Backward,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "query loki")
+	}
+	out := make([]oas.LogEntry, 0, len(entries))
+	for _, e := range slices.Backward(entries) {
+		entry := oas.LogEntry{
+			Time: e.Time,
+			Pod:  e.Labels[lokiLabelPod],
+			Line: strings.TrimRight(e.Line, "\n"),
+		}
+		if c := e.Labels[lokiLabelContainer]; c != "" {
+			entry.Container = oas.NewOptString(c)
+		}
+		out = append(out, entry)
+	}
+	return out, nil
+}
+
+// parseLogLine parses line of pod log API with timestamps.
+func parseLogLine(pod, container, line string) (oas.LogEntry, bool) {
+	ts, text, _ := strings.Cut(line, " ")
+	t, err := time.Parse(time.RFC3339Nano, ts)
+	if err != nil {
+		return oas.LogEntry{}, false
+	}
+	return oas.LogEntry{
+		Time:      t,
+		Pod:       pod,
+		Container: oas.NewOptString(container),
+		Line:      text,
+	}, true
+}
+
+// streamPodLogs calls fn for every line of container logs that matches
+// query, until stream is finished.
+func (h *Handler) streamPodLogs(
+	ctx context.Context,
+	pod v1.Pod,
+	container string,
+	opts *v1.PodLogOptions,
+	q logsQuery,
+	fn func(e oas.LogEntry) error,
+) error {
+	opts.Container = container
+	opts.Timestamps = true
+	rc, err := h.kube.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, opts).Stream(ctx)
+	if err != nil {
+		return errors.Wrap(err, "stream")
+	}
+	defer func() {
+		_ = rc.Close()
+	}()
+	s := bufio.NewScanner(rc)
+	s.Buffer(make([]byte, 0, 64*1024), maxLogLine)
+	for s.Scan() {
+		e, ok := parseLogLine(pod.Name, container, s.Text())
+		if !ok || !q.match(e) {
+			continue
+		}
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return s.Err()
+}
+
+func (h *Handler) readPodLogs(ctx context.Context, pod v1.Pod, container string, opts *v1.PodLogOptions, q logsQuery) ([]oas.LogEntry, error) {
+	var out []oas.LogEntry
+	if err := h.streamPodLogs(ctx, pod, container, opts, q, func(e oas.LogEntry) error {
+		out = append(out, e)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// logTailer follows logs of application containers, writing them as
+// server-sent events.
+type logTailer struct {
+	h     *Handler
+	q     logsQuery
+	start time.Time
+
+	mux     sync.Mutex
+	w       *sse.Writer
+	last    map[string]time.Time // last written time per pod/container
+	running map[string]struct{}  // currently followed pod/container
+}
+
+func (t *logTailer) write(e oas.LogEntry) error {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	key := e.Pod + "/" + e.Container.Or("")
+	if last, ok := t.last[key]; ok && !e.Time.After(last) {
+		// Already written before restart of stream.
+		return nil
+	}
+	t.last[key] = e.Time
+	return writeEvent(t.w, semconv.EventLog, &e)
+}
+
+// follow starts following container logs if it is not followed yet.
+func (t *logTailer) follow(ctx context.Context, cancel context.CancelCauseFunc, pod v1.Pod, container string) {
+	key := pod.Name + "/" + container
+
+	t.mux.Lock()
+	if _, ok := t.running[key]; ok {
+		t.mux.Unlock()
+		return
+	}
+	t.running[key] = struct{}{}
+	since := t.start
+	if last, ok := t.last[key]; ok {
+		since = last
+	}
+	t.mux.Unlock()
+
+	go func() {
+		defer func() {
+			t.mux.Lock()
+			delete(t.running, key)
+			t.mux.Unlock()
+		}()
+		sinceTime := metav1.NewTime(since)
+		q := t.q
+		q.since = since
+		q.until = time.Unix(1<<62, 0)
+		err := t.h.streamPodLogs(ctx, pod, container, &v1.PodLogOptions{
+			Follow:    true,
+			SinceTime: &sinceTime,
+		}, q, t.write)
+		switch {
+		case err == nil, ctx.Err() != nil:
+		case errors.Is(err, io.ErrClosedPipe):
+			// Client is gone.
+			cancel(err)
+		default:
+			zctx.From(ctx).Warn("Follow logs",
+				zap.String("pod", pod.Name),
+				zap.String("container", container),
+				zap.Error(err),
+			)
+		}
+	}()
+}
+
+// followPod starts following logs of started containers of pod.
+func (t *logTailer) followPod(ctx context.Context, cancel context.CancelCauseFunc, pod v1.Pod) {
+	for _, s := range pod.Status.ContainerStatuses {
+		if t.q.container != "" && s.Name != t.q.container {
+			continue
+		}
+		if s.State.Running == nil && s.State.Terminated == nil {
+			continue
+		}
+		t.follow(ctx, cancel, pod, s.Name)
+	}
+}
+
+// tailLogs writes history and then follows logs of application pods,
+// including pods that are started later, until context is done or client
+// is gone.
+func (h *Handler) tailLogs(ctx context.Context, app oas.Application, history []oas.LogEntry, q logsQuery, w *sse.Writer) error {
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	t := &logTailer{
+		h:       h,
+		q:       q,
+		start:   q.until,
+		w:       w,
+		last:    map[string]time.Time{},
+		running: map[string]struct{}{},
+	}
+	for _, e := range history {
+		if err := t.write(e); err != nil {
+			return errors.Wrap(err, "write history")
+		}
+	}
+
+	opts := metav1.ListOptions{
+		LabelSelector:       appSelector(app.Name),
+		AllowWatchBookmarks: true,
+	}
+	watcher, err := h.kube.CoreV1().Pods(app.Namespace).Watch(ctx, opts)
+	if err != nil {
+		return errors.Wrap(err, "watch pods")
+	}
+	defer func() {
+		// Watcher is replaced on re-connect.
+		watcher.Stop()
+	}()
+	for {
+		select {
+		case <-ctx.Done():
+			return context.Cause(ctx)
+		case e, ok := <-watcher.ResultChan():
+			if !ok {
+				watcher.Stop()
+				if watcher, err = h.kube.CoreV1().Pods(app.Namespace).Watch(ctx, opts); err != nil {
+					return errors.Wrap(err, "re-watch pods")
+				}
+				continue
+			}
+			if e.Type == watch.Error {
+				return errors.Wrap(apierrors.FromObject(e.Object), "watch")
+			}
+			pod, ok := e.Object.(*v1.Pod)
+			if !ok {
+				continue
+			}
+			opts.ResourceVersion = pod.ResourceVersion
+			if e.Type == watch.Added || e.Type == watch.Modified {
+				t.followPod(ctx, cancel, *pod)
+			}
+		}
+	}
+}
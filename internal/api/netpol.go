@@ -0,0 +1,186 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ClickHouse/ch-go"
+	"github.com/ClickHouse/ch-go/proto"
+	"github.com/go-faster/errors"
+
+	"github.com/go-faster/vega/internal/flow"
+	"github.com/go-faster/vega/internal/netpol"
+	"github.com/go-faster/vega/internal/oas"
+	"github.com/go-faster/vega/internal/semconv"
+)
+
+// maxDropGroups limits number of flow groups queried.
+const maxDropGroups = 500
+
+// Flow verdicts, see flow.NewDDL.
+const (
+	verdictDropped = "DROPPED"
+	verdictAudit   = "AUDIT"
+)
+
+// getVerdictFlows returns flows of application with verdict in [start, end),
+// grouped by peer identity, port and policy.
+//
+// Flows are matched by application label instead of pods, so flows of
+// pods replaced by rollout are included.
+func (h *Handler) getVerdictFlows(ctx context.Context, app oas.Application, verdict string, start, end time.Time) ([]netpol.Drop, error) {
+	ctx, span := h.trace.Start(ctx, "getVerdictFlows")
+	defer span.End()
+
+	var (
+		direction  proto.ColStr
+		peer       proto.ColStr
+		peerLabels = proto.NewArray[string](new(proto.ColStr))
+		peerIP     proto.ColStr
+		protocol   proto.ColStr
+		port       proto.ColUInt32
+		reason     proto.ColStr
+		policy     proto.ColStr
+		count      proto.ColUInt64
+		lastSeen   = new(proto.ColDateTime64).WithPrecision(proto.PrecisionNano)
+
+		out []netpol.Drop
+	)
+	// Index pod is source for DIRECT rows and destination for INVERSE ones,
+	// see vega-ingest.
+	query := fmt.Sprintf(`SELECT
+    if(direction = 'INVERSE', 'ingress', 'egress') AS dir,
+    any(%s) AS peer,
+    CAST(if(direction = 'INVERSE', endpoint_src_labels, endpoint_dst_labels), 'Array(String)') AS peer_labels,
+    if(has(peer_labels, 'reserved:world'),
+        if(direction = 'INVERSE',
+            if(ip_version = 'IPv6', toString(ipv6_src), toString(ipv4_src)),
+            if(ip_version = 'IPv6', toString(ipv6_dst), toString(ipv4_dst))),
+        '') AS peer_ip,
+    toString(l4_protocol) AS protocol,
+    if(l4_protocol IN ('ICMPv4', 'ICMPv6'), l4_icmp_type, l4_dst_port) AS port,
+    toString(drop_reason) AS reason,
+    arrayStringConcat(policy_denied_by, ',') AS policy,
+    sum(flow_count) AS count,
+    max(timestamp_last) AS last_seen
+FROM %s
+WHERE %s
+    AND timestamp >= fromUnixTimestamp64Nano(toInt64(%d))
+    AND timestamp < fromUnixTimestamp64Nano(toInt64(%d))
+    AND verdict = %s AND NOT ifNull(is_reply, false)
+GROUP BY dir, peer_labels, peer_ip, protocol, port, reason, policy
+ORDER BY count DESC
+LIMIT %d`,
+		flowPeerExpr,
+		flowTable,
+		flow.IndexLabelCondition(app.Namespace, "k8s:"+appSelector(app.Name)),
+		start.UnixNano(),
+		end.UnixNano(),
+		flow.Quote(verdict),
+		maxDropGroups,
+	)
+	if err := h.ch.Do(ctx, ch.Query{
+		Body: query,
+		Result: proto.Results{
+			{Name: "dir", Data: &direction},
+			{Name: "peer", Data: &peer},
+			{Name: "peer_labels", Data: peerLabels},
+			{Name: "peer_ip", Data: &peerIP},
+			{Name: "protocol", Data: &protocol},
+			{Name: "port", Data: &port},
+			{Name: "reason", Data: &reason},
+			{Name: "policy", Data: &policy},
+			{Name: "count", Data: &count},
+			{Name: "last_seen", Data: lastSeen},
+		},
+		OnResult: func(ctx context.Context, block proto.Block) error {
+			for i := 0; i < count.Rows(); i++ {
+				d := netpol.Drop{
+					Direction:  netpol.Direction(direction.Row(i)),
+					Peer:       peer.Row(i),
+					PeerLabels: peerLabels.Row(i),
+					PeerIP:     peerIP.Row(i),
+					Protocol:   protocol.Row(i),
+					Reason:     reason.Row(i),
+					Policy:     policy.Row(i),
+					Count:      int64(count.Row(i)), //#nosec G115
+					LastSeen:   lastSeen.Row(i),
+				}
+				if d.Protocol == "ICMPv4" || d.Protocol == "ICMPv6" {
+					d.ICMPType = port.Row(i)
+				} else {
+					d.Port = port.Row(i)
+				}
+				out = append(out, d)
+			}
+			return nil
+		},
+	}); err != nil {
+		return nil, errors.Wrap(err, "query")
+	}
+	return netpol.Group(out), nil
+}
+
+func convertDrop(d netpol.Drop) oas.FlowDrop {
+	out := oas.FlowDrop{
+		Direction:  oas.FlowDropDirection(d.Direction),
+		Peer:       d.Peer,
+		PeerLabels: d.PeerLabels,
+		Protocol:   d.Protocol,
+		Port:       int(d.Port),
+		Reason:     d.Reason,
+		Count:      d.Count,
+		LastSeen:   d.LastSeen,
+	}
+	if d.Policy != "" {
+		out.Policy = oas.NewOptString(d.Policy)
+	}
+	if out.PeerLabels == nil {
+		out.PeerLabels = []string{}
+	}
+	if d.ICMPType != 0 {
+		out.Port = int(d.ICMPType)
+	}
+	if d.PeerIP != "" {
+		out.PeerIP = oas.NewOptString(d.PeerIP)
+	}
+	return out
+}
+
+func (h *Handler) GetApplicationNetpol(ctx context.Context, params oas.GetApplicationNetpolParams) (*oas.NetpolReport, error) {
+	app, err := h.getApplication(ctx, params.Name)
+	if err != nil {
+		return nil, err
+	}
+	window := params.Window.Or(3600)
+	out := &oas.NetpolReport{
+		Name:      app.Name,
+		Namespace: app.Namespace,
+		Window:    window,
+		Drops:     []oas.FlowDrop{},
+	}
+
+	now := time.Now()
+	drops, err := h.getVerdictFlows(ctx, app, verdictDropped, now.Add(-time.Duration(window)*time.Second), now)
+	if err != nil {
+		return nil, errors.Wrap(err, "get drops")
+	}
+	denied := false
+	for _, d := range drops {
+		out.Drops = append(out.Drops, convertDrop(d))
+		denied = denied || d.Reason == netpol.ReasonPolicyDenied
+	}
+	if !denied {
+		return out, nil
+	}
+	policy := netpol.Policy(app.Name+"-observed", app.Namespace, map[string]string{
+		semconv.LabelVegaApp: app.Name,
+	}, drops)
+	data, err := policy.YAML()
+	if err != nil {
+		return nil, errors.Wrap(err, "encode policy")
+	}
+	out.Policy = string(data)
+	return out, nil
+}
@@ -0,0 +1,248 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ClickHouse/ch-go"
+	"github.com/ClickHouse/ch-go/proto"
+	"github.com/go-faster/errors"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/go-faster/vega/internal/auth"
+	"github.com/go-faster/vega/internal/detect"
+	"github.com/go-faster/vega/internal/netpol"
+	"github.com/go-faster/vega/internal/notify"
+	"github.com/go-faster/vega/internal/oas"
+)
+
+// Rules of network findings, runtime findings use detection rule names.
+const (
+	ruleUnexpectedDrop    = "unexpected-drop"
+	ruleNewExternalDomain = "new-external-domain"
+)
+
+const (
+	// dropFindingsBaseline is window before findings window, drops from
+	// which are expected.
+	dropFindingsBaseline = 6 * time.Hour
+	// maxRuntimeFindingGroups limits number of detection finding groups
+	// of application.
+	maxRuntimeFindingGroups = 500
+)
+
+// notifierUser is user of findings collection, that can access all
+// applications.
+var notifierUser = &auth.User{
+	Name:  "vega-notifier",
+	Admin: true,
+}
+
+// getRuntimeFindings returns detection findings of pods since start,
+// grouped by rule, pod and binary.
+func (h *Handler) getRuntimeFindings(ctx context.Context, app oas.Application, pods []v1.Pod, start time.Time) ([]notify.Finding, error) {
+	ctx, span := h.trace.Start(ctx, "getRuntimeFindings")
+	defer span.End()
+
+	var (
+		rule        = new(proto.ColStr).LowCardinality()
+		severity    proto.ColStr
+		pod         = new(proto.ColStr).LowCardinality()
+		binary      proto.ColStr
+		description proto.ColStr
+		count       proto.ColUInt64
+
+		out []notify.Finding
+	)
+	query := fmt.Sprintf(`SELECT rule, toString(severity) AS sev, k8s_pod, process_binary,
+    any(description) AS description,
+    count() AS count
+FROM %s
+WHERE %s
+GROUP BY rule, sev, k8s_pod, process_binary
+ORDER BY count DESC
+LIMIT %d`,
+		findingsTable,
+		windowCondition(app.Namespace, pods, start),
+		maxRuntimeFindingGroups,
+	)
+	if err := h.ch.Do(ctx, ch.Query{
+		Body: query,
+		Result: proto.Results{
+			{Name: "rule", Data: rule},
+			{Name: "sev", Data: &severity},
+			{Name: "k8s_pod", Data: pod},
+			{Name: "process_binary", Data: &binary},
+			{Name: "description", Data: &description},
+			{Name: "count", Data: &count},
+		},
+		OnResult: func(ctx context.Context, block proto.Block) error {
+			for i := 0; i < count.Rows(); i++ {
+				out = append(out, notify.Finding{
+					Kind:      notify.KindRuntime,
+					Rule:      rule.Row(i),
+					Severity:  severity.Row(i),
+					Namespace: app.Namespace,
+					App:       app.Name,
+					Pod:       pod.Row(i),
+					Labels: map[string]string{
+						"binary": binary.Row(i),
+					},
+					Summary: fmt.Sprintf("Rule %s matched %s in %s (%d times)",
+						rule.Row(i), binary.Row(i), pod.Row(i), count.Row(i),
+					),
+					Description: description.Row(i),
+				})
+			}
+			return nil
+		},
+	}); err != nil {
+		return nil, errors.Wrap(err, "query")
+	}
+	return out, nil
+}
+
+// getDropFindings returns groups of flows dropped since start, that were
+// not dropped in baseline window before start.
+//
+// Flows are matched by application label, so drops of pods replaced by
+// rollout are expected too.
+func (h *Handler) getDropFindings(ctx context.Context, app oas.Application, start, end time.Time) ([]notify.Finding, error) {
+	current, err := h.getVerdictFlows(ctx, app, verdictDropped, start, end)
+	if err != nil {
+		return nil, errors.Wrap(err, "current")
+	}
+	if len(current) == 0 {
+		return nil, nil
+	}
+	baseline, err := h.getVerdictFlows(ctx, app, verdictDropped, start.Add(-dropFindingsBaseline), start)
+	if err != nil {
+		return nil, errors.Wrap(err, "baseline")
+	}
+	var out []notify.Finding
+	for _, c := range netpol.Diff(current, baseline) {
+		if c.Status != netpol.StatusNew {
+			continue
+		}
+		port := fmt.Sprintf("%d/%s", c.Port, c.Protocol)
+		if c.ICMPType != 0 {
+			port = fmt.Sprintf("type %d/%s", c.ICMPType, c.Protocol)
+		}
+		peer := c.Peer
+		if c.PeerIP != "" {
+			peer = c.PeerIP
+		}
+		f := notify.Finding{
+			Kind:      notify.KindDrop,
+			Rule:      ruleUnexpectedDrop,
+			Severity:  string(detect.SeverityMedium),
+			Namespace: app.Namespace,
+			App:       app.Name,
+			Labels: map[string]string{
+				"direction": string(c.Direction),
+				"peer":      peer,
+				"port":      port,
+			},
+			Summary: fmt.Sprintf("New %s drops of %s peer %s on %s (%d flows)",
+				c.Direction, app.Name, peer, port, c.Count,
+			),
+			Description: "Flows are dropped with reason " + c.Reason,
+		}
+		if c.Policy != "" {
+			f.Description += " by policy " + c.Policy
+		}
+		out = append(out, f)
+	}
+	return out, nil
+}
+
+// getDomainFindings returns external domains first reached since start.
+func (h *Handler) getDomainFindings(ctx context.Context, app oas.Application, start, end time.Time) ([]notify.Finding, error) {
+	egress, err := h.getEgress(ctx, app, start, end)
+	if err != nil {
+		return nil, errors.Wrap(err, "get egress")
+	}
+	var out []notify.Finding
+	for _, e := range egress {
+		if !e.New {
+			continue
+		}
+		for _, name := range e.Names {
+			out = append(out, notify.Finding{
+				Kind:      notify.KindDomain,
+				Rule:      ruleNewExternalDomain,
+				Severity:  string(detect.SeverityLow),
+				Namespace: app.Namespace,
+				App:       app.Name,
+				Labels: map[string]string{
+					"domain": name,
+				},
+				Summary: fmt.Sprintf("%s reached new external domain %s", app.Name, name),
+				Description: fmt.Sprintf("Domain %s resolved to %s was reached on %d/%s",
+					name, e.IP, e.Port, e.Protocol,
+				),
+			})
+		}
+	}
+	return out, nil
+}
+
+// getAppFindings returns findings of application since start.
+func (h *Handler) getAppFindings(ctx context.Context, app oas.Application, start, end time.Time) ([]notify.Finding, error) {
+	pods, err := h.getApplicationPods(ctx, app)
+	if err != nil {
+		return nil, errors.Wrap(err, "get pods")
+	}
+	if len(pods) == 0 {
+		return nil, nil
+	}
+	runtime, err := h.getRuntimeFindings(ctx, app, pods, start)
+	if err != nil {
+		return nil, errors.Wrap(err, "get runtime findings")
+	}
+	drops, err := h.getDropFindings(ctx, app, start, end)
+	if err != nil {
+		return nil, errors.Wrap(err, "get drop findings")
+	}
+	domains, err := h.getDomainFindings(ctx, app, start, end)
+	if err != nil {
+		return nil, errors.Wrap(err, "get domain findings")
+	}
+	out := append(runtime, drops...)
+	return append(out, domains...), nil
+}
+
+// Findings returns findings of all applications observed in window before
+// now: detection rule matches, unexpected drops and new external domains.
+//
+// Finding stays in window after it was observed, so it is resolved when
+// it is not observed for window. Applications which findings failed to
+// collect are skipped and returned with errors.
+func (h *Handler) Findings(ctx context.Context, now time.Time, window time.Duration) ([]notify.Finding, map[notify.App]error, error) {
+	ctx, span := h.trace.Start(ctx, "Findings")
+	defer span.End()
+
+	ctx = auth.WithUser(ctx, notifierUser)
+	apps, err := h.getApplications(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "get applications")
+	}
+	var (
+		start  = now.Add(-window)
+		out    []notify.Finding
+		failed = map[notify.App]error{}
+	)
+	for _, app := range apps {
+		findings, err := h.getAppFindings(ctx, app, start, now)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, nil, ctx.Err()
+			}
+			failed[notify.App{Namespace: app.Namespace, Name: app.Name}] = err
+			continue
+		}
+		out = append(out, findings...)
+	}
+	return out, failed, nil
+}
@@ -0,0 +1,195 @@
+package api
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/go-faster/errors"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/go-faster/vega/internal/oas"
+)
+
+// maxEvents limits number of events in application summary.
+const maxEvents = 20
+
+// reasonOOMKilled is termination reason of container killed by OOM killer.
+const reasonOOMKilled = "OOMKilled"
+
+func convertTermination(s *v1.ContainerStateTerminated) oas.OptContainerTermination {
+	if s == nil {
+		return oas.OptContainerTermination{}
+	}
+	out := oas.ContainerTermination{
+		ExitCode: s.ExitCode,
+	}
+	if s.Signal != 0 {
+		out.Signal = oas.NewOptInt32(s.Signal)
+	}
+	if s.Reason != "" {
+		out.Reason = oas.NewOptString(s.Reason)
+	}
+	if s.Message != "" {
+		out.Message = oas.NewOptString(s.Message)
+	}
+	if !s.FinishedAt.IsZero() {
+		out.FinishedAt = oas.NewOptDateTime(s.FinishedAt.Time)
+	}
+	return oas.NewOptContainerTermination(out)
+}
+
+func convertContainerStatus(s v1.ContainerStatus, init bool) oas.ContainerStatus {
+	out := oas.ContainerStatus{
+		Name:            s.Name,
+		Init:            init,
+		Ready:           s.Ready,
+		Restarts:        int(s.RestartCount),
+		State:           oas.ContainerStatusStateWaiting,
+		LastTermination: convertTermination(s.LastTerminationState.Terminated),
+	}
+	var reason, message string
+	switch st := s.State; {
+	case st.Running != nil:
+		out.State = oas.ContainerStatusStateRunning
+		if !st.Running.StartedAt.IsZero() {
+			out.StartedAt = oas.NewOptDateTime(st.Running.StartedAt.Time)
+		}
+	case st.Terminated != nil:
+		out.State = oas.ContainerStatusStateTerminated
+		out.Terminated = convertTermination(st.Terminated)
+		reason, message = st.Terminated.Reason, st.Terminated.Message
+		if !st.Terminated.StartedAt.IsZero() {
+			out.StartedAt = oas.NewOptDateTime(st.Terminated.StartedAt.Time)
+		}
+	case st.Waiting != nil:
+		reason, message = st.Waiting.Reason, st.Waiting.Message
+	}
+	if reason != "" {
+		out.Reason = oas.NewOptString(reason)
+	}
+	if message != "" {
+		out.Message = oas.NewOptString(message)
+	}
+	for _, t := range []oas.OptContainerTermination{out.Terminated, out.LastTermination} {
+		if t.Set && t.Value.Reason.Or("") == reasonOOMKilled {
+			out.OomKilled = true
+		}
+	}
+	return out
+}
+
+// convertContainers returns statuses of init and regular containers of pod
+// and total restart count.
+func convertContainers(pod v1.Pod) ([]oas.ContainerStatus, int) {
+	var (
+		out      = make([]oas.ContainerStatus, 0, len(pod.Status.InitContainerStatuses)+len(pod.Status.ContainerStatuses))
+		restarts int
+	)
+	for _, s := range pod.Status.InitContainerStatuses {
+		out = append(out, convertContainerStatus(s, true))
+		restarts += int(s.RestartCount)
+	}
+	for _, s := range pod.Status.ContainerStatuses {
+		out = append(out, convertContainerStatus(s, false))
+		restarts += int(s.RestartCount)
+	}
+	return out, restarts
+}
+
+// podOwners returns set of objects that events of pods are matched against:
+// pods themselves, their owners and owners of their ReplicaSets.
+func (h *Handler) podOwners(ctx context.Context, namespace string, pods []v1.Pod) (map[string]struct{}, error) {
+	objects := map[string]struct{}{}
+	replicaSets := map[string]struct{}{}
+	for _, pod := range pods {
+		objects[eventObject("Pod", pod.Name)] = struct{}{}
+		for _, ref := range pod.OwnerReferences {
+			objects[eventObject(ref.Kind, ref.Name)] = struct{}{}
+			if ref.Kind == "ReplicaSet" {
+				replicaSets[ref.Name] = struct{}{}
+			}
+		}
+	}
+	for name := range replicaSets {
+		rs, err := h.kube.AppsV1().ReplicaSets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			// Deleted while pod is terminating, e.g. after rollout.
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "get replicaset %q", name)
+		}
+		for _, ref := range rs.OwnerReferences {
+			objects[eventObject(ref.Kind, ref.Name)] = struct{}{}
+		}
+	}
+	return objects, nil
+}
+
+func eventObject(kind, name string) string {
+	return kind + "/" + name
+}
+
+func eventTime(e v1.Event) time.Time {
+	switch {
+	case !e.LastTimestamp.IsZero():
+		return e.LastTimestamp.Time
+	case e.Series != nil && !e.Series.LastObservedTime.IsZero():
+		return e.Series.LastObservedTime.Time
+	case !e.EventTime.IsZero():
+		return e.EventTime.Time
+	default:
+		return e.CreationTimestamp.Time
+	}
+}
+
+func convertEvent(e v1.Event) oas.KubeEvent {
+	count := int(e.Count)
+	if e.Series != nil && int(e.Series.Count) > count {
+		count = int(e.Series.Count)
+	}
+	return oas.KubeEvent{
+		Time:    eventTime(e),
+		Type:    e.Type,
+		Reason:  e.Reason,
+		Message: e.Message,
+		Object:  eventObject(e.InvolvedObject.Kind, e.InvolvedObject.Name),
+		Count:   max(count, 1),
+	}
+}
+
+// getEvents returns recent events of pods and their owners, newest first.
+func (h *Handler) getEvents(ctx context.Context, namespace string, pods []v1.Pod) ([]oas.KubeEvent, error) {
+	ctx, span := h.trace.Start(ctx, "getEvents")
+	defer span.End()
+
+	objects, err := h.podOwners(ctx, namespace, pods)
+	if err != nil {
+		return nil, errors.Wrap(err, "get owners")
+	}
+	list, err := h.kube.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "list events")
+	}
+	var out []oas.KubeEvent
+	for _, e := range list.Items {
+		if _, ok := objects[eventObject(e.InvolvedObject.Kind, e.InvolvedObject.Name)]; !ok {
+			continue
+		}
+		out = append(out, convertEvent(e))
+	}
+	slices.SortFunc(out, func(a, b oas.KubeEvent) int {
+		return cmp.Or(
+			b.Time.Compare(a.Time),
+			cmp.Compare(a.Object, b.Object),
+		)
+	})
+	if len(out) > maxEvents {
+		out = out[:maxEvents]
+	}
+	return out, nil
+}
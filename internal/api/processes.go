@@ -0,0 +1,243 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ClickHouse/ch-go"
+	"github.com/ClickHouse/ch-go/proto"
+	"github.com/go-faster/errors"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/go-faster/vega/internal/flow"
+	"github.com/go-faster/vega/internal/oas"
+	"github.com/go-faster/vega/internal/sec"
+	"github.com/go-faster/vega/internal/semconv"
+)
+
+// maxProcessEvents limits number of process events used to build tree.
+const maxProcessEvents = 10_000
+
+// getPod returns application pod by name, searching namespaces of
+// applications accessible by user.
+func (h *Handler) getPod(ctx context.Context, name, namespace string) (v1.Pod, error) {
+	apps, err := h.getApplications(ctx)
+	if err != nil {
+		return v1.Pod{}, errors.Wrap(err, "get applications")
+	}
+	var (
+		seen  = map[string]struct{}{}
+		found []v1.Pod
+	)
+	for _, app := range apps {
+		if _, ok := seen[app.Namespace]; ok {
+			continue
+		}
+		seen[app.Namespace] = struct{}{}
+		if namespace != "" && app.Namespace != namespace {
+			continue
+		}
+		pods, err := h.kube.CoreV1().Pods(app.Namespace).List(ctx, metav1.ListOptions{
+			LabelSelector: semconv.LabelVegaApp,
+			FieldSelector: "metadata.name=" + name,
+		})
+		if err != nil {
+			return v1.Pod{}, errors.Wrapf(err, "list pods in namespace %s", app.Namespace)
+		}
+		found = append(found, pods.Items...)
+	}
+	switch len(found) {
+	case 0:
+		return v1.Pod{}, &oas.ErrorStatusCode{
+			StatusCode: http.StatusNotFound,
+			Response: oas.Error{
+				ErrorMessage: "pod not found",
+			},
+		}
+	case 1:
+		return found[0], nil
+	default:
+		return v1.Pod{}, &oas.ErrorStatusCode{
+			StatusCode: http.StatusBadRequest,
+			Response: oas.Error{
+				ErrorMessage: "pod name is ambiguous, namespace is required",
+			},
+		}
+	}
+}
+
+// getProcessEvents returns process exec and exit events of pod since start,
+// and whether events were limited.
+func (h *Handler) getProcessEvents(ctx context.Context, pod v1.Pod, start time.Time) ([]sec.Event, bool, error) {
+	ctx, span := h.trace.Start(ctx, "getProcessEvents")
+	defer span.End()
+
+	var (
+		timestamp  = new(proto.ColDateTime64).WithPrecision(proto.PrecisionNano)
+		eventType  proto.ColEnum
+		container  = new(proto.ColStr).LowCardinality()
+		exitStatus proto.ColUInt32
+		exitSignal = new(proto.ColStr).LowCardinality()
+
+		out []sec.Event
+	)
+	process := func(prefix string) (proto.Results, func(i int) sec.ProcessInfo) {
+		var (
+			execID       proto.ColStr
+			parentExecID proto.ColStr
+			pid          proto.ColUInt32
+			uid          proto.ColUInt32
+			binary       proto.ColStr
+			args         proto.ColStr
+			cwd          proto.ColStr
+			startTime    = new(proto.ColDateTime64).WithPrecision(proto.PrecisionNano)
+		)
+		return proto.Results{
+			{Name: prefix + "process_exec_id", Data: &execID},
+			{Name: prefix + "process_parent_exec_id", Data: &parentExecID},
+			{Name: prefix + "process_pid", Data: &pid},
+			{Name: prefix + "process_uid", Data: &uid},
+			{Name: prefix + "process_binary", Data: &binary},
+			{Name: prefix + "process_args", Data: &args},
+			{Name: prefix + "process_cwd", Data: &cwd},
+			{Name: prefix + "process_start_time", Data: startTime},
+		}, func(i int) sec.ProcessInfo {
+			return sec.ProcessInfo{
+				ExecID:       execID.Row(i),
+				ParentExecID: parentExecID.Row(i),
+				PID:          pid.Row(i),
+				UID:          uid.Row(i),
+				Binary:       binary.Row(i),
+				Args:         args.Row(i),
+				Cwd:          cwd.Row(i),
+				Start:        startTime.Row(i),
+			}
+		}
+	}
+	processResult, processRow := process("")
+	parentResult, parentRow := process("parent_")
+
+	result := proto.Results{
+		{Name: "timestamp", Data: timestamp},
+		{Name: "event_type", Data: &eventType},
+		{Name: "k8s_container", Data: container},
+		{Name: "exit_status", Data: &exitStatus},
+		{Name: "exit_signal", Data: exitSignal},
+	}
+	result = append(result, processResult...)
+	result = append(result, parentResult...)
+
+	columns := make([]string, 0, len(result))
+	for _, c := range result {
+		columns = append(columns, c.Name)
+	}
+	// Newest events are kept if limit is reached, so tree is complete
+	// for recent processes.
+	if err := h.ch.Do(ctx, ch.Query{
+		Body: fmt.Sprintf(`SELECT %s
+FROM %s
+WHERE k8s_ns = %s AND k8s_pod = %s
+  AND event_type IN ('ProcessExec', 'ProcessExit')
+  AND timestamp >= fromUnixTimestamp64Nano(toInt64(%d))
+ORDER BY timestamp DESC
+LIMIT %d`,
+			strings.Join(columns, ", "), secTable,
+			flow.Quote(pod.Namespace), flow.Quote(pod.Name), start.UnixNano(),
+			maxProcessEvents+1,
+		),
+		Result: result,
+		OnResult: func(ctx context.Context, block proto.Block) error {
+			for i := 0; i < timestamp.Rows(); i++ {
+				out = append(out, sec.Event{
+					Type:       sec.EventType(eventType.Row(i)),
+					Time:       timestamp.Row(i),
+					Container:  container.Row(i),
+					Process:    processRow(i),
+					Parent:     parentRow(i),
+					ExitStatus: exitStatus.Row(i),
+					ExitSignal: exitSignal.Row(i),
+				})
+			}
+			return nil
+		},
+	}); err != nil {
+		return nil, false, errors.Wrap(err, "query")
+	}
+
+	truncated := len(out) > maxProcessEvents
+	if truncated {
+		// Drop oldest event.
+		out = out[:maxProcessEvents]
+	}
+	return out, truncated, nil
+}
+
+func convertProcessNode(n *sec.Node, now time.Time) oas.ProcessNode {
+	out := oas.ProcessNode{
+		ExecID:    n.ExecID,
+		Pid:       n.PID,
+		UID:       n.UID,
+		Binary:    n.Binary,
+		Arguments: n.Args,
+		Exited:    n.Exited,
+		Partial:   n.Partial,
+		Children:  make([]oas.ProcessNode, 0, len(n.Children)),
+	}
+	if n.Cwd != "" {
+		out.Cwd = oas.NewOptString(n.Cwd)
+	}
+	if n.Container != "" {
+		out.Container = oas.NewOptString(n.Container)
+	}
+	if !n.Start.IsZero() {
+		out.StartTime = oas.NewOptDateTime(n.Start)
+		out.DurationMs = oas.NewOptFloat64(float64(n.Duration(now)) / float64(time.Millisecond))
+	}
+	if n.Exited {
+		out.ExitTime = oas.NewOptDateTime(n.Exit)
+		out.ExitStatus = oas.NewOptUint32(n.ExitStatus)
+		if n.ExitSignal != "" {
+			out.ExitSignal = oas.NewOptString(n.ExitSignal)
+		}
+	}
+	for _, c := range n.Children {
+		out.Children = append(out.Children, convertProcessNode(c, now))
+	}
+	return out
+}
+
+func (h *Handler) GetPodProcesses(ctx context.Context, params oas.GetPodProcessesParams) (*oas.ProcessTree, error) {
+	pod, err := h.getPod(ctx, params.Pod, params.Namespace.Or(""))
+	if err != nil {
+		return nil, err
+	}
+
+	end := time.Now()
+	start := end.Add(-time.Duration(params.Window.Or(3600)) * time.Second)
+	events, truncated, err := h.getProcessEvents(ctx, pod, start)
+	if err != nil {
+		return nil, errors.Wrap(err, "get process events")
+	}
+
+	out := &oas.ProcessTree{
+		Pod:       pod.Name,
+		Namespace: pod.Namespace,
+		Start:     start,
+		End:       end,
+		Processes: []oas.ProcessNode{},
+	}
+	if app := pod.Labels[semconv.LabelVegaApp]; app != "" {
+		out.Application = oas.NewOptString(app)
+	}
+	if truncated {
+		out.Truncated = oas.NewOptBool(true)
+	}
+	for _, n := range sec.BuildTree(events) {
+		out.Processes = append(out.Processes, convertProcessNode(n, end))
+	}
+	return out, nil
+}
@@ -0,0 +1,164 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-faster/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/go-faster/vega/internal/oas"
+	"github.com/go-faster/vega/internal/promapi"
+)
+
+func (h *Handler) getRangeQuery(ctx context.Context, start, end time.Time, step time.Duration, query string) ([]promapi.MatrixResultItem, error) {
+	ctx, span := h.trace.Start(ctx, "getRangeQuery",
+		trace.WithAttributes(
+			attribute.String("query", query),
+		),
+	)
+	defer span.End()
+
+	result, err := h.prom.GetQueryRange(ctx, promapi.GetQueryRangeParams{
+		Query: query,
+		Start: toPrometheusTimestamp(start),
+		End:   toPrometheusTimestamp(end),
+		Step:  strconv.FormatFloat(step.Seconds(), 'f', -1, 64),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "get query range")
+	}
+	return result.Data.Matrix.Result, nil
+}
+
+// podRegex returns PromQL regular expression that matches any of given pods.
+func podRegex(pods []v1.Pod) string {
+	names := make([]string, 0, len(pods))
+	for _, pod := range pods {
+		names = append(names, regexp.QuoteMeta(pod.Name))
+	}
+	return strings.Join(names, "|")
+}
+
+func (h *Handler) GetApplicationResources(ctx context.Context, params oas.GetApplicationResourcesParams) (*oas.ApplicationResources, error) {
+	app, err := h.getApplication(ctx, params.Name)
+	if err != nil {
+		return nil, err
+	}
+	pods, err := h.getApplicationPods(ctx, app)
+	if err != nil {
+		return nil, errors.Wrap(err, "get pods")
+	}
+	out := &oas.ApplicationResources{
+		Name:      app.Name,
+		Namespace: app.Namespace,
+		Pods:      []oas.PodResourcesSeries{},
+	}
+	if len(pods) == 0 {
+		return out, nil
+	}
+
+	var (
+		end    = time.Now()
+		start  = end.Add(-time.Duration(params.Range.Or(900)) * time.Second)
+		step   = time.Duration(params.Step.Or(15)) * time.Second
+		window = max(time.Second*30, step*2)
+		filter = podRegex(pods)
+	)
+	queries := []struct {
+		query string
+		set   func(r *oas.PodResources, v float64)
+	}{
+		{
+			query: fmt.Sprintf(`sum(container_memory_working_set_bytes{namespace=%q, pod=~%q, image!="", container!=""}) by (pod)`,
+				app.Namespace, filter,
+			),
+			set: func(r *oas.PodResources, v float64) { r.MemUsageTotalBytes = int64(v) },
+		},
+		{
+			query: fmt.Sprintf(`sum(rate(container_cpu_usage_seconds_total{namespace=%q, pod=~%q, image!="", container!="", cluster=""}[%s])) by (pod)`,
+				app.Namespace, filter, window,
+			),
+			set: func(r *oas.PodResources, v float64) { r.CPUUsageTotalMillicores = v },
+		},
+		{
+			query: fmt.Sprintf(`sum(rate(container_network_receive_bytes_total{namespace=%q, pod=~%q, cluster=""}[%s])) by (pod)`,
+				app.Namespace, filter, window,
+			),
+			set: func(r *oas.PodResources, v float64) { r.NetRxBytesPerSecond = int64(v) },
+		},
+		{
+			query: fmt.Sprintf(`sum(rate(container_network_transmit_bytes_total{namespace=%q, pod=~%q, cluster=""}[%s])) by (pod)`,
+				app.Namespace, filter, window,
+			),
+			set: func(r *oas.PodResources, v float64) { r.NetTxBytesPerSecond = int64(v) },
+		},
+	}
+
+	var (
+		mux    sync.Mutex
+		points = map[string]map[int64]*oas.PodResources{} // pod -> unix timestamp -> sample
+	)
+	g, ctx := errgroup.WithContext(ctx)
+	for _, q := range queries {
+		g.Go(func() error {
+			result, err := h.getRangeQuery(ctx, start, end, step, q.query)
+			if err != nil {
+				return errors.Wrap(err, "query")
+			}
+			mux.Lock()
+			defer mux.Unlock()
+			for _, series := range result {
+				pod := series.Metric["pod"]
+				if points[pod] == nil {
+					points[pod] = map[int64]*oas.PodResources{}
+				}
+				for _, p := range series.Values {
+					t := int64(math.Round(p.T))
+					r, ok := points[pod][t]
+					if !ok {
+						r = &oas.PodResources{}
+						points[pod][t] = r
+					}
+					q.set(r, p.V)
+				}
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, errors.Wrap(err, "get resources")
+	}
+
+	for pod, samples := range points {
+		series := oas.PodResourcesSeries{
+			Pod:    pod,
+			Points: make([]oas.PodResourcesPoint, 0, len(samples)),
+		}
+		for t, r := range samples {
+			series.Points = append(series.Points, oas.PodResourcesPoint{
+				Time:      time.Unix(t, 0).UTC(),
+				Resources: *r,
+			})
+		}
+		slices.SortFunc(series.Points, func(a, b oas.PodResourcesPoint) int {
+			return a.Time.Compare(b.Time)
+		})
+		out.Pods = append(out.Pods, series)
+	}
+	slices.SortFunc(out.Pods, func(a, b oas.PodResourcesSeries) int {
+		return strings.Compare(a.Pod, b.Pod)
+	})
+
+	return out, nil
+}
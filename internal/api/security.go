@@ -0,0 +1,82 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/go-faster/errors"
+	"github.com/go-faster/sdk/zctx"
+	"go.uber.org/zap"
+
+	"github.com/go-faster/vega/internal/auth"
+	"github.com/go-faster/vega/internal/oas"
+)
+
+var _ oas.SecurityHandler = (*Security)(nil)
+
+// Security authenticates API requests.
+type Security struct {
+	verifier *auth.Verifier
+}
+
+// NewSecurity returns new Security.
+//
+// If verifier is nil, authentication is disabled and every request
+// is made on behalf of admin.
+func NewSecurity(verifier *auth.Verifier) *Security {
+	return &Security{verifier: verifier}
+}
+
+// HandleBearerAuth implements oas.SecurityHandler.
+func (s *Security) HandleBearerAuth(ctx context.Context, operationName oas.OperationName, t oas.BearerAuth) (context.Context, error) {
+	if s.verifier == nil {
+		return auth.WithUser(ctx, &auth.User{Name: "anonymous", Admin: true}), nil
+	}
+	u, err := s.verifier.Verify(ctx, t.Token)
+	if err != nil {
+		zctx.From(ctx).Debug("Authentication failed", zap.Error(err))
+		return nil, errors.Wrap(err, "authenticate")
+	}
+	zctx.From(ctx).Debug("Authenticated",
+		zap.String("user", u.Name),
+		zap.Bool("admin", u.Admin),
+	)
+	return auth.WithUser(ctx, u), nil
+}
+
+// Authenticate is middleware that authenticates requests to handlers
+// without OpenAPI security, like Prometheus API proxy.
+func (s *Security) Authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var token string
+		if v, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok {
+			token = v
+		} else if s.verifier != nil {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, "bearer token required", http.StatusUnauthorized)
+			return
+		}
+		ctx, err := s.HandleBearerAuth(r.Context(), "", oas.BearerAuth{Token: token})
+		if err != nil {
+			http.Error(w, "invalid token", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// Middleware makes requests without token pass security requirements
+// when authentication is disabled.
+func (s *Security) Middleware(next http.Handler) http.Handler {
+	if s.verifier != nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			r = r.Clone(r.Context())
+			r.Header.Set("Authorization", "Bearer anonymous")
+		}
+		next.ServeHTTP(w, r)
+	})
+}
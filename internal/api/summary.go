@@ -0,0 +1,260 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ClickHouse/ch-go"
+	"github.com/ClickHouse/ch-go/proto"
+	"github.com/go-faster/errors"
+	"golang.org/x/sync/errgroup"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/go-faster/vega/internal/oas"
+)
+
+// summaryTop limits number of entries in top lists of summary.
+const summaryTop = 10
+
+// flowPeerExpr is SQL expression of flow peer: workload, pod, DNS name or
+// IP of the other side relative to indexed pod.
+const flowPeerExpr = `multiIf(
+    direction = 'INVERSE' AND notEmpty(endpoint_src_workloads_names), concat(endpoint_src_namespace, '/', endpoint_src_workloads_names[1]),
+    direction != 'INVERSE' AND notEmpty(endpoint_dst_workloads_names), concat(endpoint_dst_namespace, '/', endpoint_dst_workloads_names[1]),
+    k8s_peer_pod != '', concat(k8s_peer_ns, '/', k8s_peer_pod),
+    direction = 'INVERSE' AND notEmpty(src_names), src_names[1],
+    direction != 'INVERSE' AND notEmpty(dst_names), dst_names[1],
+    direction = 'INVERSE', if(ip_version = 'IPv6', toString(ipv6_src), toString(ipv4_src)),
+    if(ip_version = 'IPv6', toString(ipv6_dst), toString(ipv4_dst))
+)`
+
+// windowCondition returns SQL condition that matches rows of pods in window.
+func windowCondition(namespace string, pods []v1.Pod, start time.Time) string {
+	return fmt.Sprintf("%s AND timestamp >= fromUnixTimestamp64Nano(toInt64(%d))",
+		podsCondition("k8s_ns", "k8s_pod", namespace, pods),
+		start.UnixNano(),
+	)
+}
+
+// getNetworkSummary aggregates hubble flows of pods since start.
+func (h *Handler) getNetworkSummary(ctx context.Context, namespace string, pods []v1.Pod, start time.Time) (*oas.NetworkSummary, error) {
+	ctx, span := h.trace.Start(ctx, "getNetworkSummary")
+	defer span.End()
+
+	out := &oas.NetworkSummary{
+		TopPeers:       []oas.PeerTraffic{},
+		DropReasons:    []oas.DropReasonCount{},
+		HTTPStatuses:   []oas.HTTPStatusCount{},
+		TopDNSFailures: []oas.DNSFailure{},
+	}
+	cond := windowCondition(namespace, pods, start)
+	const dnsFailure = "l7_protocol = 'DNS' AND l7_flow_type = 'RESPONSE' AND l7_dns_response_code != 0"
+
+	g, ctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		var flows, dropped, dnsFailures proto.ColUInt64
+		if err := h.ch.Do(ctx, ch.Query{
+			Body: fmt.Sprintf(`SELECT sum(flow_count) AS flows,
+    sumIf(flow_count, verdict = 'DROPPED') AS dropped,
+    countIf(%s) AS dns_failures
+FROM %s WHERE %s`, dnsFailure, flowTable, cond),
+			Result: proto.Results{
+				{Name: "flows", Data: &flows},
+				{Name: "dropped", Data: &dropped},
+				{Name: "dns_failures", Data: &dnsFailures},
+			},
+			OnResult: func(ctx context.Context, block proto.Block) error {
+				for i := 0; i < flows.Rows(); i++ {
+					out.Flows = int64(flows.Row(i))             //#nosec G115
+					out.Dropped = int64(dropped.Row(i))         //#nosec G115
+					out.DNSFailures = int64(dnsFailures.Row(i)) //#nosec G115
+				}
+				return nil
+			},
+		}); err != nil {
+			return errors.Wrap(err, "query totals")
+		}
+		return nil
+	})
+	g.Go(func() error {
+		var (
+			peer    proto.ColStr
+			flows   proto.ColUInt64
+			dropped proto.ColUInt64
+		)
+		if err := h.ch.Do(ctx, ch.Query{
+			Body: fmt.Sprintf(`SELECT %s AS peer, sum(flow_count) AS flows, sumIf(flow_count, verdict = 'DROPPED') AS dropped
+FROM %s WHERE %s
+GROUP BY peer ORDER BY flows DESC, peer LIMIT %d`, flowPeerExpr, flowTable, cond, summaryTop),
+			Result: proto.Results{
+				{Name: "peer", Data: &peer},
+				{Name: "flows", Data: &flows},
+				{Name: "dropped", Data: &dropped},
+			},
+			OnResult: func(ctx context.Context, block proto.Block) error {
+				for i := 0; i < peer.Rows(); i++ {
+					out.TopPeers = append(out.TopPeers, oas.PeerTraffic{
+						Peer:    peer.Row(i),
+						Flows:   int64(flows.Row(i)),   //#nosec G115
+						Dropped: int64(dropped.Row(i)), //#nosec G115
+					})
+				}
+				return nil
+			},
+		}); err != nil {
+			return errors.Wrap(err, "query peers")
+		}
+		return nil
+	})
+	g.Go(func() error {
+		var (
+			reason = new(proto.ColStr).LowCardinality()
+			count  proto.ColUInt64
+		)
+		if err := h.ch.Do(ctx, ch.Query{
+			Body: fmt.Sprintf(`SELECT drop_reason, sum(flow_count) AS count
+FROM %s WHERE %s AND verdict = 'DROPPED'
+GROUP BY drop_reason ORDER BY count DESC, drop_reason`, flowTable, cond),
+			Result: proto.Results{
+				{Name: "drop_reason", Data: reason},
+				{Name: "count", Data: &count},
+			},
+			OnResult: func(ctx context.Context, block proto.Block) error {
+				for i := 0; i < count.Rows(); i++ {
+					out.DropReasons = append(out.DropReasons, oas.DropReasonCount{
+						Reason: reason.Row(i),
+						Count:  int64(count.Row(i)), //#nosec G115
+					})
+				}
+				return nil
+			},
+		}); err != nil {
+			return errors.Wrap(err, "query drop reasons")
+		}
+		return nil
+	})
+	g.Go(func() error {
+		var (
+			code  proto.ColUInt16
+			count proto.ColUInt64
+		)
+		if err := h.ch.Do(ctx, ch.Query{
+			Body: fmt.Sprintf(`SELECT l7_http_code, count() AS count
+FROM %s WHERE %s AND l7_protocol = 'HTTP' AND l7_flow_type = 'RESPONSE'
+GROUP BY l7_http_code ORDER BY l7_http_code`, flowTable, cond),
+			Result: proto.Results{
+				{Name: "l7_http_code", Data: &code},
+				{Name: "count", Data: &count},
+			},
+			OnResult: func(ctx context.Context, block proto.Block) error {
+				for i := 0; i < count.Rows(); i++ {
+					out.HTTPStatuses = append(out.HTTPStatuses, oas.HTTPStatusCount{
+						Code:  int(code.Row(i)),
+						Count: int64(count.Row(i)), //#nosec G115
+					})
+				}
+				return nil
+			},
+		}); err != nil {
+			return errors.Wrap(err, "query http statuses")
+		}
+		return nil
+	})
+	g.Go(func() error {
+		var (
+			query proto.ColStr
+			rcode proto.ColUInt16
+			count proto.ColUInt64
+		)
+		if err := h.ch.Do(ctx, ch.Query{
+			Body: fmt.Sprintf(`SELECT l7_dns_query, l7_dns_response_code, count() AS count
+FROM %s WHERE %s AND %s
+GROUP BY l7_dns_query, l7_dns_response_code ORDER BY count DESC, l7_dns_query LIMIT %d`,
+				flowTable, cond, dnsFailure, summaryTop),
+			Result: proto.Results{
+				{Name: "l7_dns_query", Data: &query},
+				{Name: "l7_dns_response_code", Data: &rcode},
+				{Name: "count", Data: &count},
+			},
+			OnResult: func(ctx context.Context, block proto.Block) error {
+				for i := 0; i < count.Rows(); i++ {
+					out.TopDNSFailures = append(out.TopDNSFailures, oas.DNSFailure{
+						Query: query.Row(i),
+						Rcode: int(rcode.Row(i)),
+						Count: int64(count.Row(i)), //#nosec G115
+					})
+				}
+				return nil
+			},
+		}); err != nil {
+			return errors.Wrap(err, "query dns failures")
+		}
+		return nil
+	})
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// getSecuritySummary aggregates tetragon events of pods since start.
+func (h *Handler) getSecuritySummary(ctx context.Context, namespace string, pods []v1.Pod, start time.Time) (*oas.SecuritySummary, error) {
+	ctx, span := h.trace.Start(ctx, "getSecuritySummary")
+	defer span.End()
+
+	var (
+		out      = &oas.SecuritySummary{}
+		execs    proto.ColUInt64
+		binaries proto.ColUInt64
+	)
+	if err := h.ch.Do(ctx, ch.Query{
+		Body: fmt.Sprintf(`SELECT count() AS execs, uniqExact(process_binary) AS binaries
+FROM %s WHERE %s AND event_type = 'ProcessExec'`, secTable, windowCondition(namespace, pods, start)),
+		Result: proto.Results{
+			{Name: "execs", Data: &execs},
+			{Name: "binaries", Data: &binaries},
+		},
+		OnResult: func(ctx context.Context, block proto.Block) error {
+			for i := 0; i < execs.Rows(); i++ {
+				out.Execs = int64(execs.Row(i))       //#nosec G115
+				out.Binaries = int64(binaries.Row(i)) //#nosec G115
+			}
+			return nil
+		},
+	}); err != nil {
+		return nil, errors.Wrap(err, "query execs")
+	}
+	return out, nil
+}
+
+// getActivity fills network and security summary of application.
+//
+// Summary is best-effort: pods and events are still reported if
+// ClickHouse is not available.
+func (h *Handler) getActivity(ctx context.Context, summary *oas.ApplicationSummary, pods []v1.Pod, window time.Duration) error {
+	if len(pods) == 0 {
+		return nil
+	}
+	start := time.Now().Add(-window)
+	seconds := int(window.Seconds())
+
+	var errs []string
+	if v, err := h.getNetworkSummary(ctx, summary.Namespace, pods, start); err != nil {
+		errs = append(errs, err.Error())
+	} else {
+		v.Window = seconds
+		summary.Network = oas.NewOptNetworkSummary(*v)
+	}
+	if v, err := h.getSecuritySummary(ctx, summary.Namespace, pods, start); err != nil {
+		errs = append(errs, err.Error())
+	} else {
+		v.Window = seconds
+		summary.Security = oas.NewOptSecuritySummary(*v)
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,266 @@
+package api
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ClickHouse/ch-go"
+	"github.com/ClickHouse/ch-go/proto"
+	"github.com/go-faster/errors"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/go-faster/vega/internal/auth"
+	"github.com/go-faster/vega/internal/flow"
+	"github.com/go-faster/vega/internal/oas"
+	"github.com/go-faster/vega/internal/tempo"
+)
+
+// Resource attributes of spans.
+const (
+	attrServiceName  = "service.name"
+	attrK8sNamespace = "k8s.namespace.name"
+	attrK8sPod       = "k8s.pod.name"
+)
+
+// maxTraceFlows limits number of flows in trace.
+const maxTraceFlows = 1000
+
+const (
+	// traceFlowsMargin is added to time range of trace spans to search
+	// flows, as flows and spans are timestamped by different clocks.
+	traceFlowsMargin = 5 * time.Minute
+	// traceFlowsWindow is time before now to search flows of trace without
+	// spans, same as TTL of hubble table.
+	traceFlowsWindow = 6 * time.Hour
+)
+
+var spanKinds = map[tracepb.Span_SpanKind]oas.SpanKind{
+	tracepb.Span_SPAN_KIND_UNSPECIFIED: oas.SpanKindUnspecified,
+	tracepb.Span_SPAN_KIND_INTERNAL:    oas.SpanKindInternal,
+	tracepb.Span_SPAN_KIND_SERVER:      oas.SpanKindServer,
+	tracepb.Span_SPAN_KIND_CLIENT:      oas.SpanKindClient,
+	tracepb.Span_SPAN_KIND_PRODUCER:    oas.SpanKindProducer,
+	tracepb.Span_SPAN_KIND_CONSUMER:    oas.SpanKindConsumer,
+}
+
+var spanStatuses = map[tracepb.Status_StatusCode]oas.SpanStatus{
+	tracepb.Status_STATUS_CODE_UNSET: oas.SpanStatusUnset,
+	tracepb.Status_STATUS_CODE_OK:    oas.SpanStatusOk,
+	tracepb.Status_STATUS_CODE_ERROR: oas.SpanStatusError,
+}
+
+// attributeValue returns string representation of attribute value.
+func attributeValue(v *commonpb.AnyValue) string {
+	switch v := v.GetValue().(type) {
+	case *commonpb.AnyValue_StringValue:
+		return v.StringValue
+	case *commonpb.AnyValue_IntValue:
+		return strconv.FormatInt(v.IntValue, 10)
+	case *commonpb.AnyValue_DoubleValue:
+		return strconv.FormatFloat(v.DoubleValue, 'g', -1, 64)
+	case *commonpb.AnyValue_BoolValue:
+		return strconv.FormatBool(v.BoolValue)
+	case *commonpb.AnyValue_BytesValue:
+		return hex.EncodeToString(v.BytesValue)
+	case *commonpb.AnyValue_ArrayValue:
+		values := make([]string, 0, len(v.ArrayValue.GetValues()))
+		for _, e := range v.ArrayValue.GetValues() {
+			values = append(values, attributeValue(e))
+		}
+		return "[" + strings.Join(values, ", ") + "]"
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func attributesMap(attrs []*commonpb.KeyValue) oas.LabelMap {
+	out := make(oas.LabelMap, len(attrs))
+	for _, kv := range attrs {
+		out[kv.GetKey()] = attributeValue(kv.GetValue())
+	}
+	return out
+}
+
+func convertSpans(data *tracepb.TracesData) []oas.Span {
+	var out []oas.Span
+	for _, rs := range data.GetResourceSpans() {
+		resource := attributesMap(rs.GetResource().GetAttributes())
+		for _, ss := range rs.GetScopeSpans() {
+			for _, s := range ss.GetSpans() {
+				span := oas.Span{
+					SpanID:     oas.SpanID(hex.EncodeToString(s.GetSpanId())),
+					Name:       s.GetName(),
+					Service:    resource[attrServiceName],
+					Kind:       spanKinds[s.GetKind()],
+					Start:      time.Unix(0, int64(s.GetStartTimeUnixNano())), //#nosec G115
+					End:        time.Unix(0, int64(s.GetEndTimeUnixNano())),   //#nosec G115
+					Status:     spanStatuses[s.GetStatus().GetCode()],
+					Attributes: attributesMap(s.GetAttributes()),
+				}
+				if span.Kind == "" {
+					span.Kind = oas.SpanKindUnspecified
+				}
+				if span.Status == "" {
+					span.Status = oas.SpanStatusUnset
+				}
+				if id := s.GetParentSpanId(); len(id) > 0 {
+					span.ParentSpanID = oas.NewOptSpanID(oas.SpanID(hex.EncodeToString(id)))
+				}
+				if v := s.GetStatus().GetMessage(); v != "" {
+					span.StatusMessage = oas.NewOptString(v)
+				}
+				if v := resource[attrK8sNamespace]; v != "" {
+					span.Namespace = oas.NewOptString(v)
+				}
+				if v := resource[attrK8sPod]; v != "" {
+					span.Pod = oas.NewOptString(v)
+				}
+				out = append(out, span)
+			}
+		}
+	}
+	slices.SortStableFunc(out, func(a, b oas.Span) int {
+		return a.Start.Compare(b.Start)
+	})
+	return out
+}
+
+// namespaceFilter returns function that reports whether user can access
+// namespace.
+func (h *Handler) namespaceFilter(ctx context.Context) (func(ns string) bool, error) {
+	user, ok := auth.UserFromContext(ctx)
+	if !ok {
+		return nil, &oas.ErrorStatusCode{
+			StatusCode: http.StatusUnauthorized,
+			Response: oas.Error{
+				ErrorMessage: "unauthenticated",
+			},
+		}
+	}
+	if user.Admin {
+		return func(string) bool { return true }, nil
+	}
+	list, err := h.kube.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "list namespaces")
+	}
+	allowed := map[string]struct{}{}
+	for _, ns := range list.Items {
+		if user.CanAccess(&ns) {
+			allowed[ns.Name] = struct{}{}
+		}
+	}
+	return func(ns string) bool {
+		_, ok := allowed[ns]
+		return ok
+	}, nil
+}
+
+func (h *Handler) GetTrace(ctx context.Context, params oas.GetTraceParams) (*oas.Trace, error) {
+	allowed, err := h.namespaceFilter(ctx)
+	if err != nil {
+		return nil, err
+	}
+	traceID := strings.ToLower(string(params.TraceID))
+	out := &oas.Trace{
+		TraceID: oas.TraceID(traceID),
+		Spans:   []oas.Span{},
+		Flows:   oas.FlowList{},
+	}
+
+	var spans []oas.Span
+	if h.tempo != nil {
+		data, err := h.tempo.Trace(ctx, traceID)
+		switch {
+		case errors.Is(err, tempo.ErrNotFound):
+			// Flows are searched in default window.
+		case err != nil:
+			return nil, errors.Wrap(err, "get trace")
+		default:
+			spans = convertSpans(data)
+		}
+	}
+	// Flows query is bounded by time range of spans.
+	start, end := traceFlowsRange(spans, time.Now())
+	flows, err := h.getTraceFlows(ctx, traceID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range spans {
+		if allowed(s.Namespace.Or("")) {
+			out.Spans = append(out.Spans, s)
+		}
+	}
+	for _, f := range flows {
+		if allowed(f.Source.Namespace.Or("")) || allowed(f.Destination.Namespace.Or("")) {
+			out.Flows = append(out.Flows, f)
+		}
+	}
+	if len(out.Spans) == 0 && len(out.Flows) == 0 {
+		return nil, &oas.ErrorStatusCode{
+			StatusCode: http.StatusNotFound,
+			Response: oas.Error{
+				ErrorMessage: "trace not found",
+			},
+		}
+	}
+	return out, nil
+}
+
+// traceFlowsRange returns time range to search flows of trace with spans.
+func traceFlowsRange(spans []oas.Span, now time.Time) (start, end time.Time) {
+	if len(spans) == 0 {
+		return now.Add(-traceFlowsWindow), now
+	}
+	for i, s := range spans {
+		if i == 0 || s.Start.Before(start) {
+			start = s.Start
+		}
+		if i == 0 || s.End.After(end) {
+			end = s.End
+		}
+	}
+	return start.Add(-traceFlowsMargin), end.Add(traceFlowsMargin)
+}
+
+// getTraceFlows returns hubble flows of trace in [start, end) ordered by time.
+func (h *Handler) getTraceFlows(ctx context.Context, traceID string, start, end time.Time) (oas.FlowList, error) {
+	ctx, span := h.trace.Start(ctx, "getTraceFlows")
+	defer span.End()
+
+	var out oas.FlowList
+	t := flow.NewTable(flowTable)
+	query := fmt.Sprintf(`SELECT %s FROM %s
+WHERE trace_id = %s
+    AND timestamp >= fromUnixTimestamp64Nano(toInt64(%d))
+    AND timestamp < fromUnixTimestamp64Nano(toInt64(%d))
+ORDER BY timestamp LIMIT %d`,
+		strings.Join(t.ResultColumns(), ", "),
+		flowTable,
+		flow.Quote(traceID),
+		start.UnixNano(), end.UnixNano(),
+		maxTraceFlows,
+	)
+	if err := h.ch.Do(ctx, ch.Query{
+		Body:   query,
+		Result: t.Result(),
+		OnResult: func(ctx context.Context, block proto.Block) error {
+			return t.Each(func(row flow.Row) error {
+				out = append(out, convertFlow(row.Raw))
+				return nil
+			})
+		},
+	}); err != nil {
+		return nil, errors.Wrap(err, "query flows")
+	}
+	return out, nil
+}
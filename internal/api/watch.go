@@ -0,0 +1,147 @@
+package api
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/go-faster/jx"
+	"github.com/go-faster/sdk/zctx"
+	"go.uber.org/zap"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/go-faster/vega/internal/oas"
+	"github.com/go-faster/vega/internal/semconv"
+	"github.com/go-faster/vega/internal/sse"
+)
+
+type jsonEncoder interface {
+	Encode(e *jx.Encoder)
+}
+
+func writeEvent(w *sse.Writer, name string, v jsonEncoder) error {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	v.Encode(e)
+	return w.Write(name, e.Bytes())
+}
+
+func (h *Handler) WatchApplication(ctx context.Context, params oas.WatchApplicationParams) (oas.WatchApplicationOK, error) {
+	app, err := h.getApplication(ctx, params.Name)
+	if err != nil {
+		return oas.WatchApplicationOK{}, err
+	}
+	watcher, err := h.kube.CoreV1().Pods(app.Namespace).Watch(ctx, metav1.ListOptions{
+		LabelSelector:       appSelector(app.Name),
+		AllowWatchBookmarks: true,
+	})
+	if err != nil {
+		return oas.WatchApplicationOK{}, errors.Wrap(err, "watch pods")
+	}
+
+	interval := time.Duration(params.Interval.Or(5)) * time.Second
+	r, w := io.Pipe()
+	go func() {
+		err := h.watchApplication(ctx, app, interval, watcher, sse.NewWriter(w))
+		if err != nil && ctx.Err() == nil {
+			zctx.From(ctx).Warn("Watch application", zap.Error(err))
+		}
+		_ = w.CloseWithError(err)
+	}()
+
+	return oas.WatchApplicationOK{Data: r}, nil
+}
+
+// watchApplication streams pod events and periodic resource samples of
+// application until context is done or client is gone.
+func (h *Handler) watchApplication(
+	ctx context.Context,
+	app oas.Application,
+	interval time.Duration,
+	watcher watch.Interface,
+	w *sse.Writer,
+) error {
+	defer func() {
+		// Watcher is replaced on re-connect.
+		watcher.Stop()
+	}()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var (
+		pods            = map[string]v1.Pod{}
+		resourceVersion string
+	)
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			list := make([]v1.Pod, 0, len(pods))
+			for _, pod := range pods {
+				list = append(list, pod)
+			}
+			sample, err := h.getPods(ctx, list)
+			if err != nil {
+				// Keep streaming pod events, resources are best-effort.
+				zctx.From(ctx).Warn("Sample resources", zap.Error(err))
+				continue
+			}
+			if err := writeEvent(w, semconv.EventResources, &oas.ApplicationSummary{
+				Name:      app.Name,
+				Namespace: app.Namespace,
+				Pods:      sample,
+			}); err != nil {
+				return errors.Wrap(err, "write resources")
+			}
+		case e, ok := <-watcher.ResultChan():
+			if !ok {
+				// Server closed watch, e.g. due to timeout, continue from last seen version.
+				watcher.Stop()
+				next, err := h.kube.CoreV1().Pods(app.Namespace).Watch(ctx, metav1.ListOptions{
+					LabelSelector:       appSelector(app.Name),
+					AllowWatchBookmarks: true,
+					ResourceVersion:     resourceVersion,
+				})
+				if err != nil {
+					return errors.Wrap(err, "re-watch pods")
+				}
+				watcher = next
+				continue
+			}
+			if e.Type == watch.Error {
+				return errors.Wrap(apierrors.FromObject(e.Object), "watch")
+			}
+			pod, ok := e.Object.(*v1.Pod)
+			if !ok {
+				continue
+			}
+			resourceVersion = pod.ResourceVersion
+
+			var name string
+			switch e.Type {
+			case watch.Added:
+				name = semconv.EventPodAdded
+				pods[pod.Name] = *pod
+			case watch.Modified:
+				name = semconv.EventPodUpdated
+				pods[pod.Name] = *pod
+			case watch.Deleted:
+				name = semconv.EventPodDeleted
+				delete(pods, pod.Name)
+			default:
+				// Bookmark.
+				continue
+			}
+			v := convertPod(*pod, oas.PodResources{})
+			if err := writeEvent(w, name, &v); err != nil {
+				return errors.Wrap(err, "write pod")
+			}
+		}
+	}
+}
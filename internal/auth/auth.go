@@ -0,0 +1,213 @@
+// Package auth implements authentication and authorization of vega API users.
+package auth
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/go-faster/errors"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/go-faster/vega"
+)
+
+// User is authenticated API user.
+type User struct {
+	Name   string
+	Email  string
+	Groups []string // GitLab group full paths, like "org/team"
+	Admin  bool     // can access all namespaces
+}
+
+type userKey struct{}
+
+// WithUser returns new context with user.
+func WithUser(ctx context.Context, u *User) context.Context {
+	return context.WithValue(ctx, userKey{}, u)
+}
+
+// UserFromContext returns user from context.
+func UserFromContext(ctx context.Context) (*User, bool) {
+	u, ok := ctx.Value(userKey{}).(*User)
+	return u, ok && u != nil
+}
+
+// NamespaceGroups returns GitLab groups mapped to namespace.
+//
+// Groups are listed in comma-separated vega.AnnotationGitLabGroups annotation.
+func NamespaceGroups(ns *v1.Namespace) []string {
+	var out []string
+	for _, g := range strings.Split(ns.Annotations[vega.AnnotationGitLabGroups], ",") {
+		if g = strings.Trim(strings.TrimSpace(g), "/"); g != "" {
+			out = append(out, g)
+		}
+	}
+	return out
+}
+
+// CanAccess reports whether user can access namespace.
+//
+// Members of parent group can access namespaces of subgroups, like in GitLab.
+func (u *User) CanAccess(ns *v1.Namespace) bool {
+	if u.Admin {
+		return true
+	}
+	for _, target := range NamespaceGroups(ns) {
+		for _, g := range u.Groups {
+			if target == g || strings.HasPrefix(target, g+"/") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ParseAdminList parses comma-separated list of admin user names or emails,
+// like in vega.EnvAdminList.
+func ParseAdminList(s string) []string {
+	var out []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// Options for Verifier.
+type Options struct {
+	// Issuer URL, should match "iss" claim of tokens.
+	Issuer string
+	// DiscoveryURL to fetch provider metadata from, if Issuer is not
+	// reachable directly, e.g. from within cluster. Defaults to Issuer.
+	DiscoveryURL string
+	// ClientID, should match "aud" claim of tokens.
+	ClientID string
+	// CLIClientID is client ID of public application that is used by
+	// "v login", also accepted in "aud" claim. Optional.
+	CLIClientID string
+	// Admins are user names or emails that can access all namespaces.
+	Admins []string
+	// HTTP client to use, http.DefaultClient if nil.
+	HTTP *http.Client
+}
+
+// Verifier verifies OIDC ID tokens, e.g. issued by GitLab.
+type Verifier struct {
+	opt Options
+
+	mux      sync.Mutex
+	verifier *oidc.IDTokenVerifier // lazily initialized
+}
+
+// NewVerifier initializes new Verifier.
+//
+// Provider metadata is fetched on first verification, so API can start
+// while issuer is unavailable.
+func NewVerifier(opt Options) *Verifier {
+	if opt.DiscoveryURL == "" {
+		opt.DiscoveryURL = opt.Issuer
+	}
+	if opt.HTTP == nil {
+		opt.HTTP = http.DefaultClient
+	}
+	return &Verifier{opt: opt}
+}
+
+func (v *Verifier) getVerifier(ctx context.Context) (*oidc.IDTokenVerifier, error) {
+	v.mux.Lock()
+	defer v.mux.Unlock()
+	if v.verifier != nil {
+		return v.verifier, nil
+	}
+
+	// Provider keeps context to fetch keys later, so detach it from request.
+	ctx = oidc.ClientContext(context.WithoutCancel(ctx), v.opt.HTTP)
+	if v.opt.DiscoveryURL != v.opt.Issuer {
+		ctx = oidc.InsecureIssuerURLContext(ctx, v.opt.Issuer)
+	}
+	provider, err := oidc.NewProvider(ctx, v.opt.DiscoveryURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "discover provider")
+	}
+	var meta struct {
+		KeysURL string   `json:"jwks_uri"`
+		Algs    []string `json:"id_token_signing_alg_values_supported"`
+	}
+	if err := provider.Claims(&meta); err != nil {
+		return nil, errors.Wrap(err, "parse provider metadata")
+	}
+	// Keys URL is advertised relative to public issuer URL.
+	keysURL := meta.KeysURL
+	if rest, ok := strings.CutPrefix(keysURL, strings.TrimSuffix(v.opt.Issuer, "/")); ok {
+		keysURL = strings.TrimSuffix(v.opt.DiscoveryURL, "/") + rest
+	}
+	v.verifier = oidc.NewVerifier(v.opt.Issuer, oidc.NewRemoteKeySet(ctx, keysURL), &oidc.Config{
+		ClientID:             v.opt.ClientID,
+		SupportedSigningAlgs: meta.Algs,
+		// Audience is checked in Verify to accept both clients.
+		SkipClientIDCheck: v.opt.CLIClientID != "",
+	})
+	return v.verifier, nil
+}
+
+// claims of ID token.
+//
+// GitLab uses "nickname" for user name and "groups_direct" for groups.
+type claims struct {
+	PreferredUsername string   `json:"preferred_username"`
+	Nickname          string   `json:"nickname"`
+	Email             string   `json:"email"`
+	Groups            []string `json:"groups"`
+	GroupsDirect      []string `json:"groups_direct"`
+}
+
+func (v *Verifier) validAudience(aud string) bool {
+	return aud == v.opt.ClientID || aud == v.opt.CLIClientID
+}
+
+// Verify verifies raw ID token and returns user.
+func (v *Verifier) Verify(ctx context.Context, rawToken string) (*User, error) {
+	verifier, err := v.getVerifier(ctx)
+	if err != nil {
+		return nil, err
+	}
+	token, err := verifier.Verify(ctx, rawToken)
+	if err != nil {
+		return nil, errors.Wrap(err, "verify")
+	}
+	if v.opt.CLIClientID != "" && !slices.ContainsFunc(token.Audience, v.validAudience) {
+		return nil, errors.Errorf("verify: expected audience %q or %q, got %q",
+			v.opt.ClientID, v.opt.CLIClientID, token.Audience,
+		)
+	}
+	var c claims
+	if err := token.Claims(&c); err != nil {
+		return nil, errors.Wrap(err, "parse claims")
+	}
+	u := &User{
+		Name:  c.PreferredUsername,
+		Email: c.Email,
+	}
+	if u.Name == "" {
+		u.Name = c.Nickname
+	}
+	if u.Name == "" {
+		u.Name = token.Subject
+	}
+	for _, g := range append(c.Groups, c.GroupsDirect...) {
+		if !slices.Contains(u.Groups, g) {
+			u.Groups = append(u.Groups, g)
+		}
+	}
+	for _, admin := range v.opt.Admins {
+		if admin == u.Name || (u.Email != "" && admin == u.Email) {
+			u.Admin = true
+		}
+	}
+	return u, nil
+}
@@ -0,0 +1,174 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/go-faster/vega"
+)
+
+type testIssuer struct {
+	*httptest.Server
+	issuer string // public issuer URL, server URL by default
+	signer jose.Signer
+}
+
+func newTestIssuer(t *testing.T) *testIssuer {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	signer, err := jose.NewSigner(jose.SigningKey{
+		Algorithm: jose.RS256,
+		Key:       jose.JSONWebKey{Key: key, KeyID: "test"},
+	}, nil)
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	s := &testIssuer{
+		Server: httptest.NewServer(mux),
+		signer: signer,
+	}
+	s.issuer = s.URL
+	t.Cleanup(s.Close)
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"issuer":                 s.issuer,
+			"jwks_uri":               s.issuer + "/keys",
+			"authorization_endpoint": s.issuer + "/oauth/authorize",
+			"token_endpoint":         s.issuer + "/oauth/token",
+			"id_token_signing_alg_values_supported": []string{
+				"RS256",
+			},
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(jose.JSONWebKeySet{
+			Keys: []jose.JSONWebKey{
+				{Key: &key.PublicKey, KeyID: "test", Algorithm: "RS256", Use: "sig"},
+			},
+		})
+	})
+	return s
+}
+
+func (s *testIssuer) token(t *testing.T, claims map[string]any) string {
+	t.Helper()
+
+	c := map[string]any{
+		"iss": s.issuer,
+		"aud": "vega",
+		"sub": "1",
+		"iat": time.Now().Unix(),
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}
+	for k, v := range claims {
+		c[k] = v
+	}
+	payload, err := json.Marshal(c)
+	require.NoError(t, err)
+	jws, err := s.signer.Sign(payload)
+	require.NoError(t, err)
+	raw, err := jws.CompactSerialize()
+	require.NoError(t, err)
+	return raw
+}
+
+func TestVerifier(t *testing.T) {
+	ctx := t.Context()
+	s := newTestIssuer(t)
+	v := NewVerifier(Options{
+		Issuer:   s.URL,
+		ClientID: "vega",
+		Admins:   ParseAdminList("root, admin@example.com"),
+	})
+
+	u, err := v.Verify(ctx, s.token(t, map[string]any{
+		"nickname":      "user",
+		"email":         "user@example.com",
+		"groups_direct": []string{"org/team"},
+	}))
+	require.NoError(t, err)
+	require.Equal(t, &User{
+		Name:   "user",
+		Email:  "user@example.com",
+		Groups: []string{"org/team"},
+	}, u)
+
+	u, err = v.Verify(ctx, s.token(t, map[string]any{"nickname": "root"}))
+	require.NoError(t, err)
+	require.True(t, u.Admin)
+
+	u, err = v.Verify(ctx, s.token(t, map[string]any{"email": "admin@example.com"}))
+	require.NoError(t, err)
+	require.True(t, u.Admin)
+	require.Equal(t, "1", u.Name, "subject is used as fallback")
+
+	_, err = v.Verify(ctx, s.token(t, map[string]any{"aud": "other"}))
+	require.Error(t, err)
+
+	_, err = v.Verify(ctx, s.token(t, map[string]any{"exp": time.Now().Add(-time.Hour).Unix()}))
+	require.Error(t, err)
+
+	_, err = v.Verify(ctx, "garbage")
+	require.Error(t, err)
+}
+
+func TestVerifier_DiscoveryURL(t *testing.T) {
+	s := newTestIssuer(t)
+	s.issuer = "http://gitlab.localhost"
+	v := NewVerifier(Options{
+		Issuer:       s.issuer,
+		DiscoveryURL: s.URL,
+		ClientID:     "vega",
+	})
+	u, err := v.Verify(t.Context(), s.token(t, map[string]any{"nickname": "user"}))
+	require.NoError(t, err)
+	require.Equal(t, "user", u.Name)
+}
+
+func TestVerifier_CLIClientID(t *testing.T) {
+	ctx := t.Context()
+	s := newTestIssuer(t)
+	v := NewVerifier(Options{
+		Issuer:      s.URL,
+		ClientID:    "vega",
+		CLIClientID: "vega-cli",
+	})
+	for _, aud := range []string{"vega", "vega-cli"} {
+		u, err := v.Verify(ctx, s.token(t, map[string]any{"aud": aud, "nickname": "user"}))
+		require.NoError(t, err, aud)
+		require.Equal(t, "user", u.Name)
+	}
+	_, err := v.Verify(ctx, s.token(t, map[string]any{"aud": "other"}))
+	require.Error(t, err)
+}
+
+func TestUser_CanAccess(t *testing.T) {
+	ns := func(groups string) *v1.Namespace {
+		return &v1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{vega.AnnotationGitLabGroups: groups},
+		}}
+	}
+	u := &User{Groups: []string{"org/team"}}
+	require.True(t, u.CanAccess(ns("org/team")))
+	require.True(t, u.CanAccess(ns("other, org/team/sub")))
+	require.False(t, u.CanAccess(ns("org")))
+	require.False(t, u.CanAccess(ns("org/teammate")))
+	require.False(t, u.CanAccess(ns("")))
+	require.False(t, u.CanAccess(&v1.Namespace{}))
+
+	admin := &User{Admin: true}
+	require.True(t, admin.CanAccess(&v1.Namespace{}))
+}
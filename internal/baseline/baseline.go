@@ -0,0 +1,220 @@
+// Package baseline learns binaries executed in container images.
+//
+// Every distinct image, binary and parent binary triple is stored once
+// with time it was first seen. Baseline of image consists of triples first
+// seen during training window that starts with the first exec in image, so
+// execs of image are checked against baseline at query time, and restart of
+// ingester does not restart training.
+//
+// Image is identified by digest, so new image version is trained again.
+package baseline
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/go-faster/tetragon/api/v1/tetragon"
+)
+
+// Entry is exec of binary by parent binary in container image.
+type Entry struct {
+	// Time of exec, or time when entry was first seen.
+	Time         time.Time
+	Image        string
+	Binary       string
+	ParentBinary string
+}
+
+type pair struct {
+	binary string
+	parent string
+}
+
+type key struct {
+	image string
+	pair
+}
+
+// RecorderOptions configures Recorder.
+type RecorderOptions struct {
+	// MaxEntries limits number of remembered entries, memory is reset when
+	// reached. 100k by default.
+	MaxEntries int
+}
+
+func (o *RecorderOptions) setDefaults() {
+	if o.MaxEntries <= 0 {
+		o.MaxEntries = 100_000
+	}
+}
+
+// Recorder selects exec events with entries that were not seen before.
+//
+// Entries are remembered only in memory, so entry can be recorded again
+// after restart or reset, which is fine as first seen time is the minimum.
+//
+// Recorder is not safe for concurrent use.
+type Recorder struct {
+	opt  RecorderOptions
+	seen map[key]struct{}
+}
+
+// NewRecorder creates new Recorder.
+func NewRecorder(opt RecorderOptions) *Recorder {
+	opt.setDefaults()
+	return &Recorder{
+		opt:  opt,
+		seen: map[key]struct{}{},
+	}
+}
+
+// Len returns number of remembered entries.
+func (r *Recorder) Len() int {
+	return len(r.seen)
+}
+
+// Observe returns entry of exec event, if it was not seen before.
+//
+// Events other than exec and execs without container image are ignored.
+func (r *Recorder) Observe(res *tetragon.GetEventsResponse) (Entry, bool) {
+	exec := res.GetProcessExec()
+	if exec == nil {
+		return Entry{}, false
+	}
+	e := Entry{
+		Time:         res.GetTime().AsTime(),
+		Image:        exec.GetProcess().GetPod().GetContainer().GetImage().GetId(),
+		Binary:       exec.GetProcess().GetBinary(),
+		ParentBinary: exec.GetParent().GetBinary(),
+	}
+	if e.Image == "" || e.Binary == "" {
+		return Entry{}, false
+	}
+	k := key{image: e.Image, pair: pair{binary: e.Binary, parent: e.ParentBinary}}
+	if _, ok := r.seen[k]; ok {
+		return Entry{}, false
+	}
+	if len(r.seen) >= r.opt.MaxEntries {
+		clear(r.seen)
+	}
+	r.seen[k] = struct{}{}
+	return e, true
+}
+
+// Kind of anomaly.
+type Kind string
+
+// Possible values of Kind.
+const (
+	KindNone Kind = ""
+	// KindBinary means that binary was not executed in image during
+	// training.
+	KindBinary Kind = "binary"
+	// KindParent means that binary was executed in image during training,
+	// but not by this parent binary.
+	KindParent Kind = "parent"
+)
+
+// Image is baseline of container image.
+type Image struct {
+	Image string
+	// FirstSeen is time of the first exec in image.
+	FirstSeen time.Time
+	// TrainedUntil is end of training window.
+	TrainedUntil time.Time
+
+	binaries map[string]struct{}
+	pairs    map[pair]struct{}
+}
+
+// Training reports whether image is still in training window.
+func (i *Image) Training(now time.Time) bool {
+	return now.Before(i.TrainedUntil)
+}
+
+// Binaries returns number of binaries in baseline.
+func (i *Image) Binaries() int {
+	return len(i.binaries)
+}
+
+// Pairs returns number of parent and child binary pairs in baseline.
+func (i *Image) Pairs() int {
+	return len(i.pairs)
+}
+
+// Baseline of container images.
+type Baseline struct {
+	images map[string]*Image
+}
+
+// New builds baseline from first seen entries and training window.
+//
+// Entries can be in any order and can be duplicated.
+func New(training time.Duration, entries []Entry) *Baseline {
+	b := &Baseline{
+		images: map[string]*Image{},
+	}
+	for _, e := range entries {
+		img, ok := b.images[e.Image]
+		if !ok {
+			img = &Image{
+				Image:     e.Image,
+				FirstSeen: e.Time,
+				binaries:  map[string]struct{}{},
+				pairs:     map[pair]struct{}{},
+			}
+			b.images[e.Image] = img
+		}
+		if e.Time.Before(img.FirstSeen) {
+			img.FirstSeen = e.Time
+		}
+	}
+	for _, img := range b.images {
+		img.TrainedUntil = img.FirstSeen.Add(training)
+	}
+	for _, e := range entries {
+		img := b.images[e.Image]
+		if !e.Time.Before(img.TrainedUntil) {
+			continue
+		}
+		img.binaries[e.Binary] = struct{}{}
+		img.pairs[pair{binary: e.Binary, parent: e.ParentBinary}] = struct{}{}
+	}
+	return b
+}
+
+// Image returns baseline of image.
+func (b *Baseline) Image(image string) (*Image, bool) {
+	img, ok := b.images[image]
+	return img, ok
+}
+
+// Images returns baselines of all images, ordered by image.
+func (b *Baseline) Images() []*Image {
+	out := make([]*Image, 0, len(b.images))
+	for _, img := range b.images {
+		out = append(out, img)
+	}
+	slices.SortFunc(out, func(a, b *Image) int {
+		return cmp.Compare(a.Image, b.Image)
+	})
+	return out
+}
+
+// Check returns kind of anomaly of exec.
+//
+// Execs of unknown images and execs during training are not anomalies.
+func (b *Baseline) Check(e Entry) Kind {
+	img, ok := b.images[e.Image]
+	if !ok || img.Training(e.Time) {
+		return KindNone
+	}
+	if _, ok := img.binaries[e.Binary]; !ok {
+		return KindBinary
+	}
+	if _, ok := img.pairs[pair{binary: e.Binary, parent: e.ParentBinary}]; !ok {
+		return KindParent
+	}
+	return KindNone
+}
@@ -0,0 +1,111 @@
+package baseline
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-faster/tetragon/api/v1/tetragon"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func execEvent(now time.Time, image, binary, parent string) *tetragon.GetEventsResponse {
+	return &tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessExec{
+			ProcessExec: &tetragon.ProcessExec{
+				Process: &tetragon.Process{
+					Binary: binary,
+					Pod: &tetragon.Pod{
+						Container: &tetragon.Container{
+							Image: &tetragon.Image{Id: image},
+						},
+					},
+				},
+				Parent: &tetragon.Process{Binary: parent},
+			},
+		},
+		Time: timestamppb.New(now),
+	}
+}
+
+func TestRecorder(t *testing.T) {
+	now := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	r := NewRecorder(RecorderOptions{MaxEntries: 2})
+
+	e, ok := r.Observe(execEvent(now, "sha256:a", "/bin/api", "/usr/bin/tini"))
+	require.True(t, ok)
+	require.Equal(t, Entry{
+		Time:         now,
+		Image:        "sha256:a",
+		Binary:       "/bin/api",
+		ParentBinary: "/usr/bin/tini",
+	}, e)
+
+	// Seen.
+	_, ok = r.Observe(execEvent(now.Add(time.Second), "sha256:a", "/bin/api", "/usr/bin/tini"))
+	require.False(t, ok)
+
+	// Same binary in other image, or by other parent.
+	_, ok = r.Observe(execEvent(now, "sha256:b", "/bin/api", "/usr/bin/tini"))
+	require.True(t, ok)
+	require.Equal(t, 2, r.Len())
+	_, ok = r.Observe(execEvent(now, "sha256:a", "/bin/api", "/bin/sh"))
+	require.True(t, ok)
+	require.Equal(t, 1, r.Len(), "should be reset")
+
+	// No image.
+	_, ok = r.Observe(execEvent(now, "", "/bin/api", ""))
+	require.False(t, ok)
+	// Not exec.
+	_, ok = r.Observe(&tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessExit{ProcessExit: &tetragon.ProcessExit{}},
+	})
+	require.False(t, ok)
+}
+
+func TestBaseline(t *testing.T) {
+	start := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) time.Time { return start.Add(d) }
+	b := New(time.Hour, []Entry{
+		// Shell after training.
+		{Time: at(2 * time.Hour), Image: "a", Binary: "/bin/sh", ParentBinary: "/bin/api"},
+		{Time: at(time.Minute), Image: "a", Binary: "/bin/api", ParentBinary: "/usr/bin/tini"},
+		{Time: at(0), Image: "a", Binary: "/usr/bin/tini", ParentBinary: ""},
+		{Time: at(30 * time.Minute), Image: "a", Binary: "/bin/healthcheck", ParentBinary: "/bin/api"},
+		// Duplicate after restart.
+		{Time: at(3 * time.Hour), Image: "a", Binary: "/bin/api", ParentBinary: "/usr/bin/tini"},
+		{Time: at(2 * time.Hour), Image: "b", Binary: "/bin/worker", ParentBinary: ""},
+	})
+
+	images := b.Images()
+	require.Len(t, images, 2)
+	a := images[0]
+	require.Equal(t, "a", a.Image)
+	require.Equal(t, start, a.FirstSeen)
+	require.Equal(t, at(time.Hour), a.TrainedUntil)
+	require.Equal(t, 3, a.Binaries())
+	require.Equal(t, 3, a.Pairs())
+	require.False(t, a.Training(at(2*time.Hour)))
+
+	img, ok := b.Image("b")
+	require.True(t, ok)
+	require.True(t, img.Training(at(2*time.Hour)))
+	require.Equal(t, 1, img.Binaries())
+
+	for _, tt := range []struct {
+		name  string
+		entry Entry
+		want  Kind
+	}{
+		{"Known", Entry{Time: at(5 * time.Hour), Image: "a", Binary: "/bin/healthcheck", ParentBinary: "/bin/api"}, KindNone},
+		{"NewBinary", Entry{Time: at(5 * time.Hour), Image: "a", Binary: "/bin/sh", ParentBinary: "/bin/api"}, KindBinary},
+		{"NewParent", Entry{Time: at(5 * time.Hour), Image: "a", Binary: "/bin/healthcheck", ParentBinary: "/bin/sh"}, KindParent},
+		{"DuringTraining", Entry{Time: at(10 * time.Minute), Image: "a", Binary: "/bin/sh"}, KindNone},
+		{"Training", Entry{Time: at(2 * time.Hour), Image: "b", Binary: "/bin/sh"}, KindNone},
+		{"UnknownImage", Entry{Time: at(5 * time.Hour), Image: "c", Binary: "/bin/sh"}, KindNone},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, b.Check(tt.entry))
+		})
+	}
+}
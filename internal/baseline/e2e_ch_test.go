@@ -0,0 +1,71 @@
+package baseline
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ClickHouse/ch-go"
+	"github.com/ClickHouse/ch-go/cht"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestTable_ResultColumns(t *testing.T) {
+	d := NewTable("baseline")
+	cols := d.ResultColumns()
+	inputs := d.Input()
+	ddl := NewDDL("baseline")
+
+	require.Equal(t, len(cols), len(inputs))
+	for i := range cols {
+		require.Equal(t, cols[i], inputs[i].Name)
+		require.True(t, strings.Contains(ddl, cols[i]))
+	}
+}
+
+func TestIntegrationClickHouseColumns(t *testing.T) {
+	cht.Skip(t)
+	s := cht.New(t)
+	ctx := context.Background()
+	c, err := ch.Dial(ctx, ch.Options{
+		Address: s.TCP,
+		Logger:  zaptest.NewLogger(t),
+	})
+	require.NoError(t, err)
+	require.NoError(t, c.Do(ctx, ch.Query{Body: DDL}), "DDL")
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	d := NewTable("baseline")
+	entries := []Entry{
+		{Time: now, Image: "sha256:a", Binary: "/bin/api", ParentBinary: "/usr/bin/tini"},
+		// Recorded again after restart.
+		{Time: now.Add(time.Hour), Image: "sha256:a", Binary: "/bin/api", ParentBinary: "/usr/bin/tini"},
+	}
+	for _, e := range entries {
+		require.NoError(t, d.Append(e))
+	}
+	require.NoError(t, c.Do(ctx, ch.Query{
+		Body:  d.Insert(),
+		Input: d.Input(),
+	}), "insert")
+
+	d.Reset()
+	require.NoError(t, c.Do(ctx, ch.Query{
+		Body:   fmt.Sprintf(`SELECT %s FROM baseline ORDER BY timestamp`, strings.Join(d.ResultColumns(), ", ")),
+		Result: d.Result(),
+	}), "select")
+	require.Equal(t, len(entries), d.Rows())
+	var got []Entry
+	require.NoError(t, d.Each(func(v Entry) error {
+		got = append(got, v)
+		return nil
+	}))
+	b := New(time.Hour, got)
+	img, ok := b.Image("sha256:a")
+	require.True(t, ok)
+	require.True(t, now.Equal(img.FirstSeen))
+	require.Equal(t, 1, img.Pairs())
+}
@@ -0,0 +1,136 @@
+package baseline
+
+import (
+	"fmt"
+
+	"github.com/ClickHouse/ch-go/proto"
+	"github.com/go-faster/errors"
+)
+
+// NewDDL returns DDL of table with first seen entries.
+//
+// Table has no TTL, baseline is kept until image rows are deleted.
+func NewDDL(tableName string) string {
+	const ddl = `
+CREATE TABLE IF NOT EXISTS %s
+(
+    timestamp DateTime64(9),
+
+    k8s_image             LowCardinality(String),
+    process_binary        String,
+    parent_process_binary String
+)
+    ENGINE = MergeTree()
+        ORDER BY (k8s_image, process_binary, parent_process_binary)
+`
+	return fmt.Sprintf(ddl, tableName)
+}
+
+// DDL for ClickHouse table.
+var DDL = NewDDL("baseline")
+
+type Column struct {
+	Name string
+	Data proto.Column
+}
+
+// Table is wrapper for ClickHouse columns that simplifies data ingestion.
+type Table struct {
+	name string
+
+	timestamp    proto.ColDateTime64
+	image        proto.ColLowCardinality[string]
+	binary       proto.ColStr
+	parentBinary proto.ColStr
+}
+
+func (t *Table) Reset() {
+	for _, v := range t.Columns() {
+		v.Data.Reset()
+	}
+}
+
+func (t *Table) Rows() int {
+	return t.timestamp.Rows()
+}
+
+func (t *Table) Insert() string {
+	return t.Input().Into(t.name)
+}
+
+func (t *Table) Result() proto.Results {
+	var out proto.Results
+	for _, v := range t.Columns() {
+		out = append(out, proto.ResultColumn{
+			Name: v.Name,
+			Data: v.Data,
+		})
+	}
+	return out
+}
+
+func (t *Table) ResultColumns() []string {
+	var columns []string
+	for _, v := range t.Result() {
+		columns = append(columns, v.Name)
+	}
+	return columns
+}
+
+func (t *Table) Columns() []Column {
+	return []Column{
+		{Name: "timestamp", Data: &t.timestamp},
+		{Name: "k8s_image", Data: &t.image},
+		{Name: "process_binary", Data: &t.binary},
+		{Name: "parent_process_binary", Data: &t.parentBinary},
+	}
+}
+
+func (t *Table) Input() proto.Input {
+	var out proto.Input
+	for _, v := range t.Columns() {
+		out = append(out, proto.InputColumn{
+			Name: v.Name,
+			Data: v.Data,
+		})
+	}
+	return out
+}
+
+// Each calls f for every row.
+func (t *Table) Each(f func(v Entry) error) error {
+	for i := 0; i < t.Rows(); i++ {
+		v := Entry{
+			Time:         t.timestamp.Row(i),
+			Image:        t.image.Row(i),
+			Binary:       t.binary.Row(i),
+			ParentBinary: t.parentBinary.Row(i),
+		}
+		if err := f(v); err != nil {
+			return errors.Wrapf(err, "[%d]", i)
+		}
+	}
+	return nil
+}
+
+// Append appends entry.
+func (t *Table) Append(v Entry) error {
+	t.image.Append(v.Image)
+	t.binary.Append(v.Binary)
+	t.parentBinary.Append(v.ParentBinary)
+	t.timestamp.Append(v.Time)
+	return nil
+}
+
+func newStrLowCardinality() proto.ColLowCardinality[string] {
+	return *proto.NewLowCardinality[string](&proto.ColStr{})
+}
+
+func NewTable(name string) *Table {
+	t := &Table{
+		name:  name,
+		image: newStrLowCardinality(),
+	}
+	t.timestamp.WithPrecision(9)
+	return t
+}
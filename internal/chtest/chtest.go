@@ -0,0 +1,21 @@
+// Package chtest contains helpers for tests of ClickHouse tables.
+package chtest
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// RequireMigrations requires every column added by migrations to be in
+// DDL, so new tables have the same columns as upgraded ones.
+func RequireMigrations(t testing.TB, ddl string, migrations []string) {
+	t.Helper()
+	for _, m := range migrations {
+		_, rest, ok := strings.Cut(m, "ADD COLUMN IF NOT EXISTS ")
+		require.True(t, ok, m)
+		column, _, _ := strings.Cut(rest, " ")
+		require.Contains(t, ddl, column, m)
+	}
+}
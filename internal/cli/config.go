@@ -1,16 +1,133 @@
 package cli
 
-import "time"
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-faster/errors"
+)
 
 type Token struct {
 	AccessToken  string    `json:"access_token"`
 	RefreshToken string    `json:"refresh_token"`
+	IDToken      string    `json:"id_token,omitempty"`
 	ExpiresAt    time.Time `json:"expires_at"`
 	ObtainedAt   time.Time `json:"obtained_at"`
 }
 
+// Context is named vega API endpoint with credentials.
+type Context struct {
+	URL   string `json:"url"`
+	Token *Token `json:"token,omitempty"`
+
+	// OAuth2 client used to obtain and refresh token.
+	Issuer       string `json:"issuer,omitempty"`
+	ClientID     string `json:"client_id,omitempty"`
+	ClientSecret string `json:"client_secret,omitempty"`
+}
+
 type Config struct {
-	Token *Token `json:"token"`
+	CurrentContext string              `json:"current_context,omitempty"`
+	Contexts       map[string]*Context `json:"contexts,omitempty"`
 }
 
 const ConfigPerm = 0o600
+
+// ConfigPath returns path to config file.
+//
+// Can be overridden by VEGA_CONFIG environment variable.
+func ConfigPath() (string, error) {
+	if p := os.Getenv("VEGA_CONFIG"); p != "" {
+		return p, nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", errors.Wrap(err, "get config dir")
+	}
+	return filepath.Join(dir, "vega", "config.json"), nil
+}
+
+// LoadConfig reads config from path.
+//
+// Missing file is not an error, empty config is returned.
+func LoadConfig(path string) (*Config, error) {
+	c := &Config{
+		Contexts: map[string]*Context{},
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return c, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "read")
+	}
+	if err := json.Unmarshal(data, c); err != nil {
+		return nil, errors.Wrapf(err, "parse %s", path)
+	}
+	if c.Contexts == nil {
+		c.Contexts = map[string]*Context{}
+	}
+	return c, nil
+}
+
+// Save writes config to path atomically.
+func (c *Config) Save(path string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "marshal")
+	}
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return errors.Wrap(err, "create dir")
+	}
+	f, err := os.CreateTemp(dir, ".config-*.json")
+	if err != nil {
+		return errors.Wrap(err, "create temp")
+	}
+	defer func() {
+		_ = os.Remove(f.Name())
+	}()
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return errors.Wrap(err, "write")
+	}
+	if err := f.Chmod(ConfigPerm); err != nil {
+		_ = f.Close()
+		return errors.Wrap(err, "chmod")
+	}
+	if err := f.Close(); err != nil {
+		return errors.Wrap(err, "close")
+	}
+	if err := os.Rename(f.Name(), path); err != nil {
+		return errors.Wrap(err, "rename")
+	}
+	return nil
+}
+
+// Context returns context by name, or current context if name is empty.
+//
+// Returns nil if there is no current context.
+func (c *Config) Context(name string) (*Context, error) {
+	if name == "" {
+		name = c.CurrentContext
+	}
+	if name == "" {
+		return nil, nil
+	}
+	ctx, ok := c.Contexts[name]
+	if !ok {
+		return nil, errors.Errorf("context %q not found", name)
+	}
+	return ctx, nil
+}
+
+// OAuth returns OAuth2 client of context.
+func (c *Context) OAuth() *OAuth {
+	return &OAuth{
+		Issuer:       c.Issuer,
+		ClientID:     c.ClientID,
+		ClientSecret: c.ClientSecret,
+	}
+}
@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "vega", "config.json")
+
+	c, err := LoadConfig(path)
+	require.NoError(t, err)
+	require.Empty(t, c.Contexts)
+
+	ctx, err := c.Context("")
+	require.NoError(t, err)
+	require.Nil(t, ctx)
+
+	c.Contexts["prod"] = &Context{URL: "https://vega.example.com", Token: &Token{AccessToken: "secret"}}
+	c.CurrentContext = "prod"
+	require.NoError(t, c.Save(path))
+
+	stat, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(ConfigPerm), stat.Mode().Perm())
+
+	loaded, err := LoadConfig(path)
+	require.NoError(t, err)
+	require.Equal(t, c, loaded)
+
+	ctx, err = loaded.Context("")
+	require.NoError(t, err)
+	require.Equal(t, "https://vega.example.com", ctx.URL)
+
+	_, err = loaded.Context("dev")
+	require.Error(t, err)
+}
+
+func TestBearerTransport(t *testing.T) {
+	var got string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("Authorization")
+	}))
+	t.Cleanup(s.Close)
+
+	client := &http.Client{Transport: &BearerTransport{Token: "foo"}}
+	res, err := client.Get(s.URL)
+	require.NoError(t, err)
+	require.NoError(t, res.Body.Close())
+	require.Equal(t, "Bearer foo", got)
+}
@@ -70,3 +70,23 @@ func Response(ctx context.Context, client *http.Client, req *http.Request) (*htt
 
 	return res, nil
 }
+
+// BearerTransport sets bearer token on every request.
+type BearerTransport struct {
+	Token string
+	Base  http.RoundTripper // http.DefaultTransport if nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *BearerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if t.Token == "" {
+		return base.RoundTrip(req)
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+t.Token)
+	return base.RoundTrip(req)
+}
@@ -0,0 +1,288 @@
+package cli
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-faster/errors"
+	"golang.org/x/oauth2"
+)
+
+// DefaultIssuer is issuer of local GitLab instance.
+const DefaultIssuer = "http://gitlab.localhost"
+
+// DefaultCallbackAddr is address of callback server of authorization code
+// flow, redirect URL of public application registered by vega-gitlab-init.
+const DefaultCallbackAddr = "127.0.0.1:8250"
+
+// DefaultScopes are requested by login, same as registered by vega-gitlab-init.
+var DefaultScopes = []string{"openid", "profile", "email"}
+
+// Token expiry margin to refresh before actual expiration.
+const tokenExpiryDelta = time.Minute
+
+// Bearer returns token to send to vega API.
+//
+// ID token is preferred as it can be validated offline.
+func (t *Token) Bearer() string {
+	if t.IDToken != "" {
+		return t.IDToken
+	}
+	return t.AccessToken
+}
+
+// Expired reports whether token is expired or about to expire.
+func (t *Token) Expired(now time.Time) bool {
+	if t.ExpiresAt.IsZero() {
+		return false
+	}
+	return now.Add(tokenExpiryDelta).After(t.ExpiresAt)
+}
+
+// idTokenExpiry returns expiration time from "exp" claim of raw ID token,
+// or zero time if it can't be parsed.
+//
+// Signature is not verified, token is verified by API.
+func idTokenExpiry(raw string) time.Time {
+	parts := strings.Split(raw, ".")
+	if len(parts) != 3 {
+		return time.Time{}
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}
+	}
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return time.Time{}
+	}
+	return time.Unix(claims.Exp, 0)
+}
+
+func newToken(t *oauth2.Token) *Token {
+	out := &Token{
+		AccessToken:  t.AccessToken,
+		RefreshToken: t.RefreshToken,
+		ExpiresAt:    t.Expiry,
+		ObtainedAt:   time.Now(),
+	}
+	if v, ok := t.Extra("id_token").(string); ok {
+		out.IDToken = v
+		// ID token is sent as bearer and expires earlier than access
+		// token, e.g. in 2 minutes on GitLab.
+		if exp := idTokenExpiry(v); !exp.IsZero() && (out.ExpiresAt.IsZero() || exp.Before(out.ExpiresAt)) {
+			out.ExpiresAt = exp
+		}
+	}
+	return out
+}
+
+// OAuth is OAuth2 client for issuer, GitLab by default.
+type OAuth struct {
+	Issuer       string
+	ClientID     string
+	ClientSecret string // optional, for confidential applications
+	Scopes       []string
+	HTTP         *http.Client // http.DefaultClient if nil
+}
+
+// issuerMetadata is subset of OpenID provider metadata.
+type issuerMetadata struct {
+	AuthorizationEndpoint       string `json:"authorization_endpoint"`
+	TokenEndpoint               string `json:"token_endpoint"`
+	DeviceAuthorizationEndpoint string `json:"device_authorization_endpoint"`
+}
+
+func (o *OAuth) httpClient() *http.Client {
+	if o.HTTP != nil {
+		return o.HTTP
+	}
+	return http.DefaultClient
+}
+
+func (o *OAuth) context(ctx context.Context) context.Context {
+	return context.WithValue(ctx, oauth2.HTTPClient, o.httpClient())
+}
+
+// discover fetches issuer endpoints.
+//
+// Falls back to GitLab endpoints if discovery is not available.
+func (o *OAuth) discover(ctx context.Context) issuerMetadata {
+	issuer := strings.TrimSuffix(o.Issuer, "/")
+	meta := issuerMetadata{
+		AuthorizationEndpoint:       issuer + "/oauth/authorize",
+		TokenEndpoint:               issuer + "/oauth/token",
+		DeviceAuthorizationEndpoint: issuer + "/oauth/authorize_device",
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, issuer+"/.well-known/openid-configuration", http.NoBody)
+	if err != nil {
+		return meta
+	}
+	res, err := o.httpClient().Do(req)
+	if err != nil {
+		return meta
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	if res.StatusCode != http.StatusOK {
+		return meta
+	}
+	var discovered issuerMetadata
+	if err := json.NewDecoder(res.Body).Decode(&discovered); err != nil {
+		return meta
+	}
+	if discovered.AuthorizationEndpoint != "" {
+		meta.AuthorizationEndpoint = discovered.AuthorizationEndpoint
+	}
+	if discovered.TokenEndpoint != "" {
+		meta.TokenEndpoint = discovered.TokenEndpoint
+	}
+	if discovered.DeviceAuthorizationEndpoint != "" {
+		meta.DeviceAuthorizationEndpoint = discovered.DeviceAuthorizationEndpoint
+	}
+	return meta
+}
+
+func (o *OAuth) config(ctx context.Context) *oauth2.Config {
+	meta := o.discover(ctx)
+	scopes := o.Scopes
+	if len(scopes) == 0 {
+		scopes = DefaultScopes
+	}
+	return &oauth2.Config{
+		ClientID:     o.ClientID,
+		ClientSecret: o.ClientSecret,
+		Scopes:       scopes,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:       meta.AuthorizationEndpoint,
+			TokenURL:      meta.TokenEndpoint,
+			DeviceAuthURL: meta.DeviceAuthorizationEndpoint,
+		},
+	}
+}
+
+// LoginBrowser performs authorization code flow with PKCE.
+//
+// Callback server listens on listen address and open is called with URL
+// that user should visit.
+func (o *OAuth) LoginBrowser(ctx context.Context, listen string, open func(u string)) (*Token, error) {
+	ctx = o.context(ctx)
+	cfg := o.config(ctx)
+
+	ln, err := net.Listen("tcp", listen)
+	if err != nil {
+		return nil, errors.Wrap(err, "listen")
+	}
+	cfg.RedirectURL = fmt.Sprintf("http://%s/callback", ln.Addr())
+
+	stateBytes := make([]byte, 16)
+	if _, err := rand.Read(stateBytes); err != nil {
+		return nil, errors.Wrap(err, "generate state")
+	}
+	var (
+		state    = hex.EncodeToString(stateBytes)
+		verifier = oauth2.GenerateVerifier()
+		codes    = make(chan string, 1)
+		errs     = make(chan error, 1)
+	)
+	srv := &http.Server{
+		ReadHeaderTimeout: time.Second * 10,
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/callback" {
+				http.NotFound(w, r)
+				return
+			}
+			q := r.URL.Query()
+			switch {
+			case q.Get("state") != state:
+				http.Error(w, "Invalid state", http.StatusBadRequest)
+				return
+			case q.Get("error") != "":
+				http.Error(w, "Login failed", http.StatusBadRequest)
+				select {
+				case errs <- errors.Errorf("authorization: %s: %s", q.Get("error"), q.Get("error_description")):
+				default:
+				}
+				return
+			}
+			_, _ = fmt.Fprintln(w, "Login successful, you can close this page.")
+			select {
+			case codes <- q.Get("code"):
+			default:
+			}
+		}),
+	}
+	go func() {
+		_ = srv.Serve(ln)
+	}()
+	defer func() {
+		_ = srv.Close()
+	}()
+
+	open(cfg.AuthCodeURL(state, oauth2.S256ChallengeOption(verifier)))
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case err := <-errs:
+		return nil, err
+	case code := <-codes:
+		t, err := cfg.Exchange(ctx, code, oauth2.VerifierOption(verifier))
+		if err != nil {
+			return nil, errors.Wrap(err, "exchange")
+		}
+		return newToken(t), nil
+	}
+}
+
+// LoginDevice performs device authorization flow.
+//
+// The prompt is called with verification URL and user code.
+func (o *OAuth) LoginDevice(ctx context.Context, prompt func(r *oauth2.DeviceAuthResponse)) (*Token, error) {
+	ctx = o.context(ctx)
+	cfg := o.config(ctx)
+
+	r, err := cfg.DeviceAuth(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "device auth")
+	}
+	prompt(r)
+	t, err := cfg.DeviceAccessToken(ctx, r)
+	if err != nil {
+		return nil, errors.Wrap(err, "device access token")
+	}
+	return newToken(t), nil
+}
+
+// Refresh obtains new token using refresh token.
+func (o *OAuth) Refresh(ctx context.Context, t *Token) (*Token, error) {
+	if t.RefreshToken == "" {
+		return nil, errors.New("no refresh token")
+	}
+	ctx = o.context(ctx)
+	cfg := o.config(ctx)
+	refreshed, err := cfg.TokenSource(ctx, &oauth2.Token{
+		RefreshToken: t.RefreshToken,
+		// Force refresh.
+		Expiry: time.Unix(1, 0),
+	}).Token()
+	if err != nil {
+		return nil, errors.Wrap(err, "refresh")
+	}
+	out := newToken(refreshed)
+	if out.RefreshToken == "" {
+		out.RefreshToken = t.RefreshToken
+	}
+	return out, nil
+}
@@ -0,0 +1,158 @@
+package cli
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+// fakeIssuer is minimal OAuth2 authorization server.
+func fakeIssuer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	var challenge string
+	mux := http.NewServeMux()
+	s := httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+
+	writeToken := func(w http.ResponseWriter, access string) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token":  access,
+			"refresh_token": "refresh",
+			"id_token":      "id." + access,
+			"token_type":    "Bearer",
+			"expires_in":    3600,
+		})
+	}
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"authorization_endpoint":        s.URL + "/authorize",
+			"token_endpoint":                s.URL + "/token",
+			"device_authorization_endpoint": s.URL + "/device",
+		})
+	})
+	mux.HandleFunc("/authorize", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		require.Equal(t, "vega", q.Get("client_id"))
+		require.Equal(t, "S256", q.Get("code_challenge_method"))
+		challenge = q.Get("code_challenge")
+
+		u, err := url.Parse(q.Get("redirect_uri"))
+		require.NoError(t, err)
+		u.RawQuery = url.Values{"code": {"code"}, "state": {q.Get("state")}}.Encode()
+		http.Redirect(w, r, u.String(), http.StatusFound)
+	})
+	mux.HandleFunc("/device", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"device_code":      "device",
+			"user_code":        "ABCD",
+			"verification_uri": s.URL + "/activate",
+			"interval":         1,
+			"expires_in":       60,
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		switch r.PostForm.Get("grant_type") {
+		case "authorization_code":
+			require.Equal(t, "code", r.PostForm.Get("code"))
+			require.Equal(t, challenge, oauth2.S256ChallengeFromVerifier(r.PostForm.Get("code_verifier")))
+			writeToken(w, "browser")
+		case "urn:ietf:params:oauth:grant-type:device_code":
+			require.Equal(t, "device", r.PostForm.Get("device_code"))
+			writeToken(w, "device")
+		case "refresh_token":
+			require.Equal(t, "refresh", r.PostForm.Get("refresh_token"))
+			writeToken(w, "refreshed")
+		default:
+			http.Error(w, "unsupported grant", http.StatusBadRequest)
+		}
+	})
+	return s
+}
+
+func TestOAuth(t *testing.T) {
+	s := fakeIssuer(t)
+	ctx := t.Context()
+	client := &OAuth{
+		Issuer:   s.URL,
+		ClientID: "vega",
+	}
+
+	t.Run("Browser", func(t *testing.T) {
+		token, err := client.LoginBrowser(ctx, "127.0.0.1:0", func(u string) {
+			// Simulate user visiting authorization page.
+			go func() {
+				res, err := http.Get(u) // #nosec G107
+				if err == nil {
+					_ = res.Body.Close()
+				}
+			}()
+		})
+		require.NoError(t, err)
+		require.Equal(t, "browser", token.AccessToken)
+		require.Equal(t, "id.browser", token.Bearer())
+		require.False(t, token.Expired(time.Now()))
+		require.True(t, token.Expired(time.Now().Add(time.Hour)))
+	})
+	t.Run("Device", func(t *testing.T) {
+		var code string
+		token, err := client.LoginDevice(ctx, func(r *oauth2.DeviceAuthResponse) {
+			code = r.UserCode
+		})
+		require.NoError(t, err)
+		require.Equal(t, "ABCD", code)
+		require.Equal(t, "device", token.AccessToken)
+	})
+	t.Run("Refresh", func(t *testing.T) {
+		token, err := client.Refresh(ctx, &Token{AccessToken: "old", RefreshToken: "refresh"})
+		require.NoError(t, err)
+		require.Equal(t, "refreshed", token.AccessToken)
+		require.Equal(t, "refresh", token.RefreshToken)
+
+		_, err = client.Refresh(ctx, &Token{AccessToken: "old"})
+		require.Error(t, err)
+	})
+}
+
+func TestToken_IDTokenExpiry(t *testing.T) {
+	var (
+		now    = time.Now().Truncate(time.Second)
+		claims = base64.RawURLEncoding.EncodeToString([]byte(
+			`{"exp":` + strconv.FormatInt(now.Add(2*time.Minute).Unix(), 10) + `}`,
+		))
+		idToken = "header." + claims + ".signature"
+	)
+	token := newToken((&oauth2.Token{
+		AccessToken: "access",
+		Expiry:      now.Add(2 * time.Hour),
+	}).WithExtra(map[string]any{"id_token": idToken}))
+	require.Equal(t, idToken, token.Bearer())
+	require.Equal(t, now.Add(2*time.Minute), token.ExpiresAt)
+	require.False(t, token.Expired(now))
+	require.True(t, token.Expired(now.Add(90*time.Second)), "refresh should be triggered by ID token")
+
+	// Access token expires earlier.
+	token = newToken((&oauth2.Token{
+		AccessToken: "access",
+		Expiry:      now.Add(time.Minute),
+	}).WithExtra(map[string]any{"id_token": idToken}))
+	require.Equal(t, now.Add(time.Minute), token.ExpiresAt)
+
+	// Not a JWT.
+	token = newToken((&oauth2.Token{
+		AccessToken: "access",
+		Expiry:      now.Add(time.Hour),
+	}).WithExtra(map[string]any{"id_token": "opaque"}))
+	require.Equal(t, now.Add(time.Hour), token.ExpiresAt)
+}
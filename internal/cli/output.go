@@ -0,0 +1,175 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"text/template"
+
+	"github.com/go-faster/errors"
+	"github.com/goccy/go-yaml"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// Output formats.
+const (
+	OutputTable      = "table"
+	OutputWide       = "wide"
+	OutputJSON       = "json"
+	OutputYAML       = "yaml"
+	OutputJSONPath   = "jsonpath"
+	OutputGoTemplate = "go-template"
+)
+
+// Outputs lists supported output formats for flag help.
+var Outputs = []string{
+	OutputTable,
+	OutputWide,
+	OutputJSON,
+	OutputYAML,
+	OutputJSONPath + "=<expr>",
+	OutputGoTemplate + "=<template>",
+}
+
+// Column of table output.
+type Column struct {
+	Name string
+	Wide bool // only in wide output
+}
+
+// Table is tabular representation of value.
+//
+// Column set should be stable, scripts depend on it.
+type Table struct {
+	Columns []Column
+	Rows    [][]string // one cell per column
+}
+
+// Printer prints values in requested output format.
+type Printer struct {
+	format   string
+	path     *jsonpath.JSONPath
+	template *template.Template
+}
+
+// NewPrinter parses output format like "json" or "jsonpath={.name}".
+func NewPrinter(output string) (*Printer, error) {
+	format, arg, hasArg := strings.Cut(output, "=")
+	p := &Printer{format: format}
+	switch format {
+	case OutputTable, OutputWide, OutputJSON, OutputYAML:
+		if hasArg {
+			return nil, errors.Errorf("output %q does not accept argument", format)
+		}
+	case OutputJSONPath:
+		if arg == "" {
+			return nil, errors.New("jsonpath expression is required, e.g. jsonpath={.name}")
+		}
+		if !strings.Contains(arg, "{") {
+			// Allow relaxed ".name" form.
+			arg = "{" + arg + "}"
+		}
+		p.path = jsonpath.New("output")
+		if err := p.path.Parse(arg); err != nil {
+			return nil, errors.Wrap(err, "parse jsonpath")
+		}
+	case OutputGoTemplate:
+		if arg == "" {
+			return nil, errors.New("template is required, e.g. go-template={{.name}}")
+		}
+		t, err := template.New("output").Parse(arg)
+		if err != nil {
+			return nil, errors.Wrap(err, "parse template")
+		}
+		p.template = t
+	default:
+		return nil, errors.Errorf("unknown output %q (supported: %s)", output, strings.Join(Outputs, ", "))
+	}
+	return p, nil
+}
+
+// Structured reports whether output is machine-readable, i.e. not a table.
+func (p *Printer) Structured() bool {
+	return p.format != OutputTable && p.format != OutputWide
+}
+
+// Print writes v to w.
+//
+// The t is used for table and wide formats, other formats use JSON
+// representation of v.
+func (p *Printer) Print(w io.Writer, v any, t Table) error {
+	switch p.format {
+	case OutputTable, OutputWide:
+		return p.printTable(w, t)
+	case OutputJSON:
+		data, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return errors.Wrap(err, "marshal")
+		}
+		_, err = fmt.Fprintf(w, "%s\n", data)
+		return err
+	case OutputYAML:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return errors.Wrap(err, "marshal")
+		}
+		out, err := yaml.JSONToYAML(data)
+		if err != nil {
+			return errors.Wrap(err, "convert to yaml")
+		}
+		_, err = w.Write(out)
+		return err
+	}
+
+	// Both jsonpath and templates operate on JSON field names.
+	data, err := json.Marshal(v)
+	if err != nil {
+		return errors.Wrap(err, "marshal")
+	}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	var obj any
+	if err := d.Decode(&obj); err != nil {
+		return errors.Wrap(err, "unmarshal")
+	}
+	if p.path != nil {
+		if err := p.path.Execute(w, obj); err != nil {
+			return errors.Wrap(err, "execute jsonpath")
+		}
+	} else if err := p.template.Execute(w, obj); err != nil {
+		return errors.Wrap(err, "execute template")
+	}
+	_, err = fmt.Fprintln(w)
+	return err
+}
+
+func (p *Printer) printTable(w io.Writer, t Table) error {
+	wide := p.format == OutputWide
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+	row := func(cells []string) {
+		var visible []string
+		for i, c := range t.Columns {
+			if c.Wide && !wide {
+				continue
+			}
+			var cell string
+			if i < len(cells) {
+				cell = cells[i]
+			}
+			visible = append(visible, cell)
+		}
+		_, _ = fmt.Fprintln(tw, strings.Join(visible, "\t"))
+	}
+	header := make([]string, len(t.Columns))
+	for i, c := range t.Columns {
+		header[i] = c.Name
+	}
+	row(header)
+	for _, cells := range t.Rows {
+		row(cells)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrinter(t *testing.T) {
+	type item struct {
+		Name  string `json:"name"`
+		Count int    `json:"count"`
+	}
+	v := []item{{Name: "foo", Count: 1}, {Name: "bar", Count: 100000000}}
+	table := Table{
+		Columns: []Column{{Name: "NAME"}, {Name: "COUNT", Wide: true}},
+		Rows:    [][]string{{"foo", "1"}, {"bar", "100000000"}},
+	}
+	for _, tt := range []struct {
+		Output string
+		Result string
+	}{
+		{"table", "NAME\nfoo\nbar\n"},
+		{"wide", "NAME   COUNT\nfoo    1\nbar    100000000\n"},
+		{"json", "[\n  {\n    \"name\": \"foo\",\n    \"count\": 1\n  },\n  {\n    \"name\": \"bar\",\n    \"count\": 100000000\n  }\n]\n"},
+		{"yaml", "- name: foo\n  count: 1\n- name: bar\n  count: 100000000\n"},
+		{"jsonpath={[*].name}", "foo bar\n"},
+		{"jsonpath=[1].count", "100000000\n"},
+		{"go-template={{range .}}{{.name}}={{.count}};{{end}}", "foo=1;bar=100000000;\n"},
+	} {
+		t.Run(tt.Output, func(t *testing.T) {
+			p, err := NewPrinter(tt.Output)
+			require.NoError(t, err)
+			var buf bytes.Buffer
+			require.NoError(t, p.Print(&buf, v, table))
+			require.Equal(t, tt.Result, buf.String())
+		})
+	}
+	for _, output := range []string{
+		"xml",
+		"json=foo",
+		"jsonpath",
+		"jsonpath={.name",
+		"go-template={{.name",
+	} {
+		_, err := NewPrinter(output)
+		require.Error(t, err, output)
+	}
+}
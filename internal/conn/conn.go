@@ -0,0 +1,281 @@
+// Package conn implements ClickHouse schema and tracking of TCP connections
+// derived from cilium hubble flows.
+package conn
+
+import (
+	"fmt"
+
+	"github.com/ClickHouse/ch-go/proto"
+	"github.com/go-faster/errors"
+)
+
+func NewDDL(tableName string) string {
+	// DDL for ClickHouse table.
+	const ddl = `
+CREATE TABLE IF NOT EXISTS %s
+(
+    -- timestamp of the first flow
+    timestamp                 DateTime64(9),
+    -- index for time-based queries
+    INDEX timestamp_idx timestamp TYPE minmax GRANULARITY 1,
+    -- timestamp of the last flow
+    timestamp_end             DateTime64(9),
+    duration_ns               UInt64,
+
+    client_ip        String,
+    client_port      UInt32,
+    client_namespace LowCardinality(String),
+    client_pod       LowCardinality(String),
+    client_workload  LowCardinality(String),
+
+    server_ip        String,
+    server_port      UInt32,
+    server_namespace LowCardinality(String),
+    server_pod       LowCardinality(String),
+    server_workload  LowCardinality(String),
+
+    -- cookie of client socket, if reported by cilium
+    socket_cookie UInt64,
+
+    -- TCP flags seen on connection
+    tcp_flags Array(Enum8(
+        'FIN' = 1,
+        'SYN' = 2,
+        'RST' = 3,
+        'PSH' = 4,
+        'ACK' = 5,
+        'URG' = 6,
+        'ECE' = 7,
+        'CWR' = 8,
+        'NS'  = 9
+    )),
+
+    flows   UInt32,
+    dropped UInt32,
+
+    handshake Enum8(
+        'UNKNOWN'  = 0,
+        'COMPLETE' = 1,
+        'FAILED'   = 2
+    ),
+    close_reason Enum8(
+        'FIN'      = 1,
+        'RST'      = 2,
+        'TIMEOUT'  = 3,
+        'SHUTDOWN' = 4
+    )
+)
+    ENGINE = MergeTree()
+        PARTITION BY toYearWeek(timestamp)
+        ORDER BY (server_namespace, server_pod, timestamp)
+`
+	return fmt.Sprintf(ddl, tableName)
+}
+
+// DDL for ClickHouse table.
+var DDL = NewDDL("connections")
+
+// NewMigrations returns statements that upgrade table created by previous
+// DDL. Statements are idempotent.
+func NewMigrations(tableName string) []string {
+	return []string{
+		fmt.Sprintf("ALTER TABLE %s MODIFY COLUMN close_reason Enum8('FIN' = 1, 'RST' = 2, 'TIMEOUT' = 3, 'SHUTDOWN' = 4)", tableName),
+	}
+}
+
+type Column struct {
+	Name string
+	Data proto.Column
+}
+
+// endpoint columns.
+type endpoint struct {
+	ip        proto.ColStr
+	port      proto.ColUInt32
+	namespace proto.ColLowCardinality[string]
+	pod       proto.ColLowCardinality[string]
+	workload  proto.ColLowCardinality[string]
+}
+
+func newEndpointColumns() endpoint {
+	return endpoint{
+		namespace: newStrLowCardinality(),
+		pod:       newStrLowCardinality(),
+		workload:  newStrLowCardinality(),
+	}
+}
+
+func (e *endpoint) Append(v Endpoint) {
+	e.ip.Append(v.IP)
+	e.port.Append(v.Port)
+	e.namespace.Append(v.Namespace)
+	e.pod.Append(v.Pod)
+	e.workload.Append(v.Workload)
+}
+
+func (e *endpoint) Row(i int) Endpoint {
+	return Endpoint{
+		IP:        e.ip.Row(i),
+		Port:      e.port.Row(i),
+		Namespace: e.namespace.Row(i),
+		Pod:       e.pod.Row(i),
+		Workload:  e.workload.Row(i),
+	}
+}
+
+func (e *endpoint) Columns(prefix string) []Column {
+	return []Column{
+		{Name: prefix + "ip", Data: &e.ip},
+		{Name: prefix + "port", Data: &e.port},
+		{Name: prefix + "namespace", Data: &e.namespace},
+		{Name: prefix + "pod", Data: &e.pod},
+		{Name: prefix + "workload", Data: &e.workload},
+	}
+}
+
+// Table is wrapper for ClickHouse columns that simplifies data ingestion.
+type Table struct {
+	name string
+
+	timestamp    proto.ColDateTime64
+	timestampEnd proto.ColDateTime64
+	duration     proto.ColUInt64
+
+	client endpoint
+	server endpoint
+
+	socketCookie proto.ColUInt64
+	tcpFlags     proto.ColArr[string]
+	flows        proto.ColUInt32
+	dropped      proto.ColUInt32
+	handshake    proto.ColEnum
+	closeReason  proto.ColEnum
+}
+
+func (t *Table) Reset() {
+	for _, v := range t.Columns() {
+		v.Data.Reset()
+	}
+}
+
+func (t *Table) Rows() int {
+	return t.timestamp.Rows()
+}
+
+func (t *Table) Insert() string {
+	return t.Input().Into(t.name)
+}
+
+func (t *Table) Result() proto.Results {
+	var out proto.Results
+	for _, v := range t.Columns() {
+		out = append(out, proto.ResultColumn{
+			Name: v.Name,
+			Data: v.Data,
+		})
+	}
+	return out
+}
+
+func (t *Table) ResultColumns() []string {
+	var columns []string
+	for _, v := range t.Result() {
+		columns = append(columns, v.Name)
+	}
+	return columns
+}
+
+func (t *Table) Columns() []Column {
+	c := []Column{
+		{Name: "timestamp", Data: &t.timestamp},
+		{Name: "timestamp_end", Data: &t.timestampEnd},
+		{Name: "duration_ns", Data: &t.duration},
+	}
+	c = append(c, t.client.Columns("client_")...)
+	c = append(c, t.server.Columns("server_")...)
+	c = append(c,
+		Column{Name: "socket_cookie", Data: &t.socketCookie},
+		Column{Name: "tcp_flags", Data: &t.tcpFlags},
+		Column{Name: "flows", Data: &t.flows},
+		Column{Name: "dropped", Data: &t.dropped},
+		Column{Name: "handshake", Data: &t.handshake},
+		Column{Name: "close_reason", Data: &t.closeReason},
+	)
+	return c
+}
+
+func (t *Table) Input() proto.Input {
+	var out proto.Input
+	for _, v := range t.Columns() {
+		out = append(out, proto.InputColumn{
+			Name: v.Name,
+			Data: v.Data,
+		})
+	}
+	return out
+}
+
+// Each calls f for every row, connections have no internal tracking state.
+func (t *Table) Each(f func(c *Conn) error) error {
+	for i := 0; i < t.Rows(); i++ {
+		c := &Conn{
+			Client:       t.client.Row(i),
+			Server:       t.server.Row(i),
+			SocketCookie: t.socketCookie.Row(i),
+			Start:        t.timestamp.Row(i),
+			End:          t.timestampEnd.Row(i),
+			Flows:        t.flows.Row(i),
+			Dropped:      t.dropped.Row(i),
+			Handshake:    Handshake(t.handshake.Row(i)),
+			Close:        CloseReason(t.closeReason.Row(i)),
+		}
+		for _, flag := range t.tcpFlags.Row(i) {
+			for j, name := range tcpFlags {
+				if name == flag {
+					c.flags |= 1 << j
+				}
+			}
+		}
+		if err := f(c); err != nil {
+			return errors.Wrapf(err, "[%d]", i)
+		}
+	}
+	return nil
+}
+
+// Append appends closed connection.
+func (t *Table) Append(c *Conn) error {
+	if c.Close == "" {
+		return errors.New("connection is not closed")
+	}
+	t.timestampEnd.Append(c.End)
+	t.duration.Append(uint64(c.Duration().Nanoseconds())) //#nosec G115
+	t.client.Append(c.Client)
+	t.server.Append(c.Server)
+	t.socketCookie.Append(c.SocketCookie)
+	t.tcpFlags.Append(c.Flags())
+	t.flows.Append(c.Flows)
+	t.dropped.Append(c.Dropped)
+	t.handshake.Append(string(c.Handshake))
+	t.closeReason.Append(string(c.Close))
+
+	t.timestamp.Append(c.Start)
+
+	return nil
+}
+
+func newStrLowCardinality() proto.ColLowCardinality[string] {
+	return *proto.NewLowCardinality[string](&proto.ColStr{})
+}
+
+func NewTable(name string) *Table {
+	t := &Table{
+		name:   name,
+		client: newEndpointColumns(),
+		server: newEndpointColumns(),
+	}
+	t.timestamp.WithPrecision(9)
+	t.timestampEnd.WithPrecision(9)
+	t.tcpFlags = *proto.NewArray[string](&proto.ColEnum{})
+	return t
+}
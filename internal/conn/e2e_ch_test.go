@@ -0,0 +1,98 @@
+package conn
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ClickHouse/ch-go"
+	"github.com/ClickHouse/ch-go/cht"
+	"github.com/cilium/cilium/api/v1/observer"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestTable_ResultColumns(t *testing.T) {
+	d := NewTable("connections")
+	cols := d.ResultColumns()
+	inputs := d.Input()
+	ddl := NewDDL("connections")
+
+	require.Equal(t, len(cols), len(inputs))
+	for i := range cols {
+		require.Equal(t, cols[i], inputs[i].Name)
+		require.True(t, strings.Contains(ddl, cols[i]))
+	}
+}
+
+func newConn() *Conn {
+	start := time.Unix(100, 0).UTC()
+	return &Conn{
+		Client:       client,
+		Server:       server,
+		SocketCookie: 42,
+		Start:        start,
+		End:          start.Add(time.Second),
+		Flows:        10,
+		Dropped:      1,
+		Handshake:    HandshakeComplete,
+		Close:        CloseRST,
+		flags:        tcpFlagsMask(&observer.TCPFlags{SYN: true, RST: true, ACK: true}),
+	}
+}
+
+func TestTable_Append(t *testing.T) {
+	d := NewTable("connections")
+	require.Error(t, d.Append(&Conn{}), "not closed")
+
+	c := newConn()
+	require.NoError(t, d.Append(c))
+	require.Equal(t, 1, d.Rows())
+	require.NoError(t, d.Each(func(got *Conn) error {
+		require.True(t, c.Start.Equal(got.Start))
+		require.True(t, c.End.Equal(got.End))
+		got.Start, got.End = c.Start, c.End
+		require.Equal(t, c, got)
+		require.Equal(t, []string{"SYN", "RST", "ACK"}, got.Flags())
+		return nil
+	}))
+	d.Reset()
+	require.Zero(t, d.Rows())
+}
+
+func TestIntegrationClickHouseColumns(t *testing.T) {
+	cht.Skip(t)
+	s := cht.New(t)
+	ctx := context.Background()
+	c, err := ch.Dial(ctx, ch.Options{
+		Address: s.TCP,
+		Logger:  zaptest.NewLogger(t),
+	})
+	require.NoError(t, err)
+	ddl := DDL
+	ddl += "\nTTL toDateTime(timestamp) + INTERVAL 6 HOUR"
+	require.NoError(t, c.Do(ctx, ch.Query{Body: ddl}), "DDL")
+
+	d := NewTable("connections")
+	conn := newConn()
+	require.NoError(t, d.Append(conn))
+	require.NoError(t, c.Do(ctx, ch.Query{
+		Body:  d.Insert(),
+		Input: d.Input(),
+	}), "insert")
+
+	d.Reset()
+	require.NoError(t, c.Do(ctx, ch.Query{
+		Body:   fmt.Sprintf("SELECT %s FROM connections", strings.Join(d.ResultColumns(), ", ")),
+		Result: d.Result(),
+	}), "select")
+	require.NoError(t, d.Each(func(got *Conn) error {
+		require.Equal(t, conn.Client, got.Client)
+		require.Equal(t, conn.Flags(), got.Flags())
+		require.Equal(t, conn.Close, got.Close)
+		require.True(t, conn.Start.Equal(got.Start))
+		return nil
+	}))
+}
@@ -0,0 +1,318 @@
+package conn
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/cilium/cilium/api/v1/flow"
+	"github.com/cilium/cilium/api/v1/observer"
+)
+
+// Handshake is state of TCP handshake of connection.
+type Handshake string
+
+// Possible values of Handshake.
+const (
+	// HandshakeUnknown means that handshake was not observed, e.g.
+	// connection was established before tracking started.
+	HandshakeUnknown Handshake = "UNKNOWN"
+	// HandshakeComplete means that server replied with SYN-ACK.
+	HandshakeComplete Handshake = "COMPLETE"
+	// HandshakeFailed means that client sent SYN, but server did not
+	// reply with SYN-ACK.
+	HandshakeFailed Handshake = "FAILED"
+)
+
+// CloseReason is reason of connection close.
+type CloseReason string
+
+// Possible values of CloseReason.
+const (
+	CloseFIN     CloseReason = "FIN"
+	CloseRST     CloseReason = "RST"
+	CloseTimeout CloseReason = "TIMEOUT"
+	// CloseShutdown means that connection was still open when tracking
+	// stopped, e.g. on ingester shutdown, so End is not actual end.
+	CloseShutdown CloseReason = "SHUTDOWN"
+)
+
+// Endpoint of connection.
+type Endpoint struct {
+	IP        string
+	Port      uint32
+	Namespace string
+	Pod       string
+	Workload  string
+}
+
+func newEndpoint(e *flow.Endpoint, ip string, port uint32) Endpoint {
+	out := Endpoint{
+		IP:        ip,
+		Port:      port,
+		Namespace: e.GetNamespace(),
+		Pod:       e.GetPodName(),
+	}
+	if w := e.GetWorkloads(); len(w) > 0 {
+		out.Workload = w[0].GetName()
+	}
+	return out
+}
+
+// TCP flags in order of flow table enum.
+var tcpFlags = []string{"FIN", "SYN", "RST", "PSH", "ACK", "URG", "ECE", "CWR", "NS"}
+
+func tcpFlagsMask(f *flow.TCPFlags) uint16 {
+	var mask uint16
+	for i, v := range []bool{
+		f.GetFIN(), f.GetSYN(), f.GetRST(), f.GetPSH(), f.GetACK(),
+		f.GetURG(), f.GetECE(), f.GetCWR(), f.GetNS(),
+	} {
+		if v {
+			mask |= 1 << i
+		}
+	}
+	return mask
+}
+
+// Conn is TCP connection tracked across flows.
+type Conn struct {
+	Client Endpoint
+	Server Endpoint
+	// SocketCookie is cookie of client socket, if reported by Cilium.
+	SocketCookie uint64
+	// Start and End are timestamps of the first and the last flow.
+	Start time.Time
+	End   time.Time
+	// Flows is count of flows of connection.
+	Flows uint32
+	// Dropped is count of dropped flows of connection.
+	Dropped uint32
+
+	Handshake Handshake
+	Close     CloseReason
+
+	flags     uint16
+	syn       bool // client SYN
+	synAck    bool // server SYN-ACK
+	clientFIN bool
+	serverFIN bool
+	seen      time.Time // time when last flow was added
+}
+
+// Flags returns TCP flags seen on connection.
+func (c *Conn) Flags() []string {
+	var out []string
+	for i, name := range tcpFlags {
+		if c.flags&(1<<i) != 0 {
+			out = append(out, name)
+		}
+	}
+	return out
+}
+
+// Duration returns duration of connection.
+func (c *Conn) Duration() time.Duration {
+	return c.End.Sub(c.Start)
+}
+
+func (c *Conn) close(reason CloseReason) {
+	c.Close = reason
+	switch {
+	case c.synAck:
+		c.Handshake = HandshakeComplete
+	case c.syn:
+		c.Handshake = HandshakeFailed
+	default:
+		c.Handshake = HandshakeUnknown
+	}
+}
+
+// key is direction-agnostic 5-tuple of TCP connection.
+type key struct {
+	a, b string
+}
+
+func newKey(srcIP string, srcPort uint32, dstIP string, dstPort uint32) key {
+	a := srcIP + "|" + strconv.FormatUint(uint64(srcPort), 10)
+	b := dstIP + "|" + strconv.FormatUint(uint64(dstPort), 10)
+	if a > b {
+		a, b = b, a
+	}
+	return key{a: a, b: b}
+}
+
+// TrackerOptions configures Tracker.
+type TrackerOptions struct {
+	// IdleTimeout closes connections without flows, 1m by default.
+	IdleTimeout time.Duration
+	// LingerTimeout closes connections with FIN from one side only,
+	// 5s by default.
+	LingerTimeout time.Duration
+	// MaxConns limits number of tracked connections, new connections
+	// are ignored when reached. 100k by default.
+	MaxConns int
+}
+
+func (o *TrackerOptions) setDefaults() {
+	if o.IdleTimeout <= 0 {
+		o.IdleTimeout = time.Minute
+	}
+	if o.LingerTimeout <= 0 {
+		o.LingerTimeout = 5 * time.Second
+	}
+	if o.MaxConns <= 0 {
+		o.MaxConns = 100_000
+	}
+}
+
+// Tracker tracks TCP connections across hubble flows by 5-tuple.
+//
+// Socket cookie is not used as key, because Cilium reports it only for
+// socket-LB translated flows and not for replies.
+//
+// Tracker is not safe for concurrent use.
+type Tracker struct {
+	opt    TrackerOptions
+	conns  map[key]*Conn
+	closed []*Conn
+}
+
+// NewTracker creates new Tracker.
+func NewTracker(opt TrackerOptions) *Tracker {
+	opt.setDefaults()
+	return &Tracker{
+		opt:   opt,
+		conns: map[key]*Conn{},
+	}
+}
+
+// Len returns number of tracked connections.
+func (t *Tracker) Len() int {
+	return len(t.conns)
+}
+
+// Add updates connection of TCP flow, other flows are ignored.
+func (t *Tracker) Add(now time.Time, f *observer.Flow) {
+	tcp := f.GetL4().GetTCP()
+	if tcp == nil || f.GetIP() == nil {
+		return
+	}
+	var (
+		srcIP, dstIP     = f.GetIP().GetSource(), f.GetIP().GetDestination()
+		srcPort, dstPort = tcp.GetSourcePort(), tcp.GetDestinationPort()
+		flags            = tcp.GetFlags()
+		k                = newKey(srcIP, srcPort, dstIP, dstPort)
+		ts               = f.GetTime().AsTime()
+	)
+	c, ok := t.conns[k]
+	if !ok {
+		if flags.GetRST() || (flags.GetFIN() && !flags.GetSYN()) {
+			// Tail of connection that is already closed or not tracked.
+			return
+		}
+		if len(t.conns) >= t.opt.MaxConns {
+			return
+		}
+		src := newEndpoint(f.GetSource(), srcIP, srcPort)
+		dst := newEndpoint(f.GetDestination(), dstIP, dstPort)
+		c = &Conn{Client: src, Server: dst, Start: ts, End: ts}
+		switch {
+		case flags.GetSYN():
+			// SYN is sent by client, SYN-ACK by server.
+			if flags.GetACK() {
+				c.Client, c.Server = dst, src
+			}
+		case f.GetIsReply().GetValue():
+			c.Client, c.Server = dst, src
+		}
+		t.conns[k] = c
+	}
+
+	fromClient := c.Client.IP == srcIP && c.Client.Port == srcPort
+	if fromClient && c.SocketCookie == 0 {
+		c.SocketCookie = f.GetSocketCookie()
+	}
+	c.seen = now
+	c.Flows++
+	if f.GetVerdict() == flow.Verdict_DROPPED {
+		c.Dropped++
+	}
+	if ts.Before(c.Start) {
+		c.Start = ts
+	}
+	if ts.After(c.End) {
+		c.End = ts
+	}
+	c.flags |= tcpFlagsMask(flags)
+	switch {
+	case fromClient && flags.GetSYN() && !flags.GetACK():
+		c.syn = true
+	case !fromClient && flags.GetSYN() && flags.GetACK():
+		c.synAck = true
+	}
+	if flags.GetFIN() {
+		if fromClient {
+			c.clientFIN = true
+		} else {
+			c.serverFIN = true
+		}
+	}
+	switch {
+	case flags.GetRST():
+		t.done(k, c, CloseRST)
+	case c.clientFIN && c.serverFIN:
+		t.done(k, c, CloseFIN)
+	}
+}
+
+func (t *Tracker) done(k key, c *Conn, reason CloseReason) {
+	c.close(reason)
+	delete(t.conns, k)
+	t.closed = append(t.closed, c)
+}
+
+// Flush calls fn for closed and timed out connections, ordered by start,
+// and stops tracking them.
+func (t *Tracker) Flush(now time.Time, fn func(c *Conn) error) error {
+	for k, c := range t.conns {
+		idle := now.Sub(c.seen)
+		switch {
+		case (c.clientFIN || c.serverFIN) && idle >= t.opt.LingerTimeout:
+			t.done(k, c, CloseFIN)
+		case idle >= t.opt.IdleTimeout:
+			t.done(k, c, CloseTimeout)
+		}
+	}
+	return t.flushClosed(fn)
+}
+
+// Shutdown calls fn for all connections, like Flush, and stops tracking
+// them. Connections that are still open are closed with CloseShutdown.
+func (t *Tracker) Shutdown(fn func(c *Conn) error) error {
+	for k, c := range t.conns {
+		if c.clientFIN || c.serverFIN {
+			t.done(k, c, CloseFIN)
+			continue
+		}
+		t.done(k, c, CloseShutdown)
+	}
+	return t.flushClosed(fn)
+}
+
+func (t *Tracker) flushClosed(fn func(c *Conn) error) error {
+	closed := t.closed
+	t.closed = nil
+	slices.SortStableFunc(closed, func(a, b *Conn) int {
+		return cmp.Compare(a.Start.UnixNano(), b.Start.UnixNano())
+	})
+	for i, c := range closed {
+		if err := fn(c); err != nil {
+			// Keep not flushed connections.
+			t.closed = append(t.closed, closed[i+1:]...)
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+package conn
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cilium/cilium/api/v1/observer"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var (
+	client = Endpoint{IP: "10.0.0.1", Port: 40000, Namespace: "shop", Pod: "api-1", Workload: "api"}
+	server = Endpoint{IP: "10.0.0.2", Port: 5432, Namespace: "db", Pod: "postgres-0", Workload: "postgres"}
+)
+
+func newFlow(ts time.Time, src, dst Endpoint, flags *observer.TCPFlags) *observer.Flow {
+	endpoint := func(e Endpoint) *observer.Endpoint {
+		return &observer.Endpoint{
+			Namespace: e.Namespace,
+			PodName:   e.Pod,
+			Workloads: []*observer.Workload{{Name: e.Workload, Kind: "Deployment"}},
+		}
+	}
+	return &observer.Flow{
+		Time:        timestamppb.New(ts),
+		Verdict:     observer.Verdict_FORWARDED,
+		Source:      endpoint(src),
+		Destination: endpoint(dst),
+		IP:          &observer.IP{Source: src.IP, Destination: dst.IP},
+		L4: &observer.Layer4{
+			Protocol: &observer.Layer4_TCP{
+				TCP: &observer.TCP{
+					SourcePort:      src.Port,
+					DestinationPort: dst.Port,
+					Flags:           flags,
+				},
+			},
+		},
+	}
+}
+
+func flush(t *testing.T, tr *Tracker, now time.Time) []*Conn {
+	t.Helper()
+	var out []*Conn
+	require.NoError(t, tr.Flush(now, func(c *Conn) error {
+		out = append(out, c)
+		return nil
+	}))
+	return out
+}
+
+func TestTracker(t *testing.T) {
+	start := time.Unix(100, 0)
+	at := func(d time.Duration) time.Time { return start.Add(d) }
+
+	t.Run("FIN", func(t *testing.T) {
+		tr := NewTracker(TrackerOptions{})
+		// SYN-ACK is observed first, client is still detected by flags.
+		tr.Add(at(0), newFlow(at(2*time.Millisecond), server, client, &observer.TCPFlags{SYN: true, ACK: true}))
+		tr.Add(at(0), newFlow(at(0), client, server, &observer.TCPFlags{SYN: true}))
+		tr.Add(at(0), newFlow(at(3*time.Millisecond), client, server, &observer.TCPFlags{ACK: true, PSH: true}))
+		tr.Add(at(0), newFlow(at(time.Second), client, server, &observer.TCPFlags{FIN: true, ACK: true}))
+		require.Equal(t, 1, tr.Len())
+		require.Empty(t, flush(t, tr, at(time.Second)))
+
+		tr.Add(at(time.Second), newFlow(at(time.Second+time.Millisecond), server, client, &observer.TCPFlags{FIN: true, ACK: true}))
+		require.Zero(t, tr.Len())
+		// Late ACK of FIN is not a new connection.
+		tr.Add(at(time.Second), newFlow(at(time.Second+2*time.Millisecond), client, server, &observer.TCPFlags{FIN: true, ACK: true}))
+		require.Zero(t, tr.Len())
+
+		conns := flush(t, tr, at(time.Second))
+		require.Len(t, conns, 1)
+		c := conns[0]
+		require.Equal(t, client, c.Client)
+		require.Equal(t, server, c.Server)
+		require.Equal(t, HandshakeComplete, c.Handshake)
+		require.Equal(t, CloseFIN, c.Close)
+		require.Equal(t, uint32(5), c.Flows)
+		require.Equal(t, time.Second+time.Millisecond, c.Duration())
+		require.Equal(t, []string{"FIN", "SYN", "PSH", "ACK"}, c.Flags())
+	})
+	t.Run("RST", func(t *testing.T) {
+		tr := NewTracker(TrackerOptions{})
+		tr.Add(at(0), newFlow(at(0), client, server, &observer.TCPFlags{SYN: true}))
+		tr.Add(at(0), newFlow(at(time.Millisecond), server, client, &observer.TCPFlags{RST: true, ACK: true}))
+		conns := flush(t, tr, at(0))
+		require.Len(t, conns, 1)
+		require.Equal(t, CloseRST, conns[0].Close)
+		require.Equal(t, HandshakeFailed, conns[0].Handshake)
+	})
+	t.Run("HandshakeTimeout", func(t *testing.T) {
+		tr := NewTracker(TrackerOptions{IdleTimeout: 10 * time.Second})
+		syn := newFlow(at(0), client, server, &observer.TCPFlags{SYN: true})
+		syn.Verdict = observer.Verdict_DROPPED
+		syn.SocketCookie = 42
+		tr.Add(at(0), syn)
+		tr.Add(at(time.Second), syn)
+		require.Empty(t, flush(t, tr, at(10*time.Second)))
+
+		conns := flush(t, tr, at(11*time.Second))
+		require.Len(t, conns, 1)
+		c := conns[0]
+		require.Equal(t, CloseTimeout, c.Close)
+		require.Equal(t, HandshakeFailed, c.Handshake)
+		require.Equal(t, uint32(2), c.Dropped)
+		require.Equal(t, uint64(42), c.SocketCookie)
+	})
+	t.Run("Linger", func(t *testing.T) {
+		tr := NewTracker(TrackerOptions{LingerTimeout: time.Second})
+		// Connection established before tracking, reply direction.
+		reply := newFlow(at(0), server, client, &observer.TCPFlags{ACK: true, PSH: true})
+		reply.IsReply = wrapperspb.Bool(true)
+		tr.Add(at(0), reply)
+		tr.Add(at(0), newFlow(at(0), server, client, &observer.TCPFlags{FIN: true, ACK: true}))
+		require.Empty(t, flush(t, tr, at(500*time.Millisecond)))
+
+		conns := flush(t, tr, at(time.Second))
+		require.Len(t, conns, 1)
+		require.Equal(t, client, conns[0].Client)
+		require.Equal(t, CloseFIN, conns[0].Close)
+		require.Equal(t, HandshakeUnknown, conns[0].Handshake)
+	})
+	t.Run("Shutdown", func(t *testing.T) {
+		tr := NewTracker(TrackerOptions{})
+		tr.Add(at(0), newFlow(at(0), client, server, &observer.TCPFlags{SYN: true}))
+		tr.Add(at(0), newFlow(at(time.Millisecond), server, client, &observer.TCPFlags{SYN: true, ACK: true}))
+		tr.Add(at(0), newFlow(at(time.Second), client, server, &observer.TCPFlags{ACK: true, PSH: true}))
+		closing := client
+		closing.Port++
+		tr.Add(at(0), newFlow(at(0), closing, server, &observer.TCPFlags{ACK: true, PSH: true}))
+		tr.Add(at(0), newFlow(at(0), closing, server, &observer.TCPFlags{FIN: true, ACK: true}))
+		require.Empty(t, flush(t, tr, at(time.Second)))
+
+		var conns []*Conn
+		require.NoError(t, tr.Shutdown(func(c *Conn) error {
+			conns = append(conns, c)
+			return nil
+		}))
+		require.Zero(t, tr.Len())
+		require.Len(t, conns, 2)
+		for _, c := range conns {
+			if c.Client == closing {
+				require.Equal(t, CloseFIN, c.Close)
+				continue
+			}
+			require.Equal(t, CloseShutdown, c.Close)
+			require.Equal(t, HandshakeComplete, c.Handshake)
+			require.Equal(t, time.Second, c.Duration())
+		}
+	})
+	t.Run("MaxConns", func(t *testing.T) {
+		tr := NewTracker(TrackerOptions{MaxConns: 1})
+		tr.Add(at(0), newFlow(at(0), client, server, &observer.TCPFlags{SYN: true}))
+		other := client
+		other.Port++
+		tr.Add(at(0), newFlow(at(0), other, server, &observer.TCPFlags{SYN: true}))
+		require.Equal(t, 1, tr.Len())
+	})
+	t.Run("NotTCP", func(t *testing.T) {
+		tr := NewTracker(TrackerOptions{})
+		tr.Add(at(0), &observer.Flow{
+			IP: &observer.IP{Source: client.IP, Destination: server.IP},
+			L4: &observer.Layer4{Protocol: &observer.Layer4_UDP{UDP: &observer.UDP{}}},
+		})
+		require.Zero(t, tr.Len())
+	})
+}
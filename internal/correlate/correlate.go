@@ -0,0 +1,189 @@
+// Package correlate implements ClickHouse view that attributes hubble flows
+// to processes from tetragon events.
+package correlate
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-faster/vega/internal/flow"
+)
+
+// Match is how flow was attributed to process, stored in process_match
+// column of view.
+type Match string
+
+// Possible values of Match.
+const (
+	// MatchNone means that no process was found.
+	MatchNone Match = ""
+	// MatchSocket means that socket cookie of flow is equal to cookie of
+	// socket argument of tetragon kprobe in the same pod, e.g. tcp_connect.
+	//
+	// This match is exact.
+	MatchSocket Match = "socket"
+	// MatchExec means that process is the most recently started process of
+	// the pod that was still running at flow time.
+	//
+	// This match is heuristic: tetragon process events do not carry
+	// cgroup id, so processes of different containers of the pod are not
+	// distinguished. Use cgroup_id column to group such flows.
+	MatchExec Match = "exec"
+)
+
+// Lookback is how long before start of view window tetragon events are
+// scanned for processes and sockets of flows, same as TTL of tetragon table.
+const Lookback = 6 * time.Hour
+
+// NewViewDDL returns DDL of parameterized view that extends flows from
+// flowTable with process_* columns, attributing them to processes from
+// secTable.
+//
+// View is parameterized by namespace, pod and time window, so only events
+// of the pod are scanned, see ViewSource. Empty pod selects all pods of
+// namespace.
+//
+// Row is attributed to process of indexed pod (k8s_ns, k8s_pod), so both
+// DIRECT and INVERSE rows are attributed to process of their own pod.
+// Example of query for processes of pod that talked to IP:
+//
+//	SELECT process_binary, process_exec_id, sum(flow_count)
+//	FROM hubble_process(ns = 'shop', pod = 'api-1', start = '2024-01-01 10:00:00', end = '2024-01-01 11:00:00')
+//	WHERE peer_ip = '1.1.1.1'
+//	GROUP BY process_binary, process_exec_id
+func NewViewDDL(viewName, flowTable, secTable string) string {
+	const ddl = `
+CREATE OR REPLACE VIEW %[1]s AS
+SELECT
+    f.timestamp AS timestamp,
+    f.timestamp_last AS timestamp_last,
+    f.flow_count AS flow_count,
+    f.k8s_ns AS k8s_ns,
+    f.k8s_pod AS k8s_pod,
+    f.direction AS direction,
+    f.verdict AS verdict,
+    f.ip_src AS ip_src,
+    f.ip_dst AS ip_dst,
+    f.peer_ip AS peer_ip,
+    f.l4_protocol AS l4_protocol,
+    f.l4_src_port AS l4_src_port,
+    f.l4_dst_port AS l4_dst_port,
+    f.socket_cookie AS socket_cookie,
+    f.cgroup_id AS cgroup_id,
+    f.trace_id AS trace_id,
+
+    s.exec_id != '' AS socket_matched,
+    if(socket_matched, s.exec_id, f.proc_exec_id) AS process_exec_id,
+    if(socket_matched, s.pid, f.proc_pid) AS process_pid,
+    if(socket_matched, s.binary, f.proc_binary) AS process_binary,
+    if(socket_matched, s.args, f.proc_args) AS process_args,
+    if(socket_matched, s.container, f.proc_container) AS process_container,
+    multiIf(socket_matched, '%[4]s', f.proc_exec_id != '', '%[5]s', '%[6]s') AS process_match
+FROM (
+    SELECT
+        flows.timestamp AS timestamp,
+        flows.timestamp_last AS timestamp_last,
+        flows.flow_count AS flow_count,
+        flows.k8s_ns AS k8s_ns,
+        flows.k8s_pod AS k8s_pod,
+        toString(flows.direction) AS direction,
+        toString(flows.verdict) AS verdict,
+        if(flows.ip_version = 'IPv6', toString(flows.ipv6_src), toString(flows.ipv4_src)) AS ip_src,
+        if(flows.ip_version = 'IPv6', toString(flows.ipv6_dst), toString(flows.ipv4_dst)) AS ip_dst,
+        if(flows.direction = 'INVERSE', ip_src, ip_dst) AS peer_ip,
+        toString(flows.l4_protocol) AS l4_protocol,
+        flows.l4_src_port AS l4_src_port,
+        flows.l4_dst_port AS l4_dst_port,
+        flows.socket_cookie AS socket_cookie,
+        flows.cgroup_id AS cgroup_id,
+        flows.trace_id AS trace_id,
+
+        -- Most recently started process of pod that was running at flow time,
+        -- so short-lived processes do not hide long-lived ones.
+        arrayLast(
+            x -> tupleElement(x, 1) <= flows.timestamp
+                AND (NOT tupleElement(x, 2) OR flows.timestamp <= tupleElement(x, 3)),
+            p.procs
+        ) AS proc,
+        tupleElement(proc, 4) AS proc_exec_id,
+        tupleElement(proc, 5) AS proc_pid,
+        tupleElement(proc, 6) AS proc_binary,
+        tupleElement(proc, 7) AS proc_args,
+        tupleElement(proc, 8) AS proc_container
+    FROM (
+        SELECT *
+        FROM %[2]s
+        WHERE %[7]s
+            AND timestamp >= %[8]s AND timestamp < %[9]s
+    ) AS flows
+    LEFT JOIN (
+        SELECT
+            k8s_ns,
+            k8s_pod,
+            -- Processes of pod ordered by start time.
+            arraySort(x -> tupleElement(x, 1), groupArray(
+                (start_time, exited, exit_time, exec_id, pid, binary, args, container)
+            )) AS procs
+        FROM (
+            SELECT
+                k8s_ns,
+                k8s_pod,
+                process_exec_id AS exec_id,
+                any(process_start_time) AS start_time,
+                any(process_pid) AS pid,
+                any(process_binary) AS binary,
+                any(process_args) AS args,
+                any(k8s_container) AS container,
+                countIf(event_type = 'ProcessExit') > 0 AS exited,
+                maxIf(timestamp, event_type = 'ProcessExit') AS exit_time
+            FROM %[3]s
+            WHERE event_type IN ('ProcessExec', 'ProcessExit') AND process_exec_id != ''
+                AND %[7]s
+                AND timestamp >= %[10]s AND timestamp < %[9]s
+            GROUP BY k8s_ns, k8s_pod, process_exec_id
+        )
+        GROUP BY k8s_ns, k8s_pod
+    ) AS p
+    ON flows.k8s_ns = p.k8s_ns AND flows.k8s_pod = p.k8s_pod
+) AS f
+LEFT JOIN (
+    SELECT
+        k8s_ns,
+        k8s_pod,
+        sock_cookie,
+        argMax(process_exec_id, timestamp) AS exec_id,
+        argMax(process_pid, timestamp) AS pid,
+        argMax(process_binary, timestamp) AS binary,
+        argMax(process_args, timestamp) AS args,
+        argMax(k8s_container, timestamp) AS container
+    FROM %[3]s
+    WHERE event_type = 'ProcessKprobe' AND sock_cookie != 0
+        AND %[7]s
+        AND timestamp >= %[10]s AND timestamp < %[9]s
+    GROUP BY k8s_ns, k8s_pod, sock_cookie
+) AS s
+ON f.k8s_ns = s.k8s_ns AND f.k8s_pod = s.k8s_pod AND f.socket_cookie = s.sock_cookie
+`
+	const (
+		pod   = "k8s_ns = {ns:String} AND ({pod:String} = '' OR k8s_pod = {pod:String})"
+		start = "{start:DateTime64(9, 'UTC')}"
+		end   = "{end:DateTime64(9, 'UTC')}"
+	)
+	lookback := fmt.Sprintf("%s - INTERVAL %d SECOND", start, int(Lookback.Seconds()))
+	return fmt.Sprintf(ddl, viewName, flowTable, secTable, MatchSocket, MatchExec, MatchNone,
+		pod, start, end, lookback,
+	)
+}
+
+// ViewSource returns table function of view with given parameters to use
+// in FROM clause, like "hubble_process(ns = 'shop', ...)".
+//
+// View returns flows of pod in namespace in [start, end) window, all pods
+// of namespace if pod is empty.
+func ViewSource(viewName, namespace, pod string, start, end time.Time) string {
+	const layout = "2006-01-02 15:04:05.999999999"
+	return fmt.Sprintf("%s(ns = %s, pod = %s, start = %s, end = %s)", viewName,
+		flow.Quote(namespace), flow.Quote(pod),
+		flow.Quote(start.UTC().Format(layout)), flow.Quote(end.UTC().Format(layout)),
+	)
+}
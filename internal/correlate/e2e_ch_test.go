@@ -0,0 +1,164 @@
+package correlate
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ClickHouse/ch-go"
+	"github.com/ClickHouse/ch-go/cht"
+	"github.com/ClickHouse/ch-go/proto"
+	"github.com/cilium/cilium/api/v1/observer"
+	"github.com/go-faster/tetragon/api/v1/tetragon"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/go-faster/vega/internal/flow"
+	"github.com/go-faster/vega/internal/sec"
+)
+
+func TestNewViewDDL(t *testing.T) {
+	ddl := NewViewDDL("hubble_process", "hubble", "tetragon")
+	require.Contains(t, ddl, "CREATE OR REPLACE VIEW hubble_process")
+	require.Contains(t, ddl, "FROM hubble\n")
+	require.Contains(t, ddl, "FROM tetragon")
+	require.Contains(t, ddl, "{ns:String}")
+	require.NotContains(t, ddl, "%!")
+}
+
+func TestViewSource(t *testing.T) {
+	var (
+		start = time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+		end   = start.Add(time.Hour + time.Millisecond)
+	)
+	require.Equal(t,
+		`hubble_process(ns = 'sh\'op', pod = 'api-1', start = '2024-01-01 10:00:00', end = '2024-01-01 11:00:00.001')`,
+		ViewSource("hubble_process", "sh'op", "api-1", start, end),
+	)
+}
+
+func TestIntegrationClickHouseView(t *testing.T) {
+	cht.Skip(t)
+	s := cht.New(t)
+	ctx := context.Background()
+	c, err := ch.Dial(ctx, ch.Options{
+		Address: s.TCP,
+		Logger:  zaptest.NewLogger(t),
+	})
+	require.NoError(t, err)
+	for _, ddl := range []string{
+		flow.NewDDL("hubble"),
+		sec.NewDDL("tetragon"),
+		NewViewDDL("hubble_process", "hubble", "tetragon"),
+	} {
+		require.NoError(t, c.Do(ctx, ch.Query{Body: ddl}), "DDL")
+	}
+
+	var (
+		start = time.Now().Add(-time.Minute).Truncate(time.Millisecond)
+		pod   = &tetragon.Pod{Namespace: "shop", Name: "api-1", Container: &tetragon.Container{Name: "api"}}
+		api   = &tetragon.Process{
+			ExecId:    "api",
+			Pid:       wrapperspb.UInt32(1),
+			Binary:    "/usr/bin/api",
+			StartTime: timestamppb.New(start),
+			Pod:       pod,
+		}
+		curl = &tetragon.Process{
+			ExecId:       "curl",
+			ParentExecId: "api",
+			Pid:          wrapperspb.UInt32(10),
+			Binary:       "/usr/bin/curl",
+			StartTime:    timestamppb.New(start.Add(10 * time.Second)),
+			Pod:          pod,
+		}
+	)
+	events := sec.NewTable("tetragon")
+	for _, e := range []*tetragon.GetEventsResponse{
+		{
+			Event: &tetragon.GetEventsResponse_ProcessExec{ProcessExec: &tetragon.ProcessExec{Process: api}},
+			Time:  api.StartTime,
+		},
+		{
+			Event: &tetragon.GetEventsResponse_ProcessExec{ProcessExec: &tetragon.ProcessExec{Process: curl, Parent: api}},
+			Time:  curl.StartTime,
+		},
+		{
+			Event: &tetragon.GetEventsResponse_ProcessExit{ProcessExit: &tetragon.ProcessExit{Process: curl, Parent: api}},
+			Time:  timestamppb.New(start.Add(20 * time.Second)),
+		},
+		{
+			// Connection opened by api.
+			Event: &tetragon.GetEventsResponse_ProcessKprobe{ProcessKprobe: &tetragon.ProcessKprobe{
+				Process:      api,
+				FunctionName: "tcp_connect",
+				Args: []*tetragon.KprobeArgument{
+					{Arg: &tetragon.KprobeArgument_SockArg{SockArg: &tetragon.KprobeSock{
+						Saddr:  "10.0.0.1",
+						Daddr:  "10.0.0.2",
+						Sport:  40000,
+						Dport:  5432,
+						Cookie: 42,
+					}}},
+				},
+			}},
+			Time: timestamppb.New(start.Add(15 * time.Second)),
+		},
+	} {
+		require.NoError(t, events.Append(sec.Row{Res: e}))
+	}
+	require.NoError(t, c.Do(ctx, ch.Query{Body: events.Insert(), Input: events.Input()}), "insert events")
+
+	flows := flow.NewTable("hubble")
+	index := flow.Peer{Kubernetes: flow.RowKubernetes{Namespace: "shop", Pod: "api-1"}}
+	for _, f := range []struct {
+		at     time.Duration
+		cookie uint64
+	}{
+		{at: 15 * time.Second, cookie: 42}, // socket
+		{at: 16 * time.Second},             // exec, curl is running
+		{at: 30 * time.Second},             // exec, curl exited but api is running
+	} {
+		require.NoError(t, flows.Append(flow.Row{
+			Index: index,
+			Raw: &observer.Flow{
+				Time:         timestamppb.New(start.Add(f.at)),
+				SocketCookie: f.cookie,
+				IP:           &observer.IP{Source: "10.0.0.1", Destination: "10.0.0.2", IpVersion: observer.IPVersion_IPv4},
+			},
+		}))
+	}
+	require.NoError(t, c.Do(ctx, ch.Query{Body: flows.Insert(), Input: flows.Input()}), "insert flows")
+
+	var (
+		match  proto.ColStr
+		binary proto.ColStr
+		peer   proto.ColStr
+		got    []string
+	)
+	require.NoError(t, c.Do(ctx, ch.Query{
+		Body: `SELECT process_match, process_binary, peer_ip FROM ` +
+			ViewSource("hubble_process", "shop", "api-1", start, start.Add(time.Minute)) +
+			` ORDER BY timestamp`,
+		Result: proto.Results{
+			{Name: "process_match", Data: &match},
+			{Name: "process_binary", Data: &binary},
+			{Name: "peer_ip", Data: &peer},
+		},
+		OnResult: func(ctx context.Context, block proto.Block) error {
+			for i := 0; i < match.Rows(); i++ {
+				require.Equal(t, "10.0.0.2", peer.Row(i))
+				got = append(got, strings.TrimSpace(match.Row(i)+" "+binary.Row(i)))
+			}
+			return nil
+		},
+	}), "select")
+	require.Equal(t, []string{
+		"socket /usr/bin/api",
+		"exec /usr/bin/curl",
+		"exec /usr/bin/api",
+	}, got)
+}
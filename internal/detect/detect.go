@@ -0,0 +1,161 @@
+// Package detect implements runtime security detection rules over tetragon
+// events, and storage and delivery of findings.
+package detect
+
+import (
+	"slices"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/go-faster/tetragon/api/v1/tetragon"
+)
+
+// Finding is process execution matched by rule.
+type Finding struct {
+	Time        time.Time `json:"time"`
+	Rule        string    `json:"rule"`
+	Severity    Severity  `json:"severity"`
+	Description string    `json:"description,omitempty"`
+
+	Node      string `json:"node,omitempty"`
+	Namespace string `json:"namespace"`
+	Pod       string `json:"pod"`
+	Container string `json:"container,omitempty"`
+	Image     string `json:"image,omitempty"`
+
+	ExecID       string `json:"exec_id"`
+	Binary       string `json:"binary"`
+	Args         string `json:"args,omitempty"`
+	UID          uint32 `json:"uid"`
+	ParentExecID string `json:"parent_exec_id,omitempty"`
+	ParentBinary string `json:"parent_binary,omitempty"`
+	ParentUID    uint32 `json:"parent_uid"`
+}
+
+// Engine evaluates rules over tetragon events.
+type Engine struct {
+	rules []*compiled
+}
+
+// NewEngine initializes engine with rules.
+func NewEngine(rules []Rule) (*Engine, error) {
+	e := &Engine{}
+	for i, r := range rules {
+		c, err := compileRule(r)
+		if err != nil {
+			return nil, errors.Wrapf(err, "rule [%d] %s", i, r.Name)
+		}
+		e.rules = append(e.rules, c)
+	}
+	return e, nil
+}
+
+// Len returns number of rules.
+func (e *Engine) Len() int {
+	return len(e.rules)
+}
+
+// euid returns effective user id of process, if process credentials are
+// reported by tetragon, or user id.
+func euid(p *tetragon.Process) uint32 {
+	if v := p.GetProcessCredentials().GetEuid(); v != nil {
+		return v.GetValue()
+	}
+	return p.GetUid().GetValue()
+}
+
+func (c *compiled) match(e *tetragon.ProcessExec, now time.Time) bool {
+	var (
+		m      = c.Match
+		proc   = e.GetProcess()
+		parent = e.GetParent()
+		pod    = proc.GetPod()
+	)
+	if pod == nil && !m.Host {
+		return false
+	}
+	if c.binary != nil && !c.binary.MatchString(proc.GetBinary()) {
+		return false
+	}
+	if c.notBinary != nil && c.notBinary.MatchString(proc.GetBinary()) {
+		return false
+	}
+	if c.parent != nil && (parent == nil || !c.parent.MatchString(parent.GetBinary())) {
+		return false
+	}
+	if c.ancestor != nil {
+		found := parent != nil && c.ancestor.MatchString(parent.GetBinary())
+		for _, a := range e.GetAncestors() {
+			if found {
+				break
+			}
+			found = c.ancestor.MatchString(a.GetBinary())
+		}
+		if !found {
+			return false
+		}
+	}
+	for _, re := range c.args {
+		if !re.MatchString(proc.GetArguments()) {
+			return false
+		}
+	}
+	if len(m.Namespace) > 0 && !slices.Contains(m.Namespace, pod.GetNamespace()) {
+		return false
+	}
+	if len(m.UID) > 0 && !slices.Contains(m.UID, euid(proc)) {
+		return false
+	}
+	if m.UIDChanged && (parent == nil || euid(proc) == euid(parent)) {
+		return false
+	}
+	if m.MinContainerAge > 0 {
+		start := pod.GetContainer().GetStartTime()
+		if start == nil || now.Sub(start.AsTime()) < time.Duration(m.MinContainerAge) {
+			return false
+		}
+	}
+	return true
+}
+
+// Eval returns findings of all rules matched by event. Only process
+// executions are evaluated.
+func (e *Engine) Eval(r *tetragon.GetEventsResponse) []Finding {
+	exec := r.GetProcessExec()
+	if exec == nil {
+		return nil
+	}
+	now := r.GetTime().AsTime()
+	var out []Finding
+	for _, c := range e.rules {
+		if !c.match(exec, now) {
+			continue
+		}
+		var (
+			proc   = exec.GetProcess()
+			parent = exec.GetParent()
+			pod    = proc.GetPod()
+		)
+		out = append(out, Finding{
+			Time:        now,
+			Rule:        c.Name,
+			Severity:    c.Severity,
+			Description: c.Description,
+
+			Node:      r.GetNodeName(),
+			Namespace: pod.GetNamespace(),
+			Pod:       pod.GetName(),
+			Container: pod.GetContainer().GetName(),
+			Image:     pod.GetContainer().GetImage().GetName(),
+
+			ExecID:       proc.GetExecId(),
+			Binary:       proc.GetBinary(),
+			Args:         proc.GetArguments(),
+			UID:          euid(proc),
+			ParentExecID: parent.GetExecId(),
+			ParentBinary: parent.GetBinary(),
+			ParentUID:    euid(parent),
+		})
+	}
+	return out
+}
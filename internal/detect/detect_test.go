@@ -0,0 +1,249 @@
+package detect
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-faster/tetragon/api/v1/tetragon"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func execEvent(now time.Time, proc, parent *tetragon.Process) *tetragon.GetEventsResponse {
+	if proc.Pod == nil {
+		proc.Pod = &tetragon.Pod{
+			Namespace: "shop",
+			Name:      "api-1",
+			Container: &tetragon.Container{
+				Name:      "api",
+				StartTime: timestamppb.New(now.Add(-time.Hour)),
+				Image:     &tetragon.Image{Name: "registry/api:v1"},
+			},
+		}
+	}
+	return &tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessExec{
+			ProcessExec: &tetragon.ProcessExec{Process: proc, Parent: parent},
+		},
+		NodeName: "node-1",
+		Time:     timestamppb.New(now),
+	}
+}
+
+func rules(e *Engine, r *tetragon.GetEventsResponse) []string {
+	var out []string
+	for _, f := range e.Eval(r) {
+		out = append(out, f.Rule)
+	}
+	return out
+}
+
+func TestDefaultRules(t *testing.T) {
+	e, err := NewEngine(DefaultRules())
+	require.NoError(t, err)
+	require.Equal(t, 4, e.Len())
+
+	now := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	user := wrapperspb.UInt32(1000)
+	root := wrapperspb.UInt32(0)
+	for _, tt := range []struct {
+		name   string
+		proc   *tetragon.Process
+		parent *tetragon.Process
+		want   []string
+	}{
+		{
+			name:   "ShellFromNginx",
+			proc:   &tetragon.Process{Binary: "/bin/sh", Arguments: "-c id", Uid: user},
+			parent: &tetragon.Process{Binary: "/usr/sbin/nginx", Uid: user},
+			want:   []string{"shell-from-web-server"},
+		},
+		{
+			name:   "ShellFromEntrypoint",
+			proc:   &tetragon.Process{Binary: "/bin/sh", Uid: user},
+			parent: &tetragon.Process{Binary: "/usr/bin/tini", Uid: user},
+		},
+		{
+			name:   "BinaryFromTmp",
+			proc:   &tetragon.Process{Binary: "/tmp/x/miner", Uid: user},
+			parent: &tetragon.Process{Binary: "/bin/sh", Uid: user},
+			want:   []string{"binary-from-tmp"},
+		},
+		{
+			name:   "Setuid",
+			proc:   &tetragon.Process{Binary: "/usr/bin/sudo", Uid: root},
+			parent: &tetragon.Process{Binary: "/bin/bash", Uid: user},
+			want:   []string{"privilege-escalation"},
+		},
+		{
+			name: "SetuidByCredentials",
+			proc: &tetragon.Process{
+				Binary:             "/usr/bin/passwd",
+				Uid:                user,
+				ProcessCredentials: &tetragon.ProcessCredentials{Euid: root},
+			},
+			parent: &tetragon.Process{Binary: "/bin/bash", Uid: user},
+			want:   []string{"privilege-escalation"},
+		},
+		{
+			name:   "RootParent",
+			proc:   &tetragon.Process{Binary: "/usr/bin/id", Uid: root},
+			parent: &tetragon.Process{Binary: "/bin/bash", Uid: root},
+		},
+		{
+			name:   "PackageManager",
+			proc:   &tetragon.Process{Binary: "/usr/bin/apt-get", Arguments: "install curl", Uid: root},
+			parent: &tetragon.Process{Binary: "/bin/bash", Uid: root},
+			want:   []string{"package-manager-in-container"},
+		},
+		{
+			name: "PackageManagerOnStartup",
+			proc: &tetragon.Process{
+				Binary: "/sbin/apk",
+				Uid:    root,
+				Pod: &tetragon.Pod{
+					Namespace: "shop",
+					Name:      "api-1",
+					Container: &tetragon.Container{StartTime: timestamppb.New(now.Add(-time.Second))},
+				},
+			},
+			parent: &tetragon.Process{Binary: "/bin/sh", Uid: root},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, rules(e, execEvent(now, tt.proc, tt.parent)))
+		})
+	}
+}
+
+func TestEngine_Eval(t *testing.T) {
+	now := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	e, err := NewEngine([]Rule{
+		{
+			Name:     "curl-from-java",
+			Severity: SeverityLow,
+			Match: Match{
+				Binary:         []string{"*/curl", "*/wget"},
+				NotBinary:      []string{"/opt/*"},
+				AncestorBinary: []string{"*/java"},
+				Args:           []string{`https?://`},
+				Namespace:      []string{"shop"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	ev := execEvent(now,
+		&tetragon.Process{ExecId: "curl", Binary: "/usr/bin/curl", Arguments: "https://example.com", Uid: wrapperspb.UInt32(1000)},
+		&tetragon.Process{ExecId: "sh", Binary: "/bin/sh", Uid: wrapperspb.UInt32(1000)},
+	)
+	// No java ancestor.
+	require.Empty(t, e.Eval(ev))
+
+	ev.GetProcessExec().Ancestors = []*tetragon.Process{{Binary: "/usr/lib/jvm/bin/java"}}
+	findings := e.Eval(ev)
+	require.Equal(t, []Finding{
+		{
+			Time:         now,
+			Rule:         "curl-from-java",
+			Severity:     SeverityLow,
+			Node:         "node-1",
+			Namespace:    "shop",
+			Pod:          "api-1",
+			Container:    "api",
+			Image:        "registry/api:v1",
+			ExecID:       "curl",
+			Binary:       "/usr/bin/curl",
+			Args:         "https://example.com",
+			UID:          1000,
+			ParentExecID: "sh",
+			ParentBinary: "/bin/sh",
+			ParentUID:    1000,
+		},
+	}, findings)
+
+	ev.GetProcessExec().Process.Arguments = "--version"
+	require.Empty(t, e.Eval(ev))
+
+	// Only exec events are evaluated.
+	require.Empty(t, e.Eval(&tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessExit{ProcessExit: &tetragon.ProcessExit{}},
+	}))
+}
+
+func TestEngine_EvalHost(t *testing.T) {
+	now := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	e, err := NewEngine([]Rule{
+		{Name: "shell", Severity: SeverityLow, Match: Match{Binary: []string{"*/sh"}}},
+		{Name: "host-shell", Severity: SeverityLow, Match: Match{Binary: []string{"*/sh"}, Host: true}},
+	})
+	require.NoError(t, err)
+
+	pod := execEvent(now, &tetragon.Process{Binary: "/bin/sh"}, nil)
+	require.Equal(t, []string{"shell", "host-shell"}, rules(e, pod))
+
+	host := execEvent(now, &tetragon.Process{Binary: "/bin/sh"}, nil)
+	host.GetProcessExec().Process.Pod = nil
+	require.Equal(t, []string{"host-shell"}, rules(e, host))
+}
+
+func TestParseRules(t *testing.T) {
+	rules, err := ParseRules([]byte(`
+rules:
+  - name: tmp
+    severity: high
+    match:
+      binary: ["/tmp/*"]
+      min_container_age: 30s
+`))
+	require.NoError(t, err)
+	require.Equal(t, []Rule{
+		{
+			Name:     "tmp",
+			Severity: SeverityHigh,
+			Match: Match{
+				Binary:          []string{"/tmp/*"},
+				MinContainerAge: Duration(30 * time.Second),
+			},
+		},
+	}, rules)
+
+	for _, tt := range []struct {
+		name string
+		data string
+	}{
+		{"UnknownField", "rules: [{name: a, severity: low, match: {binaries: [a]}}]"},
+		{"NoName", "rules: [{severity: low}]"},
+		{"BadSeverity", "rules: [{name: a, severity: urgent}]"},
+		{"Duplicate", "rules: [{name: a, severity: low}, {name: a, severity: low}]"},
+		{"BadArgs", "rules: [{name: a, severity: low, match: {args: ['(']}}]"},
+		{"BadDuration", "rules: [{name: a, severity: low, match: {min_container_age: 1y}}]"},
+		{"EmptyPattern", "rules: [{name: a, severity: low, match: {binary: ['']}}]"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseRules([]byte(tt.data))
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestGlob(t *testing.T) {
+	re, err := glob([]string{"/tmp/*", "*/php-fpm?"})
+	require.NoError(t, err)
+	for s, want := range map[string]bool{
+		"/tmp/a":              true,
+		"/tmp/a/b":            true,
+		"/tmp":                false,
+		"/var/tmp/a":          false,
+		"/usr/sbin/php-fpm8":  true,
+		"/usr/sbin/php-fpm":   false,
+		"/usr/sbin/php-fpm.8": false,
+	} {
+		require.Equal(t, want, re.MatchString(s), s)
+	}
+
+	re, err = glob(nil)
+	require.NoError(t, err)
+	require.Nil(t, re)
+}
@@ -0,0 +1,98 @@
+package detect
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ClickHouse/ch-go"
+	"github.com/ClickHouse/ch-go/cht"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestTable_ResultColumns(t *testing.T) {
+	d := NewTable("findings")
+	cols := d.ResultColumns()
+	inputs := d.Input()
+	ddl := NewDDL("findings")
+
+	require.Equal(t, len(cols), len(inputs))
+	for i := range cols {
+		require.Equal(t, cols[i], inputs[i].Name)
+		require.True(t, strings.Contains(ddl, cols[i]))
+	}
+}
+
+func testFinding() Finding {
+	return Finding{
+		Time:         time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
+		Rule:         "shell-from-web-server",
+		Severity:     SeverityHigh,
+		Description:  "Shell spawned by web server.",
+		Node:         "node-1",
+		Namespace:    "shop",
+		Pod:          "api-1",
+		Container:    "api",
+		Image:        "registry/api:v1",
+		ExecID:       "sh",
+		Binary:       "/bin/sh",
+		Args:         "-c id",
+		UID:          1000,
+		ParentExecID: "nginx",
+		ParentBinary: "/usr/sbin/nginx",
+		ParentUID:    1000,
+	}
+}
+
+func TestTable(t *testing.T) {
+	d := NewTable("findings")
+	want := testFinding()
+	require.NoError(t, d.Append(want))
+	require.Error(t, d.Append(Finding{Severity: "urgent"}))
+	require.Equal(t, 1, d.Rows())
+	require.NoError(t, d.Each(func(v Finding) error {
+		require.True(t, want.Time.Equal(v.Time))
+		v.Time = want.Time
+		require.Equal(t, want, v)
+		return nil
+	}))
+}
+
+func TestIntegrationClickHouseColumns(t *testing.T) {
+	cht.Skip(t)
+	s := cht.New(t)
+	ctx := context.Background()
+	c, err := ch.Dial(ctx, ch.Options{
+		Address: s.TCP,
+		Logger:  zaptest.NewLogger(t),
+	})
+	require.NoError(t, err)
+	ddl := DDL
+	ddl += "\nTTL toDateTime(timestamp) + INTERVAL 6 HOUR"
+	require.NoError(t, c.Do(ctx, ch.Query{Body: ddl}), "DDL")
+
+	d := NewTable("findings")
+	want := testFinding()
+	want.Time = time.Now().UTC().Truncate(time.Microsecond)
+	require.NoError(t, d.Append(want))
+	require.NoError(t, c.Do(ctx, ch.Query{
+		Body:  d.Insert(),
+		Input: d.Input(),
+	}), "insert")
+
+	d.Reset()
+	require.NoError(t, c.Do(ctx, ch.Query{
+		Body:   fmt.Sprintf(`SELECT %s FROM findings`, strings.Join(d.ResultColumns(), ", ")),
+		Result: d.Result(),
+	}), "select")
+	require.Equal(t, 1, d.Rows())
+	require.NoError(t, d.Each(func(v Finding) error {
+		require.True(t, want.Time.Equal(v.Time))
+		v.Time = want.Time
+		require.Equal(t, want, v)
+		return nil
+	}))
+}
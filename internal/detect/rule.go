@@ -0,0 +1,199 @@
+package detect
+
+import (
+	_ "embed"
+	"encoding/json"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/go-faster/errors"
+	"sigs.k8s.io/yaml"
+)
+
+// Severity of finding.
+type Severity string
+
+// Possible values of Severity, from lowest.
+const (
+	SeverityInfo     Severity = "info"
+	SeverityLow      Severity = "low"
+	SeverityMedium   Severity = "medium"
+	SeverityHigh     Severity = "high"
+	SeverityCritical Severity = "critical"
+)
+
+// Valid reports whether severity is known.
+func (s Severity) Valid() bool {
+	switch s {
+	case SeverityInfo, SeverityLow, SeverityMedium, SeverityHigh, SeverityCritical:
+		return true
+	default:
+		return false
+	}
+}
+
+// Duration is time.Duration that is decoded from string like "1m".
+type Duration time.Duration
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return errors.Wrap(err, "duration should be string")
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return errors.Wrap(err, "parse duration")
+	}
+	*d = Duration(v)
+	return nil
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// Match is condition of rule over process execution.
+//
+// Every set field should match, and list field matches if any of its
+// values matches. Binaries are matched by glob pattern where "*" matches
+// any characters, including "/", and "?" matches single character.
+//
+// Only processes executed in pods are matched, unless Host is set.
+type Match struct {
+	// Host also matches processes executed on node outside of pods.
+	Host bool `json:"host,omitempty"`
+	// Binary of executed process.
+	Binary []string `json:"binary,omitempty"`
+	// NotBinary excludes executed binaries.
+	NotBinary []string `json:"not_binary,omitempty"`
+	// ParentBinary of executed process.
+	ParentBinary []string `json:"parent_binary,omitempty"`
+	// AncestorBinary of executed process, including parent.
+	AncestorBinary []string `json:"ancestor_binary,omitempty"`
+	// Args are regular expressions of process arguments.
+	Args []string `json:"args,omitempty"`
+	// Namespace of pod.
+	Namespace []string `json:"namespace,omitempty"`
+	// UID is effective user id of executed process.
+	UID []uint32 `json:"uid,omitempty"`
+	// UIDChanged matches processes with effective user id that differs
+	// from parent's one, e.g. setuid binaries.
+	UIDChanged bool `json:"uid_changed,omitempty"`
+	// MinContainerAge matches processes executed in container that was
+	// running at least for given duration, so container startup is
+	// not matched.
+	MinContainerAge Duration `json:"min_container_age,omitempty"`
+}
+
+// Rule is declarative detection rule.
+type Rule struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Severity    Severity `json:"severity"`
+	Match       Match    `json:"match"`
+}
+
+// Rules is list of rules, as defined in YAML file.
+type Rules struct {
+	Rules []Rule `json:"rules"`
+}
+
+//go:embed rules.yml
+var defaultRules []byte
+
+// DefaultRules returns built-in rules.
+func DefaultRules() []Rule {
+	rules, err := ParseRules(defaultRules)
+	if err != nil {
+		panic(err)
+	}
+	return rules
+}
+
+// ParseRules parses and validates YAML rules.
+func ParseRules(data []byte) ([]Rule, error) {
+	var v Rules
+	if err := yaml.UnmarshalStrict(data, &v); err != nil {
+		return nil, errors.Wrap(err, "unmarshal")
+	}
+	seen := map[string]struct{}{}
+	for i, r := range v.Rules {
+		if _, err := compileRule(r); err != nil {
+			return nil, errors.Wrapf(err, "rule [%d] %s", i, r.Name)
+		}
+		if _, ok := seen[r.Name]; ok {
+			return nil, errors.Errorf("rule [%d]: duplicate name %q", i, r.Name)
+		}
+		seen[r.Name] = struct{}{}
+	}
+	return v.Rules, nil
+}
+
+// glob compiles glob patterns into single regular expression.
+func glob(patterns []string) (*regexp.Regexp, error) {
+	if len(patterns) == 0 {
+		return nil, nil
+	}
+	var b strings.Builder
+	b.WriteString("^(?:")
+	for i, p := range patterns {
+		if p == "" {
+			return nil, errors.New("empty pattern")
+		}
+		if i > 0 {
+			b.WriteString("|")
+		}
+		p = regexp.QuoteMeta(p)
+		p = strings.ReplaceAll(p, `\*`, `.*`)
+		p = strings.ReplaceAll(p, `\?`, `.`)
+		b.WriteString(p)
+	}
+	b.WriteString(")$")
+	return regexp.Compile(b.String())
+}
+
+// compiled is rule with compiled patterns.
+type compiled struct {
+	Rule
+
+	binary    *regexp.Regexp
+	notBinary *regexp.Regexp
+	parent    *regexp.Regexp
+	ancestor  *regexp.Regexp
+	args      []*regexp.Regexp
+}
+
+func compileRule(r Rule) (*compiled, error) {
+	if r.Name == "" {
+		return nil, errors.New("name is required")
+	}
+	if !r.Severity.Valid() {
+		return nil, errors.Errorf("unknown severity %q", r.Severity)
+	}
+	c := &compiled{Rule: r}
+	for _, v := range []struct {
+		name     string
+		patterns []string
+		to       **regexp.Regexp
+	}{
+		{"binary", r.Match.Binary, &c.binary},
+		{"not_binary", r.Match.NotBinary, &c.notBinary},
+		{"parent_binary", r.Match.ParentBinary, &c.parent},
+		{"ancestor_binary", r.Match.AncestorBinary, &c.ancestor},
+	} {
+		re, err := glob(v.patterns)
+		if err != nil {
+			return nil, errors.Wrap(err, v.name)
+		}
+		*v.to = re
+	}
+	for _, expr := range r.Match.Args {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, errors.Wrap(err, "args")
+		}
+		c.args = append(c.args, re)
+	}
+	return c, nil
+}
@@ -0,0 +1,223 @@
+package detect
+
+import (
+	"fmt"
+
+	"github.com/ClickHouse/ch-go/proto"
+	"github.com/go-faster/errors"
+)
+
+func NewDDL(tableName string) string {
+	// DDL for ClickHouse table.
+	const ddl = `
+CREATE TABLE IF NOT EXISTS %s
+(
+    timestamp   DateTime64(9),
+    -- index for time-based queries
+    INDEX timestamp_idx timestamp TYPE minmax GRANULARITY 1,
+
+    rule        LowCardinality(String),
+    severity    Enum8(
+        'info'     = 1,
+        'low'      = 2,
+        'medium'   = 3,
+        'high'     = 4,
+        'critical' = 5
+    ),
+    description String,
+
+    node_name     LowCardinality(String),
+    k8s_ns        LowCardinality(String),
+    k8s_pod       LowCardinality(String),
+    k8s_container LowCardinality(String),
+    k8s_image     LowCardinality(String),
+
+    process_exec_id        String,
+    process_binary         String,
+    process_args           String,
+    process_uid            UInt32,
+    parent_process_exec_id String,
+    parent_process_binary  String,
+    parent_process_uid     UInt32
+)
+    ENGINE = MergeTree()
+        PARTITION BY toYearWeek(timestamp)
+        ORDER BY (k8s_ns, k8s_pod, timestamp)
+`
+	return fmt.Sprintf(ddl, tableName)
+}
+
+// DDL for ClickHouse table.
+var DDL = NewDDL("findings")
+
+type Column struct {
+	Name string
+	Data proto.Column
+}
+
+// Table is wrapper for ClickHouse columns that simplifies data ingestion.
+type Table struct {
+	name string
+
+	timestamp   proto.ColDateTime64
+	rule        proto.ColLowCardinality[string]
+	severity    proto.ColEnum
+	description proto.ColStr
+
+	node      proto.ColLowCardinality[string]
+	namespace proto.ColLowCardinality[string]
+	pod       proto.ColLowCardinality[string]
+	container proto.ColLowCardinality[string]
+	image     proto.ColLowCardinality[string]
+
+	execID       proto.ColStr
+	binary       proto.ColStr
+	args         proto.ColStr
+	uid          proto.ColUInt32
+	parentExecID proto.ColStr
+	parentBinary proto.ColStr
+	parentUID    proto.ColUInt32
+}
+
+func (t *Table) Reset() {
+	for _, v := range t.Columns() {
+		v.Data.Reset()
+	}
+}
+
+func (t *Table) Rows() int {
+	return t.timestamp.Rows()
+}
+
+func (t *Table) Insert() string {
+	return t.Input().Into(t.name)
+}
+
+func (t *Table) Result() proto.Results {
+	var out proto.Results
+	for _, v := range t.Columns() {
+		out = append(out, proto.ResultColumn{
+			Name: v.Name,
+			Data: v.Data,
+		})
+	}
+	return out
+}
+
+func (t *Table) ResultColumns() []string {
+	var columns []string
+	for _, v := range t.Result() {
+		columns = append(columns, v.Name)
+	}
+	return columns
+}
+
+func (t *Table) Columns() []Column {
+	return []Column{
+		{Name: "timestamp", Data: &t.timestamp},
+		{Name: "rule", Data: &t.rule},
+		{Name: "severity", Data: &t.severity},
+		{Name: "description", Data: &t.description},
+
+		{Name: "node_name", Data: &t.node},
+		{Name: "k8s_ns", Data: &t.namespace},
+		{Name: "k8s_pod", Data: &t.pod},
+		{Name: "k8s_container", Data: &t.container},
+		{Name: "k8s_image", Data: &t.image},
+
+		{Name: "process_exec_id", Data: &t.execID},
+		{Name: "process_binary", Data: &t.binary},
+		{Name: "process_args", Data: &t.args},
+		{Name: "process_uid", Data: &t.uid},
+		{Name: "parent_process_exec_id", Data: &t.parentExecID},
+		{Name: "parent_process_binary", Data: &t.parentBinary},
+		{Name: "parent_process_uid", Data: &t.parentUID},
+	}
+}
+
+func (t *Table) Input() proto.Input {
+	var out proto.Input
+	for _, v := range t.Columns() {
+		out = append(out, proto.InputColumn{
+			Name: v.Name,
+			Data: v.Data,
+		})
+	}
+	return out
+}
+
+// Each calls f for every row.
+func (t *Table) Each(f func(v Finding) error) error {
+	for i := 0; i < t.Rows(); i++ {
+		v := Finding{
+			Time:        t.timestamp.Row(i),
+			Rule:        t.rule.Row(i),
+			Severity:    Severity(t.severity.Row(i)),
+			Description: t.description.Row(i),
+
+			Node:      t.node.Row(i),
+			Namespace: t.namespace.Row(i),
+			Pod:       t.pod.Row(i),
+			Container: t.container.Row(i),
+			Image:     t.image.Row(i),
+
+			ExecID:       t.execID.Row(i),
+			Binary:       t.binary.Row(i),
+			Args:         t.args.Row(i),
+			UID:          t.uid.Row(i),
+			ParentExecID: t.parentExecID.Row(i),
+			ParentBinary: t.parentBinary.Row(i),
+			ParentUID:    t.parentUID.Row(i),
+		}
+		if err := f(v); err != nil {
+			return errors.Wrapf(err, "[%d]", i)
+		}
+	}
+	return nil
+}
+
+// Append appends finding.
+func (t *Table) Append(v Finding) error {
+	if !v.Severity.Valid() {
+		return errors.Errorf("unknown severity %q", v.Severity)
+	}
+	t.rule.Append(v.Rule)
+	t.severity.Append(string(v.Severity))
+	t.description.Append(v.Description)
+
+	t.node.Append(v.Node)
+	t.namespace.Append(v.Namespace)
+	t.pod.Append(v.Pod)
+	t.container.Append(v.Container)
+	t.image.Append(v.Image)
+
+	t.execID.Append(v.ExecID)
+	t.binary.Append(v.Binary)
+	t.args.Append(v.Args)
+	t.uid.Append(v.UID)
+	t.parentExecID.Append(v.ParentExecID)
+	t.parentBinary.Append(v.ParentBinary)
+	t.parentUID.Append(v.ParentUID)
+
+	t.timestamp.Append(v.Time)
+
+	return nil
+}
+
+func newStrLowCardinality() proto.ColLowCardinality[string] {
+	return *proto.NewLowCardinality[string](&proto.ColStr{})
+}
+
+func NewTable(name string) *Table {
+	t := &Table{
+		name:      name,
+		rule:      newStrLowCardinality(),
+		node:      newStrLowCardinality(),
+		namespace: newStrLowCardinality(),
+		pod:       newStrLowCardinality(),
+		container: newStrLowCardinality(),
+		image:     newStrLowCardinality(),
+	}
+	t.timestamp.WithPrecision(9)
+	return t
+}
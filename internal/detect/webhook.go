@@ -0,0 +1,64 @@
+package detect
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/go-faster/errors"
+)
+
+// WebhookPayload is JSON body of webhook request.
+type WebhookPayload struct {
+	Findings []Finding `json:"findings"`
+}
+
+// Webhook sends findings to HTTP endpoint as JSON.
+type Webhook struct {
+	url  string
+	http *http.Client
+}
+
+// NewWebhook initializes new Webhook for given URL.
+//
+// If client is nil, http.DefaultClient is used.
+func NewWebhook(webhookURL string, client *http.Client) *Webhook {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Webhook{
+		url:  webhookURL,
+		http: client,
+	}
+}
+
+// Send posts findings in single request, non-2xx response is an error.
+func (w *Webhook) Send(ctx context.Context, findings []Finding) error {
+	if len(findings) == 0 {
+		return nil
+	}
+	data, err := json.Marshal(WebhookPayload{Findings: findings})
+	if err != nil {
+		return errors.Wrap(err, "marshal")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(data))
+	if err != nil {
+		return errors.Wrap(err, "new request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := w.http.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "do")
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		data, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return errors.Errorf("%s: %s", res.Status, strings.TrimSpace(string(data)))
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package detect
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhook(t *testing.T) {
+	var got WebhookPayload
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	t.Cleanup(srv.Close)
+
+	ctx := context.Background()
+	findings := []Finding{
+		{
+			Time:      time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
+			Rule:      "binary-from-tmp",
+			Severity:  SeverityHigh,
+			Namespace: "shop",
+			Pod:       "api-1",
+			Binary:    "/tmp/miner",
+		},
+	}
+	w := NewWebhook(srv.URL, srv.Client())
+	require.NoError(t, w.Send(ctx, findings))
+	require.Equal(t, findings, got.Findings)
+
+	// Nothing to send.
+	require.NoError(t, NewWebhook("http://localhost:0", nil).Send(ctx, nil))
+}
+
+func TestWebhook_Error(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "bad payload", http.StatusBadRequest)
+	}))
+	t.Cleanup(srv.Close)
+
+	err := NewWebhook(srv.URL, srv.Client()).Send(context.Background(), []Finding{{Rule: "a"}})
+	require.ErrorContains(t, err, "400 Bad Request: bad payload")
+}
@@ -0,0 +1,228 @@
+package flow
+
+import (
+	"strings"
+	"time"
+
+	"github.com/cilium/cilium/api/v1/flow"
+	"github.com/cilium/cilium/api/v1/observer"
+)
+
+// Cilium monitor event types, see github.com/cilium/cilium/pkg/monitor/api.
+const (
+	eventTypeDrop  = 1
+	eventTypeTrace = 4
+)
+
+// packetCount returns 1 for per-packet events, like trace and drop, and 0
+// for other events, like policy verdict that is reported per connection.
+func packetCount(f *observer.Flow) uint32 {
+	switch f.GetEventType().GetType() {
+	case eventTypeDrop, eventTypeTrace:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// aggregateKey identifies flows that are folded into single row.
+type aggregateKey struct {
+	src, dst         string
+	protocol         string
+	srcPort, dstPort uint32
+	icmpType         uint32
+	icmpCode         uint32
+
+	verdict          flow.Verdict
+	dropReason       flow.DropReason
+	trafficDirection flow.TrafficDirection
+	observationPoint flow.TraceObservationPoint
+	eventType        int32
+	eventSubType     int32
+	isReply          string
+	node             string
+	policyMatchType  uint32
+	policyDeniedBy   string
+}
+
+func newAggregateKey(f *observer.Flow) aggregateKey {
+	k := aggregateKey{
+		src:              f.GetIP().GetSource(),
+		dst:              f.GetIP().GetDestination(),
+		verdict:          f.GetVerdict(),
+		dropReason:       f.GetDropReasonDesc(),
+		trafficDirection: f.GetTrafficDirection(),
+		observationPoint: f.GetTraceObservationPoint(),
+		eventType:        f.GetEventType().GetType(),
+		eventSubType:     f.GetEventType().GetSubType(),
+		node:             f.GetNodeName(),
+		policyMatchType:  f.GetPolicyMatchType(),
+	}
+	if v := f.GetIsReply(); v != nil {
+		k.isReply = v.String()
+	}
+	var denied []string
+	for _, p := range f.GetEgressDeniedBy() {
+		denied = append(denied, PolicyName(p))
+	}
+	for _, p := range f.GetIngressDeniedBy() {
+		denied = append(denied, PolicyName(p))
+	}
+	k.policyDeniedBy = strings.Join(denied, ",")
+
+	l4 := f.GetL4()
+	switch {
+	case l4.GetTCP() != nil:
+		k.protocol = "TCP"
+		k.srcPort, k.dstPort = l4.GetTCP().GetSourcePort(), l4.GetTCP().GetDestinationPort()
+	case l4.GetUDP() != nil:
+		k.protocol = "UDP"
+		k.srcPort, k.dstPort = l4.GetUDP().GetSourcePort(), l4.GetUDP().GetDestinationPort()
+	case l4.GetSCTP() != nil:
+		k.protocol = "SCTP"
+		k.srcPort, k.dstPort = l4.GetSCTP().GetSourcePort(), l4.GetSCTP().GetDestinationPort()
+	case l4.GetICMPv4() != nil:
+		k.protocol = "ICMPv4"
+		k.icmpType, k.icmpCode = l4.GetICMPv4().GetType(), l4.GetICMPv4().GetCode()
+	case l4.GetICMPv6() != nil:
+		k.protocol = "ICMPv6"
+		k.icmpType, k.icmpCode = l4.GetICMPv6().GetType(), l4.GetICMPv6().GetCode()
+	}
+	return k
+}
+
+// Aggregate is group of flows folded into single row.
+type Aggregate struct {
+	// Flow is the first flow of group, with TCP flags of all flows.
+	Flow *observer.Flow
+	// Flows is count of flows in group.
+	Flows uint32
+	// Packets is count of per-packet events in group.
+	Packets uint32
+	// Last is timestamp of the last flow.
+	Last time.Time
+
+	key     aggregateKey
+	created time.Time
+}
+
+// Row returns table row of aggregate.
+func (g *Aggregate) Row(index, peer Peer, inverse bool) Row {
+	return Row{
+		Raw:         g.Flow,
+		Index:       index,
+		Peer:        peer,
+		Inverse:     inverse,
+		FlowCount:   g.Flows,
+		PacketCount: g.Packets,
+		LastSeen:    g.Last,
+	}
+}
+
+func mergeTCPFlags(dst, src *flow.TCPFlags) {
+	dst.FIN = dst.FIN || src.GetFIN()
+	dst.SYN = dst.SYN || src.GetSYN()
+	dst.RST = dst.RST || src.GetRST()
+	dst.PSH = dst.PSH || src.GetPSH()
+	dst.ACK = dst.ACK || src.GetACK()
+	dst.URG = dst.URG || src.GetURG()
+	dst.ECE = dst.ECE || src.GetECE()
+	dst.CWR = dst.CWR || src.GetCWR()
+	dst.NS = dst.NS || src.GetNS()
+}
+
+func (g *Aggregate) add(f *observer.Flow) {
+	g.Flows++
+	g.Packets += packetCount(f)
+	if ts := f.GetTime().AsTime(); ts.After(g.Last) {
+		g.Last = ts
+	}
+	flags := f.GetL4().GetTCP().GetFlags()
+	if flags == nil {
+		return
+	}
+	tcp := g.Flow.GetL4().GetTCP()
+	if tcp.Flags == nil {
+		tcp.Flags = &flow.TCPFlags{}
+	}
+	mergeTCPFlags(tcp.Flags, flags)
+}
+
+// Aggregator folds L3/L4 flows with the same 5-tuple, verdict and direction
+// seen within window into single row with flow and packet counts.
+//
+// Aggregator is not safe for concurrent use.
+type Aggregator struct {
+	window    time.Duration
+	maxGroups int
+	groups    map[aggregateKey]*Aggregate
+	pending   []*Aggregate // in order of creation
+}
+
+// DefaultMaxGroups limits number of groups buffered by Aggregator.
+const DefaultMaxGroups = 100_000
+
+// NewAggregator creates Aggregator with window and limit of buffered groups.
+func NewAggregator(window time.Duration, maxGroups int) *Aggregator {
+	if maxGroups <= 0 {
+		maxGroups = DefaultMaxGroups
+	}
+	return &Aggregator{
+		window:    window,
+		maxGroups: maxGroups,
+		groups:    map[aggregateKey]*Aggregate{},
+	}
+}
+
+// Len returns number of buffered groups.
+func (a *Aggregator) Len() int {
+	return len(a.pending)
+}
+
+// Add folds f into group, taking ownership of f.
+//
+// Returns false if f can not be aggregated, like L7 flow, or too many groups
+// are buffered, so f should be stored as is.
+func (a *Aggregator) Add(now time.Time, f *observer.Flow) bool {
+	if f.GetType() != flow.FlowType_L3_L4 || f.GetL7() != nil || f.GetIP() == nil {
+		return false
+	}
+	key := newAggregateKey(f)
+	if g, ok := a.groups[key]; ok {
+		g.add(f)
+		return true
+	}
+	if len(a.pending) >= a.maxGroups {
+		return false
+	}
+	g := &Aggregate{
+		Flow:    f,
+		Flows:   1,
+		Packets: packetCount(f),
+		Last:    f.GetTime().AsTime(),
+		key:     key,
+		created: now,
+	}
+	a.groups[key] = g
+	a.pending = append(a.pending, g)
+	return true
+}
+
+// Flush calls fn for groups created at least window before now and
+// removes them.
+func (a *Aggregator) Flush(now time.Time, fn func(g *Aggregate) error) error {
+	var n int
+	for _, g := range a.pending {
+		if now.Sub(g.created) < a.window {
+			break
+		}
+		n++
+		delete(a.groups, g.key)
+		if err := fn(g); err != nil {
+			a.pending = a.pending[n:]
+			return err
+		}
+	}
+	a.pending = a.pending[n:]
+	return nil
+}
@@ -0,0 +1,127 @@
+package flow
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cilium/cilium/api/v1/observer"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestAggregator(t *testing.T) {
+	start := time.Unix(100, 0)
+	newFlow := func(offset time.Duration, srcPort uint32, flags *observer.TCPFlags) *observer.Flow {
+		return &observer.Flow{
+			Time:      timestamppb.New(start.Add(offset)),
+			Type:      observer.FlowType_L3_L4,
+			Verdict:   observer.Verdict_FORWARDED,
+			EventType: &observer.CiliumEventType{Type: eventTypeTrace},
+			IP: &observer.IP{
+				Source:      "10.0.0.1",
+				Destination: "10.0.0.2",
+				IpVersion:   observer.IPVersion_IPv4,
+			},
+			L4: &observer.Layer4{
+				Protocol: &observer.Layer4_TCP{
+					TCP: &observer.TCP{
+						SourcePort:      srcPort,
+						DestinationPort: 80,
+						Flags:           flags,
+					},
+				},
+			},
+		}
+	}
+
+	a := NewAggregator(time.Second, 0)
+	require.True(t, a.Add(start, newFlow(0, 1000, &observer.TCPFlags{SYN: true})))
+	require.True(t, a.Add(start, newFlow(10*time.Millisecond, 1000, &observer.TCPFlags{ACK: true})))
+	require.True(t, a.Add(start, newFlow(20*time.Millisecond, 1001, nil)))
+	require.True(t, a.Add(start.Add(500*time.Millisecond), newFlow(500*time.Millisecond, 1000, &observer.TCPFlags{ACK: true, FIN: true})))
+
+	dropped := newFlow(600*time.Millisecond, 1000, nil)
+	dropped.Verdict = observer.Verdict_DROPPED
+	dropped.EventType = &observer.CiliumEventType{Type: eventTypeDrop}
+	require.True(t, a.Add(start.Add(600*time.Millisecond), dropped))
+
+	l7 := newFlow(0, 1000, nil)
+	l7.L7 = &observer.Layer7{}
+	require.False(t, a.Add(start, l7), "L7 flows are not aggregated")
+	require.Equal(t, 3, a.Len())
+
+	var groups []*Aggregate
+	collect := func(g *Aggregate) error {
+		groups = append(groups, g)
+		return nil
+	}
+	require.NoError(t, a.Flush(start.Add(999*time.Millisecond), collect))
+	require.Empty(t, groups, "window is not passed")
+
+	require.NoError(t, a.Flush(start.Add(time.Second), collect))
+	require.Len(t, groups, 2)
+	require.Equal(t, 1, a.Len())
+
+	g := groups[0]
+	require.Equal(t, uint32(3), g.Flows)
+	require.Equal(t, uint32(3), g.Packets)
+	require.True(t, start.Add(500*time.Millisecond).Equal(g.Last))
+	require.Equal(t, &observer.TCPFlags{SYN: true, ACK: true, FIN: true}, g.Flow.GetL4().GetTCP().GetFlags())
+	require.Equal(t, uint32(1), groups[1].Flows)
+	require.Equal(t, uint32(1001), groups[1].Flow.GetL4().GetTCP().GetSourcePort())
+
+	// Flushed group is started again.
+	require.True(t, a.Add(start.Add(time.Second), newFlow(time.Second, 1000, nil)))
+	require.Equal(t, 2, a.Len())
+
+	groups = groups[:0]
+	require.NoError(t, a.Flush(start.Add(2*time.Second), collect))
+	require.Len(t, groups, 2)
+	require.Equal(t, observer.Verdict_DROPPED, groups[0].Flow.GetVerdict())
+	require.Equal(t, uint32(1), groups[0].Packets)
+	require.Zero(t, a.Len())
+}
+
+func TestAggregator_MaxGroups(t *testing.T) {
+	a := NewAggregator(time.Second, 1)
+	newFlow := func(src string) *observer.Flow {
+		return &observer.Flow{
+			Time: timestamppb.Now(),
+			Type: observer.FlowType_L3_L4,
+			IP:   &observer.IP{Source: src, Destination: "10.0.0.2"},
+		}
+	}
+	now := time.Now()
+	require.True(t, a.Add(now, newFlow("10.0.0.1")))
+	require.True(t, a.Add(now, newFlow("10.0.0.1")))
+	require.False(t, a.Add(now, newFlow("10.0.0.3")))
+}
+
+func TestTable_Aggregate(t *testing.T) {
+	last := time.Unix(200, 0).UTC()
+	g := &Aggregate{
+		Flow: &observer.Flow{
+			Time: timestamppb.New(time.Unix(100, 0)),
+			Type: observer.FlowType_L3_L4,
+		},
+		Flows:   10,
+		Packets: 8,
+		Last:    last,
+	}
+	d := NewTable("flows")
+	require.NoError(t, d.Append(g.Row(Peer{}, Peer{}, false)))
+	require.NoError(t, d.Append(Row{Raw: g.Flow}))
+	var rows []Row
+	require.NoError(t, d.Each(func(r Row) error {
+		rows = append(rows, r)
+		return nil
+	}))
+	require.Len(t, rows, 2)
+	require.Equal(t, uint32(10), rows[0].FlowCount)
+	require.Equal(t, uint32(8), rows[0].PacketCount)
+	require.True(t, last.Equal(rows[0].LastSeen))
+
+	require.Equal(t, uint32(1), rows[1].FlowCount)
+	require.Equal(t, uint32(0), rows[1].PacketCount, "no event type")
+	require.True(t, g.Flow.GetTime().AsTime().Equal(rows[1].LastSeen))
+}
@@ -5,14 +5,18 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ClickHouse/ch-go"
 	"github.com/ClickHouse/ch-go/cht"
+	"github.com/ClickHouse/ch-go/proto"
 	"github.com/cilium/cilium/api/v1/observer"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/go-faster/vega/internal/chtest"
 )
 
 func TestTable_ResultColumns(t *testing.T) {
@@ -28,6 +32,35 @@ func TestTable_ResultColumns(t *testing.T) {
 	}
 }
 
+func TestNewMigrations(t *testing.T) {
+	chtest.RequireMigrations(t, NewDDL("flows"), NewMigrations("flows"))
+}
+
+func TestTable_Kafka(t *testing.T) {
+	kafka := &observer.Kafka{
+		ErrorCode:     -1,
+		ApiVersion:    7,
+		ApiKey:        "produce",
+		CorrelationId: 42,
+		Topic:         "orders",
+	}
+	d := NewTable("flows")
+	require.NoError(t, d.Append(Row{
+		Raw: &observer.Flow{
+			Time: timestamppb.Now(),
+			L7: &observer.Layer7{
+				Type:   observer.L7FlowType_RESPONSE,
+				Record: &observer.Layer7_Kafka{Kafka: kafka},
+			},
+		},
+	}))
+	require.Equal(t, 1, d.Rows())
+	require.NoError(t, d.Each(func(r Row) error {
+		require.Equal(t, kafka.String(), r.Raw.GetL7().GetKafka().String())
+		return nil
+	}))
+}
+
 func TestIntegrationClickHouseColumns(t *testing.T) {
 	cht.Skip(t)
 	s := cht.New(t)
@@ -181,3 +214,88 @@ func TestIntegrationClickHouseColumns(t *testing.T) {
 		return nil
 	})
 }
+
+func TestQuote(t *testing.T) {
+	require.Equal(t, `'a\\b\'c'`, Quote(`a\b'c`))
+}
+
+func TestIndexLabelCondition(t *testing.T) {
+	require.Equal(t,
+		`k8s_ns = 'shop' AND has(if(direction = 'INVERSE', endpoint_dst_labels, endpoint_src_labels), 'k8s:app=it\'s')`,
+		IndexLabelCondition("shop", "k8s:app=it's"),
+	)
+}
+
+func TestIntegrationIndexLabelCondition(t *testing.T) {
+	cht.Skip(t)
+	s := cht.New(t)
+	ctx := context.Background()
+	c, err := ch.Dial(ctx, ch.Options{
+		Address: s.TCP,
+		Logger:  zaptest.NewLogger(t),
+	})
+	require.NoError(t, err)
+	require.NoError(t, c.Do(ctx, ch.Query{Body: DDL}), "DDL")
+
+	var (
+		now      = time.Now()
+		baseline = now.Add(-2 * time.Hour)
+		app      = &observer.Endpoint{Namespace: "shop", Labels: []string{"k8s:vega.app=api"}}
+		other    = &observer.Endpoint{Namespace: "shop", Labels: []string{"k8s:vega.app=web"}}
+		d        = NewTable("flows")
+	)
+	for _, r := range []struct {
+		pod     string
+		inverse bool
+		src     *observer.Endpoint
+		dst     *observer.Endpoint
+		at      time.Time
+	}{
+		// Pod of previous rollout.
+		{pod: "api-7d9f-old", src: app, dst: other, at: baseline},
+		{pod: "api-5c6b-new", src: app, dst: other, at: now},
+		{pod: "api-5c6b-new", src: other, dst: app, at: now, inverse: true},
+		{pod: "web-1", src: other, dst: app, at: now},
+	} {
+		require.NoError(t, d.Append(Row{
+			Index:   Peer{Kubernetes: RowKubernetes{Namespace: "shop", Pod: r.pod}},
+			Inverse: r.inverse,
+			Raw: &observer.Flow{
+				Source:      r.src,
+				Destination: r.dst,
+				Time:        timestamppb.New(r.at),
+			},
+		}))
+	}
+	require.NoError(t, c.Do(ctx, ch.Query{
+		Body:  d.Insert(),
+		Input: d.Input(),
+	}), "insert")
+
+	pods := func(start, end time.Time) []string {
+		var (
+			pod = new(proto.ColStr).LowCardinality()
+			out []string
+		)
+		require.NoError(t, c.Do(ctx, ch.Query{
+			Body: fmt.Sprintf(`SELECT k8s_pod FROM flows WHERE %s
+    AND timestamp >= fromUnixTimestamp64Nano(toInt64(%d))
+    AND timestamp < fromUnixTimestamp64Nano(toInt64(%d))
+ORDER BY k8s_pod`,
+				IndexLabelCondition("shop", "k8s:vega.app=api"),
+				start.UnixNano(), end.UnixNano(),
+			),
+			Result: proto.Results{{Name: "k8s_pod", Data: pod}},
+			OnResult: func(ctx context.Context, block proto.Block) error {
+				for i := 0; i < pod.Rows(); i++ {
+					out = append(out, pod.Row(i))
+				}
+				return nil
+			},
+		}), "select")
+		return out
+	}
+	// Windows have different pod names.
+	require.Equal(t, []string{"api-7d9f-old"}, pods(baseline.Add(-time.Minute), baseline.Add(time.Minute)))
+	require.Equal(t, []string{"api-5c6b-new", "api-5c6b-new"}, pods(now.Add(-time.Minute), now.Add(time.Minute)))
+}
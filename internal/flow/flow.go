@@ -4,6 +4,9 @@ package flow
 import (
 	"fmt"
 	"net/netip"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/ClickHouse/ch-go/proto"
 	"github.com/cilium/cilium/api/v1/flow"
@@ -19,6 +22,10 @@ func NewDDL(tableName string) string {
 CREATE TABLE IF NOT EXISTS %s
 (
     timestamp                 DateTime64(9),
+    -- last timestamp and counts of flows folded into row, see Aggregator
+    timestamp_last            DateTime64(9) DEFAULT timestamp,
+    flow_count                UInt32 DEFAULT 1,
+    packet_count              UInt32 DEFAULT 1,
 
     -- index for time-based queries
     INDEX timestamp_idx timestamp TYPE minmax GRANULARITY 1,
@@ -99,6 +106,8 @@ CREATE TABLE IF NOT EXISTS %s
     ) DEFAULT 'TRAFFIC_DIRECTION_UNKNOWN',
 
     policy_match_type UInt32,
+    -- policies that denied the flow as namespace/name, name for cluster-wide
+    policy_denied_by Array(LowCardinality(String)),
 
     trace_observation_point Enum8(
         'UNKNOWN_POINT' = 0,
@@ -263,6 +272,27 @@ CREATE TABLE IF NOT EXISTS %s
 // DDL for ClickHouse table.
 var DDL = NewDDL("flows")
 
+// NewMigrations returns statements that add columns introduced after
+// table creation, so tables created by previous versions can be
+// written to. Statements are idempotent.
+func NewMigrations(tableName string) []string {
+	return []string{
+		fmt.Sprintf("ALTER TABLE %s ADD COLUMN IF NOT EXISTS policy_denied_by Array(LowCardinality(String)) AFTER policy_match_type", tableName),
+		fmt.Sprintf("ALTER TABLE %s ADD COLUMN IF NOT EXISTS timestamp_last DateTime64(9) DEFAULT timestamp AFTER timestamp", tableName),
+		fmt.Sprintf("ALTER TABLE %s ADD COLUMN IF NOT EXISTS flow_count UInt32 DEFAULT 1 AFTER timestamp_last", tableName),
+		fmt.Sprintf("ALTER TABLE %s ADD COLUMN IF NOT EXISTS packet_count UInt32 DEFAULT 1 AFTER flow_count", tableName),
+	}
+}
+
+// PolicyName returns policy name as namespace/name, or name for
+// cluster-wide policies.
+func PolicyName(p *flow.Policy) string {
+	if ns := p.GetNamespace(); ns != "" {
+		return ns + "/" + p.GetName()
+	}
+	return p.GetName()
+}
+
 // Table is wrapper for ClickHouse columns that simplifies data ingestion.
 type Table struct {
 	name       string
@@ -296,6 +326,7 @@ type Table struct {
 
 	trafficDirection      proto.ColEnum
 	policyMatchType       proto.ColUInt32
+	policyDeniedBy        proto.ColArr[string]
 	traceObservationPoint proto.ColEnum
 
 	interfaceIndex proto.ColUInt32
@@ -347,6 +378,12 @@ type Table struct {
 	l7HTTPHeadersKeys   proto.ColArr[string]
 	l7HTTPHeadersValues proto.ColArr[string]
 
+	l7KafkaErrorCode     proto.ColUInt32
+	l7KafkaAPIVersion    proto.ColUInt32
+	l7KafkaAPIKey        proto.ColStr
+	l7KafkaCorrelationID proto.ColInt32
+	l7KafkaTopic         proto.ColStr
+
 	direction proto.ColEnum
 
 	vegaEnv     proto.ColEnum
@@ -377,6 +414,10 @@ type Table struct {
 	k8sPeerPod proto.ColLowCardinality[string]
 	k8sPeerNS  proto.ColLowCardinality[string]
 
+	timestampLast proto.ColDateTime64
+	flowCount     proto.ColUInt32
+	packetCount   proto.ColUInt32
+
 	timestamp proto.ColDateTime64
 }
 
@@ -412,6 +453,7 @@ func (t *Table) Reset() {
 
 		&t.trafficDirection,
 		&t.policyMatchType,
+		&t.policyDeniedBy,
 		&t.traceObservationPoint,
 
 		&t.interfaceIndex,
@@ -462,6 +504,12 @@ func (t *Table) Reset() {
 		&t.l7HTTPHeadersKeys,
 		&t.l7HTTPHeadersValues,
 
+		&t.l7KafkaErrorCode,
+		&t.l7KafkaAPIVersion,
+		&t.l7KafkaAPIKey,
+		&t.l7KafkaCorrelationID,
+		&t.l7KafkaTopic,
+
 		&t.direction,
 
 		&t.vegaEnv,
@@ -489,6 +537,9 @@ func (t *Table) Reset() {
 		&t.vegaPeerHost,
 		&t.k8sPeerPod,
 		&t.k8sPeerNS,
+		&t.timestampLast,
+		&t.flowCount,
+		&t.packetCount,
 		&t.timestamp,
 	} {
 		v.Reset()
@@ -535,6 +586,7 @@ func (t *Table) Result() proto.Results {
 
 		{Name: "traffic_direction", Data: &t.trafficDirection},
 		{Name: "policy_match_type", Data: &t.policyMatchType},
+		{Name: "policy_denied_by", Data: &t.policyDeniedBy},
 		{Name: "trace_observation_point", Data: &t.traceObservationPoint},
 
 		{Name: "interface_index", Data: &t.interfaceIndex},
@@ -581,6 +633,12 @@ func (t *Table) Result() proto.Results {
 		{Name: "l7_http_headers_keys", Data: &t.l7HTTPHeadersKeys},
 		{Name: "l7_http_headers_values", Data: &t.l7HTTPHeadersValues},
 
+		{Name: "l7_kafka_error_code", Data: &t.l7KafkaErrorCode},
+		{Name: "l7_kafka_api_version", Data: &t.l7KafkaAPIVersion},
+		{Name: "l7_kafka_api_key", Data: &t.l7KafkaAPIKey},
+		{Name: "l7_kafka_correlation_id", Data: &t.l7KafkaCorrelationID},
+		{Name: "l7_kafka_topic", Data: &t.l7KafkaTopic},
+
 		{Name: "direction", Data: &t.direction},
 
 		{Name: "k8s_pod", Data: &t.k8sPod},
@@ -589,6 +647,10 @@ func (t *Table) Result() proto.Results {
 		{Name: "k8s_peer_pod", Data: &t.k8sPeerPod},
 		{Name: "k8s_peer_ns", Data: &t.k8sPeerNS},
 
+		{Name: "timestamp_last", Data: &t.timestampLast},
+		{Name: "flow_count", Data: &t.flowCount},
+		{Name: "packet_count", Data: &t.packetCount},
+
 		{Name: "timestamp", Data: &t.timestamp},
 	}
 }
@@ -634,6 +696,7 @@ func (t *Table) Input() proto.Input {
 
 		{Name: "traffic_direction", Data: &t.trafficDirection},
 		{Name: "policy_match_type", Data: &t.policyMatchType},
+		{Name: "policy_denied_by", Data: &t.policyDeniedBy},
 		{Name: "trace_observation_point", Data: &t.traceObservationPoint},
 
 		{Name: "interface_index", Data: &t.interfaceIndex},
@@ -680,6 +743,12 @@ func (t *Table) Input() proto.Input {
 		{Name: "l7_http_headers_keys", Data: &t.l7HTTPHeadersKeys},
 		{Name: "l7_http_headers_values", Data: &t.l7HTTPHeadersValues},
 
+		{Name: "l7_kafka_error_code", Data: &t.l7KafkaErrorCode},
+		{Name: "l7_kafka_api_version", Data: &t.l7KafkaAPIVersion},
+		{Name: "l7_kafka_api_key", Data: &t.l7KafkaAPIKey},
+		{Name: "l7_kafka_correlation_id", Data: &t.l7KafkaCorrelationID},
+		{Name: "l7_kafka_topic", Data: &t.l7KafkaTopic},
+
 		{Name: "direction", Data: &t.direction},
 
 		{Name: "k8s_pod", Data: &t.k8sPod},
@@ -688,6 +757,10 @@ func (t *Table) Input() proto.Input {
 		{Name: "k8s_peer_pod", Data: &t.k8sPeerPod},
 		{Name: "k8s_peer_ns", Data: &t.k8sPeerNS},
 
+		{Name: "timestamp_last", Data: &t.timestampLast},
+		{Name: "flow_count", Data: &t.flowCount},
+		{Name: "packet_count", Data: &t.packetCount},
+
 		{Name: "timestamp", Data: &t.timestamp},
 	}
 }
@@ -719,6 +792,17 @@ func (t *Table) Each(fn func(row Row) error) error {
 
 			Time: timestamppb.New(t.timestamp.Row(i)),
 		}
+		for _, name := range t.policyDeniedBy.Row(i) {
+			p := &flow.Policy{Name: name}
+			if ns, n, ok := strings.Cut(name, "/"); ok {
+				p.Namespace, p.Name = ns, n
+			}
+			if f.TrafficDirection == flow.TrafficDirection_INGRESS {
+				f.IngressDeniedBy = append(f.IngressDeniedBy, p)
+			} else {
+				f.EgressDeniedBy = append(f.EgressDeniedBy, p)
+			}
+		}
 		if v := t.isReply.Row(i); v.Set {
 			f.IsReply = wrapperspb.Bool(v.Value)
 		} else {
@@ -917,6 +1001,16 @@ func (t *Table) Each(fn func(row Row) error) error {
 						Qtypes:            t.l7DNSQTypes.Row(i),
 					},
 				}
+			case "Kafka":
+				f.L7.Record = &observer.Layer7_Kafka{
+					Kafka: &observer.Kafka{
+						ErrorCode:     int32(t.l7KafkaErrorCode.Row(i)),  //#nosec G115
+						ApiVersion:    int32(t.l7KafkaAPIVersion.Row(i)), //#nosec G115
+						ApiKey:        t.l7KafkaAPIKey.Row(i),
+						CorrelationId: t.l7KafkaCorrelationID.Row(i),
+						Topic:         t.l7KafkaTopic.Row(i),
+					},
+				}
 			}
 		}
 
@@ -935,6 +1029,9 @@ func (t *Table) Each(fn func(row Row) error) error {
 					Namespace: t.k8sPeerNS.Row(i),
 				},
 			},
+			FlowCount:   t.flowCount.Row(i),
+			PacketCount: t.packetCount.Row(i),
+			LastSeen:    t.timestampLast.Row(i),
 		}
 		if err := fn(row); err != nil {
 			return errors.Wrapf(err, "[%d]", i)
@@ -978,6 +1075,11 @@ func (t *Table) Append(row Row) error {
 	t.eventType.Append(f.GetEventType().GetType())
 	t.eventSubType.Append(f.GetEventType().GetSubType())
 	t.policyMatchType.Append(f.GetPolicyMatchType())
+	var deniedBy []string
+	for _, p := range slices.Concat(f.GetIngressDeniedBy(), f.GetEgressDeniedBy()) {
+		deniedBy = append(deniedBy, PolicyName(p))
+	}
+	t.policyDeniedBy.Append(deniedBy)
 
 	t.proxyPort.Append(f.GetProxyPort())
 	t.socketCookie.Append(f.GetSocketCookie())
@@ -1166,13 +1268,33 @@ func (t *Table) Append(row Row) error {
 	t.l7HTTPHeadersKeys.Append(httpHeaderKeys)
 	t.l7HTTPHeadersValues.Append(httpHeaderValues)
 
+	// Error codes are signed, e.g. -1 is UNKNOWN_SERVER_ERROR, but stored
+	// as UInt32, so use toInt32(l7_kafka_error_code) in queries.
+	t.l7KafkaErrorCode.Append(uint32(l7.GetKafka().GetErrorCode()))   //#nosec G115
+	t.l7KafkaAPIVersion.Append(uint32(l7.GetKafka().GetApiVersion())) //#nosec G115
+	t.l7KafkaAPIKey.Append(l7.GetKafka().GetApiKey())
+	t.l7KafkaCorrelationID.Append(l7.GetKafka().GetCorrelationId())
+	t.l7KafkaTopic.Append(l7.GetKafka().GetTopic())
+
 	t.k8sPod.Append(row.Index.Kubernetes.Pod)
 	t.k8sNS.Append(row.Index.Kubernetes.Namespace)
 
 	t.k8sPeerPod.Append(row.Peer.Kubernetes.Pod)
 	t.k8sPeerNS.Append(row.Peer.Kubernetes.Namespace)
 
-	t.timestamp.Append(f.GetTime().AsTime())
+	ts := f.GetTime().AsTime()
+	if row.FlowCount == 0 {
+		// Single flow.
+		t.timestampLast.Append(ts)
+		t.flowCount.Append(1)
+		t.packetCount.Append(packetCount(f))
+	} else {
+		t.timestampLast.Append(row.LastSeen)
+		t.flowCount.Append(row.FlowCount)
+		t.packetCount.Append(row.PacketCount)
+	}
+
+	t.timestamp.Append(ts)
 
 	return nil
 }
@@ -1186,6 +1308,14 @@ type Row struct {
 	Index   Peer
 	Peer    Peer
 	Inverse bool
+
+	// FlowCount is count of flows folded into row by Aggregator, zero
+	// means single flow Raw.
+	FlowCount uint32
+	// PacketCount is count of per-packet events folded into row.
+	PacketCount uint32
+	// LastSeen is timestamp of last folded flow, Raw is the first one.
+	LastSeen time.Time
 }
 
 func newStrLowCardinality() proto.ColLowCardinality[string] {
@@ -1206,6 +1336,8 @@ func NewTable(name string) *Table {
 
 		interfaceName: newStrLowCardinality(),
 
+		policyDeniedBy: *proto.NewArray[string](new(proto.ColStr).LowCardinality()),
+
 		endpointSrcNamespace:      newStrLowCardinality(),
 		endpointSrcPodName:        newStrLowCardinality(),
 		endpointSrcLabels:         *proto.NewArray[string](new(proto.ColStr).LowCardinality()),
@@ -1259,6 +1391,7 @@ func NewTable(name string) *Table {
 	}
 
 	t.timestamp.WithPrecision(9)
+	t.timestampLast.WithPrecision(9)
 
 	t.l4TCPFlags = *proto.NewArray[string](&proto.ColEnum{})
 
@@ -0,0 +1,24 @@
+package flow
+
+import (
+	"fmt"
+	"strings"
+)
+
+var quoteReplacer = strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+
+// Quote returns ClickHouse string literal.
+func Quote(s string) string {
+	return "'" + quoteReplacer.Replace(s) + "'"
+}
+
+// IndexLabelCondition returns SQL condition that matches rows of index pods
+// in namespace with cilium identity label, like "k8s:vega.app=api".
+//
+// Index pod is source of DIRECT rows and destination of INVERSE ones. Unlike
+// condition on pod names, rows of pods that were replaced by rollout match.
+func IndexLabelCondition(namespace, label string) string {
+	return fmt.Sprintf("k8s_ns = %s AND has(if(direction = 'INVERSE', endpoint_dst_labels, endpoint_src_labels), %s)",
+		Quote(namespace), Quote(label),
+	)
+}
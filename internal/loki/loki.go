@@ -0,0 +1,148 @@
+// Package loki implements minimal client for Loki HTTP API.
+package loki
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-faster/errors"
+)
+
+// Direction of log query.
+type Direction string
+
+// Possible values for Direction.
+const (
+	Forward  Direction = "forward"
+	Backward Direction = "backward"
+)
+
+// Client of Loki HTTP API.
+type Client struct {
+	url  string
+	http *http.Client
+}
+
+// NewClient initializes new Client for Loki at given URL.
+//
+// If client is nil, http.DefaultClient is used.
+func NewClient(lokiURL string, client *http.Client) *Client {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Client{
+		url:  strings.TrimRight(lokiURL, "/"),
+		http: client,
+	}
+}
+
+// QueryRangeParams are parameters of QueryRange.
+type QueryRangeParams struct {
+	Query     string
+	Start     time.Time
+	End       time.Time
+	Limit     int
+	Direction Direction
+}
+
+// Entry is single log line.
+type Entry struct {
+	Time   time.Time
+	Labels map[string]string
+	Line   string
+}
+
+type queryRangeResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	Data   struct {
+		ResultType string `json:"resultType"`
+		Result     []struct {
+			Stream map[string]string `json:"stream"`
+			Values [][]string        `json:"values"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+// QueryRange executes LogQL log query and returns entries of all streams
+// ordered by time according to direction.
+func (c *Client) QueryRange(ctx context.Context, p QueryRangeParams) ([]Entry, error) {
+	q := url.Values{
+		"query": {p.Query},
+	}
+	if !p.Start.IsZero() {
+		q.Set("start", strconv.FormatInt(p.Start.UnixNano(), 10))
+	}
+	if !p.End.IsZero() {
+		q.Set("end", strconv.FormatInt(p.End.UnixNano(), 10))
+	}
+	if p.Limit > 0 {
+		q.Set("limit", strconv.Itoa(p.Limit))
+	}
+	if p.Direction != "" {
+		q.Set("direction", string(p.Direction))
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url+"/loki/api/v1/query_range?"+q.Encode(), http.NoBody)
+	if err != nil {
+		return nil, errors.Wrap(err, "new request")
+	}
+	res, err := c.http.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "do")
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	if res.StatusCode != http.StatusOK {
+		data, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return nil, errors.Errorf("%s: %s", res.Status, strings.TrimSpace(string(data)))
+	}
+
+	var out queryRangeResponse
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return nil, errors.Wrap(err, "decode")
+	}
+	if out.Status != "success" {
+		return nil, errors.Errorf("query failed: %s", out.Error)
+	}
+	if out.Data.ResultType != "streams" {
+		return nil, errors.Errorf("unexpected result type %q", out.Data.ResultType)
+	}
+	var entries []Entry
+	for _, s := range out.Data.Result {
+		for _, v := range s.Values {
+			if len(v) < 2 {
+				return nil, errors.Errorf("invalid value of length %d", len(v))
+			}
+			ns, err := strconv.ParseInt(v[0], 10, 64)
+			if err != nil {
+				return nil, errors.Wrap(err, "parse timestamp")
+			}
+			entries = append(entries, Entry{
+				Time:   time.Unix(0, ns),
+				Labels: s.Stream,
+				Line:   v[1],
+			})
+		}
+	}
+	slices.SortStableFunc(entries, func(a, b Entry) int {
+		if p.Direction == Backward {
+			return b.Time.Compare(a.Time)
+		}
+		return cmp.Compare(a.Time.UnixNano(), b.Time.UnixNano())
+	})
+	return entries, nil
+}
+
+// Quote returns LogQL string literal.
+func Quote(s string) string {
+	return strconv.Quote(s)
+}
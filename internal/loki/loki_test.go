@@ -0,0 +1,53 @@
+package loki
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_QueryRange(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/loki/api/v1/query_range", r.URL.Path)
+		q := r.URL.Query()
+		require.Equal(t, `{pod="a"} |= "err"`, q.Get("query"))
+		require.Equal(t, "1000000000", q.Get("start"))
+		require.Equal(t, "100", q.Get("limit"))
+		require.Equal(t, "forward", q.Get("direction"))
+		_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"streams","result":[
+			{"stream":{"pod":"a"},"values":[["3000000000","third"],["1000000000","first"]]},
+			{"stream":{"pod":"b"},"values":[["2000000000","second"]]}
+		]}}`))
+	}))
+	t.Cleanup(s.Close)
+
+	c := NewClient(s.URL+"/", nil)
+	entries, err := c.QueryRange(t.Context(), QueryRangeParams{
+		Query:     `{pod="a"} |= ` + Quote("err"),
+		Start:     time.Unix(1, 0),
+		Limit:     100,
+		Direction: Forward,
+	})
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	var lines []string
+	for _, e := range entries {
+		lines = append(lines, e.Line)
+	}
+	require.Equal(t, []string{"first", "second", "third"}, lines)
+	require.Equal(t, "b", entries[1].Labels["pod"])
+	require.Equal(t, time.Unix(2, 0), entries[1].Time)
+}
+
+func TestClient_QueryRangeError(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "parse error", http.StatusBadRequest)
+	}))
+	t.Cleanup(s.Close)
+
+	_, err := NewClient(s.URL, nil).QueryRange(t.Context(), QueryRangeParams{Query: "{"})
+	require.ErrorContains(t, err, "parse error")
+}
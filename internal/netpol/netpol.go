@@ -0,0 +1,443 @@
+// Package netpol groups dropped flows and generates CiliumNetworkPolicy
+// that would allow them.
+package netpol
+
+import (
+	"cmp"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Direction of traffic relative to application.
+type Direction string
+
+// Possible values of Direction.
+const (
+	Ingress Direction = "ingress"
+	Egress  Direction = "egress"
+)
+
+// ReasonPolicyDenied is drop reason of flows denied by network policy.
+const ReasonPolicyDenied = "POLICY_DENIED"
+
+// LabelNamespace is cilium label of pod namespace.
+const LabelNamespace = "io.kubernetes.pod.namespace"
+
+// Drop is group of dropped flows between application and peer.
+type Drop struct {
+	Direction Direction
+	// Peer is human-readable peer name.
+	Peer string
+	// PeerLabels are cilium identity labels of peer, like "k8s:app=api"
+	// or "reserved:world".
+	PeerLabels []string
+	// PeerIP is set only for peers outside of cluster.
+	PeerIP   string
+	Protocol string
+	Port     uint32
+	ICMPType uint32
+	Reason   string
+	// Policy is comma-separated list of policies that denied flows,
+	// empty if flows were denied by default.
+	Policy   string
+	Count    int64
+	LastSeen time.Time
+}
+
+// identityKeys are label keys that identify workload, in order of preference.
+var identityKeys = []string{
+	"vega.app",
+	"app.kubernetes.io/name",
+	"app",
+	"k8s-app",
+}
+
+// noisyKeys are label keys that change between pods of single workload
+// or are derived from other labels.
+var noisyKeys = []string{
+	"pod-template-hash",
+	"controller-revision-hash",
+	"pod-template-generation",
+	"statefulset.kubernetes.io/pod-name",
+	"apps.kubernetes.io/pod-index",
+	"controller-uid",
+	"batch.kubernetes.io/controller-uid",
+	"io.cilium.k8s.policy.cluster",
+	"io.cilium.k8s.policy.serviceaccount",
+}
+
+func isNoisy(key string) bool {
+	return slices.Contains(noisyKeys, key) ||
+		strings.HasPrefix(key, "io.cilium.k8s.namespace.labels.")
+}
+
+// Selector returns label selector of peer from cilium identity labels.
+//
+// If peer has one of well-known workload labels, only it and namespace
+// are selected, otherwise all stable labels are. Returns nil if peer is
+// not a Kubernetes endpoint.
+func Selector(labels []string) map[string]string {
+	all := map[string]string{}
+	for _, l := range labels {
+		kv, ok := strings.CutPrefix(l, "k8s:")
+		if !ok {
+			continue
+		}
+		k, v, _ := strings.Cut(kv, "=")
+		if isNoisy(k) {
+			continue
+		}
+		all[k] = v
+	}
+	if len(all) == 0 {
+		return nil
+	}
+	for _, k := range identityKeys {
+		if v, ok := all[k]; ok {
+			out := map[string]string{k: v}
+			if ns, ok := all[LabelNamespace]; ok {
+				out[LabelNamespace] = ns
+			}
+			return out
+		}
+	}
+	return all
+}
+
+// Entity returns cilium entity of reserved identity labels, if any.
+func Entity(labels []string) (string, bool) {
+	for _, l := range labels {
+		v, ok := strings.CutPrefix(l, "reserved:")
+		if !ok {
+			continue
+		}
+		switch v {
+		case "host", "remote-node", "kube-apiserver", "world", "health", "ingress", "init":
+			return v, true
+		case "unmanaged":
+			return "cluster", true
+		}
+	}
+	return "", false
+}
+
+// peerKey returns key that identifies peer in policy.
+func (d Drop) peerKey() string {
+	if d.PeerIP != "" {
+		return "cidr:" + d.PeerIP
+	}
+	if e, ok := Entity(d.PeerLabels); ok {
+		return "entity:" + e
+	}
+	sel := Selector(d.PeerLabels)
+	keys := make([]string, 0, len(sel))
+	for k, v := range sel {
+		keys = append(keys, k+"="+v)
+	}
+	slices.Sort(keys)
+	return "endpoint:" + strings.Join(keys, ",")
+}
+
+// Group merges drops with the same direction, peer identity, port and
+// reason, ordering result by count.
+func Group(drops []Drop) []Drop {
+	type key struct {
+		direction Direction
+		peer      string
+		protocol  string
+		port      uint32
+		icmpType  uint32
+		reason    string
+		policy    string
+	}
+	var (
+		index = map[key]int{}
+		out   []Drop
+	)
+	for _, d := range drops {
+		k := key{d.Direction, d.peerKey(), d.Protocol, d.Port, d.ICMPType, d.Reason, d.Policy}
+		i, ok := index[k]
+		if !ok {
+			index[k] = len(out)
+			out = append(out, d)
+			continue
+		}
+		out[i].Count += d.Count
+		if d.LastSeen.After(out[i].LastSeen) {
+			out[i].LastSeen = d.LastSeen
+		}
+	}
+	slices.SortStableFunc(out, func(a, b Drop) int {
+		return cmp.Or(
+			cmp.Compare(b.Count, a.Count),
+			cmp.Compare(a.Peer, b.Peer),
+		)
+	})
+	return out
+}
+
+// Status of flow group in Diff.
+type Status string
+
+// Possible values of Status.
+const (
+	// StatusNew is group that is present only in current window.
+	StatusNew Status = "new"
+	// StatusGone is group that is present only in baseline window.
+	StatusGone Status = "gone"
+	// StatusSame is group that is present in both windows.
+	StatusSame Status = "same"
+)
+
+// Change is flow group compared between current and baseline windows.
+type Change struct {
+	Drop
+	Status        Status
+	BaselineCount int64
+}
+
+var statusOrder = map[Status]int{
+	StatusNew:  0,
+	StatusSame: 1,
+	StatusGone: 2,
+}
+
+// Diff compares grouped flows of current window with baseline window.
+//
+// Count of Change is count in current window. New groups go first.
+func Diff(current, baseline []Drop) []Change {
+	var (
+		index = map[string]int{}
+		out   []Change
+	)
+	key := func(d Drop) string {
+		return strings.Join([]string{
+			string(d.Direction), d.peerKey(), d.Protocol,
+			strconv.FormatUint(uint64(d.Port), 10),
+			strconv.FormatUint(uint64(d.ICMPType), 10),
+			d.Reason, d.Policy,
+		}, "\x00")
+	}
+	for _, d := range Group(current) {
+		index[key(d)] = len(out)
+		out = append(out, Change{Drop: d, Status: StatusNew})
+	}
+	for _, d := range Group(baseline) {
+		if i, ok := index[key(d)]; ok {
+			out[i].Status = StatusSame
+			out[i].BaselineCount = d.Count
+			continue
+		}
+		c := Change{Drop: d, Status: StatusGone, BaselineCount: d.Count}
+		c.Count = 0
+		out = append(out, c)
+	}
+	slices.SortStableFunc(out, func(a, b Change) int {
+		return cmp.Or(
+			cmp.Compare(statusOrder[a.Status], statusOrder[b.Status]),
+			cmp.Compare(b.Count, a.Count),
+			cmp.Compare(b.BaselineCount, a.BaselineCount),
+		)
+	})
+	return out
+}
+
+// Metadata of Kubernetes object.
+type Metadata struct {
+	Name        string            `json:"name"`
+	Namespace   string            `json:"namespace"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// EndpointSelector selects endpoints by labels.
+type EndpointSelector struct {
+	MatchLabels map[string]string `json:"matchLabels"`
+}
+
+// PortProtocol is L4 port.
+type PortProtocol struct {
+	Port     string `json:"port"`
+	Protocol string `json:"protocol"`
+}
+
+// PortRule is list of allowed ports.
+type PortRule struct {
+	Ports []PortProtocol `json:"ports"`
+}
+
+// ICMPField is allowed ICMP message type.
+type ICMPField struct {
+	Family string `json:"family,omitempty"`
+	Type   uint32 `json:"type"`
+}
+
+// ICMPRule is list of allowed ICMP message types.
+type ICMPRule struct {
+	Fields []ICMPField `json:"fields"`
+}
+
+// IngressRule allows traffic from peers.
+type IngressRule struct {
+	FromEndpoints []EndpointSelector `json:"fromEndpoints,omitempty"`
+	FromEntities  []string           `json:"fromEntities,omitempty"`
+	FromCIDR      []string           `json:"fromCIDR,omitempty"`
+	ToPorts       []PortRule         `json:"toPorts,omitempty"`
+	ICMPs         []ICMPRule         `json:"icmps,omitempty"`
+}
+
+// EgressRule allows traffic to peers.
+type EgressRule struct {
+	ToEndpoints []EndpointSelector `json:"toEndpoints,omitempty"`
+	ToEntities  []string           `json:"toEntities,omitempty"`
+	ToCIDR      []string           `json:"toCIDR,omitempty"`
+	ToPorts     []PortRule         `json:"toPorts,omitempty"`
+	ICMPs       []ICMPRule         `json:"icmps,omitempty"`
+}
+
+// Spec of CiliumNetworkPolicy.
+type Spec struct {
+	EndpointSelector EndpointSelector `json:"endpointSelector"`
+	Ingress          []IngressRule    `json:"ingress,omitempty"`
+	Egress           []EgressRule     `json:"egress,omitempty"`
+}
+
+// CiliumNetworkPolicy is subset of cilium.io/v2 CiliumNetworkPolicy.
+type CiliumNetworkPolicy struct {
+	APIVersion string   `json:"apiVersion"`
+	Kind       string   `json:"kind"`
+	Metadata   Metadata `json:"metadata"`
+	Spec       Spec     `json:"spec"`
+}
+
+// YAML returns policy manifest.
+func (p *CiliumNetworkPolicy) YAML() ([]byte, error) {
+	return yaml.Marshal(p)
+}
+
+// peerRule is allowed traffic of single peer.
+type peerRule struct {
+	direction Direction
+	selector  map[string]string
+	entity    string
+	cidr      string
+	ports     []PortProtocol
+	icmps     []ICMPField
+}
+
+func cidr(ip string) string {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return ""
+	}
+	return netip.PrefixFrom(addr, addr.BitLen()).String()
+}
+
+// Policy returns policy for endpoints selected by selector that allows
+// drops denied by policy. Other drops are ignored.
+func Policy(name, namespace string, selector map[string]string, drops []Drop) *CiliumNetworkPolicy {
+	var (
+		index = map[string]int{}
+		rules []*peerRule
+	)
+	for _, d := range Group(drops) {
+		if d.Reason != ReasonPolicyDenied {
+			continue
+		}
+		r := &peerRule{direction: d.Direction}
+		switch entity, isEntity := Entity(d.PeerLabels); {
+		case d.PeerIP != "" && cidr(d.PeerIP) != "":
+			r.cidr = cidr(d.PeerIP)
+		case isEntity:
+			r.entity = entity
+		default:
+			if r.selector = Selector(d.PeerLabels); r.selector == nil {
+				continue
+			}
+		}
+		key := string(d.Direction) + "/" + d.peerKey()
+		if i, ok := index[key]; ok {
+			r = rules[i]
+		} else {
+			index[key] = len(rules)
+			rules = append(rules, r)
+		}
+		switch d.Protocol {
+		case "TCP", "UDP", "SCTP":
+			p := PortProtocol{Port: strconv.FormatUint(uint64(d.Port), 10), Protocol: d.Protocol}
+			if !slices.Contains(r.ports, p) {
+				r.ports = append(r.ports, p)
+			}
+		case "ICMPv4", "ICMPv6":
+			f := ICMPField{Type: d.ICMPType}
+			if d.Protocol == "ICMPv6" {
+				f.Family = "IPv6"
+			}
+			if !slices.Contains(r.icmps, f) {
+				r.icmps = append(r.icmps, f)
+			}
+		}
+	}
+
+	p := &CiliumNetworkPolicy{
+		APIVersion: "cilium.io/v2",
+		Kind:       "CiliumNetworkPolicy",
+		Metadata: Metadata{
+			Name:      name,
+			Namespace: namespace,
+			Annotations: map[string]string{
+				"vega.generated": "Generated from dropped flows, review before applying",
+			},
+		},
+		Spec: Spec{
+			EndpointSelector: EndpointSelector{MatchLabels: selector},
+		},
+	}
+	for _, r := range rules {
+		var (
+			ports []PortRule
+			icmps []ICMPRule
+		)
+		if len(r.ports) > 0 {
+			ports = []PortRule{{Ports: r.ports}}
+		}
+		if len(r.icmps) > 0 {
+			icmps = []ICMPRule{{Fields: r.icmps}}
+		}
+		var (
+			endpoints []EndpointSelector
+			entities  []string
+			cidrs     []string
+		)
+		switch {
+		case r.selector != nil:
+			endpoints = []EndpointSelector{{MatchLabels: r.selector}}
+		case r.entity != "":
+			entities = []string{r.entity}
+		case r.cidr != "":
+			cidrs = []string{r.cidr}
+		}
+		if r.direction == Ingress {
+			p.Spec.Ingress = append(p.Spec.Ingress, IngressRule{
+				FromEndpoints: endpoints,
+				FromEntities:  entities,
+				FromCIDR:      cidrs,
+				ToPorts:       ports,
+				ICMPs:         icmps,
+			})
+		} else {
+			p.Spec.Egress = append(p.Spec.Egress, EgressRule{
+				ToEndpoints: endpoints,
+				ToEntities:  entities,
+				ToCIDR:      cidrs,
+				ToPorts:     ports,
+				ICMPs:       icmps,
+			})
+		}
+	}
+	return p
+}
@@ -0,0 +1,114 @@
+package netpol
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelector(t *testing.T) {
+	require.Equal(t, map[string]string{
+		"app":          "web",
+		LabelNamespace: "shop",
+	}, Selector([]string{
+		"k8s:app=web",
+		"k8s:pod-template-hash=abc",
+		"k8s:io.kubernetes.pod.namespace=shop",
+		"k8s:io.cilium.k8s.policy.cluster=default",
+		"k8s:version=v1",
+	}))
+	require.Equal(t, map[string]string{
+		"tier":         "db",
+		LabelNamespace: "shop",
+	}, Selector([]string{
+		"k8s:tier=db",
+		"k8s:io.kubernetes.pod.namespace=shop",
+		"k8s:io.cilium.k8s.namespace.labels.team=a",
+	}))
+	require.Nil(t, Selector([]string{"reserved:world"}))
+}
+
+func TestGroup(t *testing.T) {
+	now := time.Now()
+	drops := Group([]Drop{
+		{Direction: Ingress, Peer: "a", PeerLabels: []string{"k8s:app=web", "k8s:pod-template-hash=1"}, Protocol: "TCP", Port: 80, Reason: ReasonPolicyDenied, Count: 1, LastSeen: now},
+		{Direction: Ingress, Peer: "b", PeerLabels: []string{"k8s:app=web", "k8s:pod-template-hash=2"}, Protocol: "TCP", Port: 80, Reason: ReasonPolicyDenied, Count: 2, LastSeen: now.Add(time.Second)},
+		{Direction: Egress, Peer: "1.1.1.1", PeerLabels: []string{"reserved:world"}, PeerIP: "1.1.1.1", Protocol: "UDP", Port: 53, Reason: ReasonPolicyDenied, Count: 1},
+	})
+	require.Len(t, drops, 2)
+	require.Equal(t, int64(3), drops[0].Count)
+	require.Equal(t, now.Add(time.Second), drops[0].LastSeen)
+}
+
+func TestPolicy(t *testing.T) {
+	p := Policy("api-allow", "shop", map[string]string{"vega.app": "api"}, []Drop{
+		{Direction: Ingress, PeerLabels: []string{"k8s:app=web", "k8s:io.kubernetes.pod.namespace=shop"}, Protocol: "TCP", Port: 8080, Reason: ReasonPolicyDenied, Count: 3},
+		{Direction: Ingress, PeerLabels: []string{"k8s:app=web", "k8s:io.kubernetes.pod.namespace=shop"}, Protocol: "TCP", Port: 9090, Reason: ReasonPolicyDenied, Count: 1},
+		{Direction: Egress, PeerLabels: []string{"reserved:world"}, PeerIP: "1.1.1.1", Protocol: "UDP", Port: 53, Reason: ReasonPolicyDenied, Count: 2},
+		{Direction: Egress, PeerLabels: []string{"reserved:kube-apiserver"}, Protocol: "TCP", Port: 6443, Reason: ReasonPolicyDenied, Count: 1},
+		{Direction: Egress, PeerLabels: []string{"k8s:app=db"}, Protocol: "TCP", Port: 5432, Reason: "CT_MAP_INSERTION_FAILED", Count: 10},
+	})
+	data, err := p.YAML()
+	require.NoError(t, err)
+	require.Equal(t, `apiVersion: cilium.io/v2
+kind: CiliumNetworkPolicy
+metadata:
+  annotations:
+    vega.generated: Generated from dropped flows, review before applying
+  name: api-allow
+  namespace: shop
+spec:
+  egress:
+  - toCIDR:
+    - 1.1.1.1/32
+    toPorts:
+    - ports:
+      - port: "53"
+        protocol: UDP
+  - toEntities:
+    - kube-apiserver
+    toPorts:
+    - ports:
+      - port: "6443"
+        protocol: TCP
+  endpointSelector:
+    matchLabels:
+      vega.app: api
+  ingress:
+  - fromEndpoints:
+    - matchLabels:
+        app: web
+        io.kubernetes.pod.namespace: shop
+    toPorts:
+    - ports:
+      - port: "8080"
+        protocol: TCP
+      - port: "9090"
+        protocol: TCP
+`, string(data))
+}
+
+func TestDiff(t *testing.T) {
+	web := []string{"k8s:app=web"}
+	changes := Diff([]Drop{
+		{Direction: Ingress, PeerLabels: web, Protocol: "TCP", Port: 80, Count: 5},
+		{Direction: Ingress, PeerLabels: web, Protocol: "TCP", Port: 443, Count: 1},
+	}, []Drop{
+		{Direction: Ingress, PeerLabels: web, Protocol: "TCP", Port: 80, Count: 7},
+		{Direction: Egress, PeerLabels: []string{"reserved:world"}, PeerIP: "1.1.1.1", Protocol: "UDP", Port: 53, Count: 2},
+	})
+	require.Len(t, changes, 3)
+
+	require.Equal(t, StatusNew, changes[0].Status)
+	require.Equal(t, uint32(443), changes[0].Port)
+	require.Equal(t, int64(1), changes[0].Count)
+
+	require.Equal(t, StatusSame, changes[1].Status)
+	require.Equal(t, int64(5), changes[1].Count)
+	require.Equal(t, int64(7), changes[1].BaselineCount)
+
+	require.Equal(t, StatusGone, changes[2].Status)
+	require.Equal(t, int64(0), changes[2].Count)
+	require.Equal(t, int64(2), changes[2].BaselineCount)
+}
@@ -0,0 +1,77 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-faster/errors"
+)
+
+// Alert is alert of Alertmanager v2 API.
+//
+// Alert is identified by labels. Alert is firing until EndsAt, so alert is
+// resolved by sending it with EndsAt in the past.
+type Alert struct {
+	Labels       map[string]string `json:"labels"`
+	Annotations  map[string]string `json:"annotations,omitempty"`
+	StartsAt     time.Time         `json:"startsAt,omitzero"`
+	EndsAt       time.Time         `json:"endsAt,omitzero"`
+	GeneratorURL string            `json:"generatorURL,omitempty"`
+}
+
+// Resolved reports whether alert is resolved at given time.
+func (a Alert) Resolved(now time.Time) bool {
+	return !a.EndsAt.IsZero() && !a.EndsAt.After(now)
+}
+
+// Alertmanager is minimal client of Alertmanager v2 API.
+type Alertmanager struct {
+	url  string
+	http *http.Client
+}
+
+// NewAlertmanager initializes new Alertmanager client for given URL.
+//
+// If client is nil, http.DefaultClient is used.
+func NewAlertmanager(alertmanagerURL string, client *http.Client) *Alertmanager {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Alertmanager{
+		url:  strings.TrimRight(alertmanagerURL, "/"),
+		http: client,
+	}
+}
+
+// Send posts alerts in single request, non-2xx response is an error.
+func (a *Alertmanager) Send(ctx context.Context, alerts []Alert) error {
+	if len(alerts) == 0 {
+		return nil
+	}
+	data, err := json.Marshal(alerts)
+	if err != nil {
+		return errors.Wrap(err, "marshal")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.url+"/api/v2/alerts", bytes.NewReader(data))
+	if err != nil {
+		return errors.Wrap(err, "new request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := a.http.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "do")
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		data, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return errors.Errorf("%s: %s", res.Status, strings.TrimSpace(string(data)))
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+// Package notify converts vega findings to Alertmanager alerts.
+package notify
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Kind of finding, used as alertname label.
+type Kind string
+
+// Possible values of Kind.
+const (
+	// KindRuntime is runtime detection rule match.
+	KindRuntime Kind = "VegaRuntimeDetection"
+	// KindDrop is dropped flow that was not dropped before.
+	KindDrop Kind = "VegaUnexpectedDrop"
+	// KindDomain is external domain that was not reached before.
+	KindDomain Kind = "VegaNewExternalDomain"
+)
+
+// Labels of alerts.
+const (
+	LabelAlertName = "alertname"
+	LabelSource    = "source"
+	LabelNamespace = "namespace"
+	LabelApp       = "app"
+	LabelPod       = "pod"
+	LabelRule      = "rule"
+	LabelSeverity  = "severity"
+)
+
+// Source is value of LabelSource of all alerts.
+const Source = "vega"
+
+// GroupBy are grouping keys of vega alerts for Alertmanager route, so
+// single notification is sent for findings of the same kind and rule in
+// application.
+var GroupBy = []string{LabelAlertName, LabelNamespace, LabelApp, LabelRule}
+
+// App identifies application.
+type App struct {
+	Namespace string
+	Name      string
+}
+
+// Finding of application.
+type Finding struct {
+	Kind      Kind
+	Rule      string
+	Severity  string
+	Namespace string
+	App       string
+	// Pod is empty if finding is not specific to pod.
+	Pod string
+	// Labels are additional labels that identify finding within rule,
+	// like binary or domain.
+	Labels map[string]string
+
+	Summary     string
+	Description string
+}
+
+// labels returns alert labels of finding.
+func (f Finding) labels() map[string]string {
+	out := make(map[string]string, len(f.Labels)+7)
+	maps.Copy(out, f.Labels)
+	out[LabelAlertName] = string(f.Kind)
+	out[LabelSource] = Source
+	out[LabelNamespace] = f.Namespace
+	out[LabelApp] = f.App
+	out[LabelRule] = f.Rule
+	out[LabelSeverity] = f.Severity
+	if f.Pod != "" {
+		out[LabelPod] = f.Pod
+	}
+	return out
+}
+
+// labelsKey returns key that identifies alert with given labels.
+func labelsKey(labels map[string]string) string {
+	var b strings.Builder
+	for _, k := range slices.Sorted(maps.Keys(labels)) {
+		b.WriteString(k)
+		b.WriteByte('=')
+		b.WriteString(labels[k])
+		b.WriteByte(0)
+	}
+	return b.String()
+}
+
+// Options configures Notifier.
+type Options struct {
+	// ResolveTimeout is time after which Alertmanager resolves firing alert
+	// that was not sent again, should be greater than update interval.
+	// 5m by default.
+	ResolveTimeout time.Duration
+}
+
+func (o *Options) setDefaults() {
+	if o.ResolveTimeout <= 0 {
+		o.ResolveTimeout = 5 * time.Minute
+	}
+}
+
+// Notifier tracks firing alerts of findings.
+//
+// Notifier is not safe for concurrent use.
+type Notifier struct {
+	opt    Options
+	active map[string]Alert
+}
+
+// New creates new Notifier.
+func New(opt Options) *Notifier {
+	opt.setDefaults()
+	return &Notifier{
+		opt:    opt,
+		active: map[string]Alert{},
+	}
+}
+
+// Len returns number of firing alerts.
+func (n *Notifier) Len() int {
+	return len(n.active)
+}
+
+// Update returns alerts to send for findings observed at now.
+//
+// Every finding is firing alert that is sent on every update, so it is
+// not resolved by ResolveTimeout. Alerts of findings that are not observed
+// anymore are resolved. Findings with the same labels are sent once.
+//
+// Alerts of failed applications, which findings were not collected, are
+// not resolved and not sent, so they are resolved by ResolveTimeout if
+// application keeps failing.
+func (n *Notifier) Update(now time.Time, findings []Finding, failed []App) []Alert {
+	var (
+		out  []Alert
+		seen = make(map[string]struct{}, len(findings))
+	)
+	for _, f := range findings {
+		labels := f.labels()
+		key := labelsKey(labels)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		startsAt := now
+		if prev, ok := n.active[key]; ok {
+			startsAt = prev.StartsAt
+		}
+		a := Alert{
+			Labels:      labels,
+			Annotations: map[string]string{},
+			StartsAt:    startsAt,
+			EndsAt:      now.Add(n.opt.ResolveTimeout),
+		}
+		if f.Summary != "" {
+			a.Annotations["summary"] = f.Summary
+		}
+		if f.Description != "" {
+			a.Annotations["description"] = f.Description
+		}
+		n.active[key] = a
+		out = append(out, a)
+	}
+	for _, key := range slices.Sorted(maps.Keys(n.active)) {
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		a := n.active[key]
+		app := App{Namespace: a.Labels[LabelNamespace], Name: a.Labels[LabelApp]}
+		if slices.Contains(failed, app) {
+			if a.Resolved(now) {
+				// Resolved by Alertmanager.
+				delete(n.active, key)
+			}
+			continue
+		}
+		a.EndsAt = now
+		delete(n.active, key)
+		out = append(out, a)
+	}
+	return out
+}
@@ -0,0 +1,137 @@
+package notify
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFinding_labels(t *testing.T) {
+	f := Finding{
+		Kind:      KindRuntime,
+		Rule:      "shell-from-web-server",
+		Severity:  "high",
+		Namespace: "shop",
+		App:       "api",
+		Pod:       "api-1",
+		Labels: map[string]string{
+			"binary": "/bin/sh",
+			// Overridden.
+			LabelApp: "web",
+		},
+	}
+	require.Equal(t, map[string]string{
+		LabelAlertName: "VegaRuntimeDetection",
+		LabelSource:    "vega",
+		LabelNamespace: "shop",
+		LabelApp:       "api",
+		LabelPod:       "api-1",
+		LabelRule:      "shell-from-web-server",
+		LabelSeverity:  "high",
+		"binary":       "/bin/sh",
+	}, f.labels())
+
+	f.Pod = ""
+	require.NotContains(t, f.labels(), LabelPod)
+	for _, k := range GroupBy {
+		require.Contains(t, f.labels(), k)
+	}
+}
+
+func TestNotifier(t *testing.T) {
+	var (
+		ctx   = t.Context()
+		s     = newStub(t)
+		am    = NewAlertmanager(s.url, nil)
+		n     = New(Options{ResolveTimeout: 3 * time.Minute})
+		start = time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	)
+	domain := func(name string) Finding {
+		return Finding{
+			Kind:      KindDomain,
+			Rule:      "new-external-domain",
+			Severity:  "low",
+			Namespace: "shop",
+			App:       "api",
+			Labels:    map[string]string{"domain": name},
+			Summary:   "New external domain " + name,
+		}
+	}
+	update := func(now time.Time, findings ...Finding) []Alert {
+		t.Helper()
+		alerts := n.Update(now, findings, nil)
+		require.NoError(t, am.Send(ctx, alerts))
+		return alerts
+	}
+
+	alerts := update(start, domain("a.com"), domain("b.com"), domain("a.com"))
+	require.Len(t, alerts, 2, "duplicate should be sent once")
+	require.Equal(t, 2, n.Len())
+	require.Equal(t, start, alerts[0].StartsAt)
+	require.Equal(t, start.Add(3*time.Minute), alerts[0].EndsAt)
+	require.Equal(t, map[string]string{"summary": "New external domain a.com"}, alerts[0].Annotations)
+	require.ElementsMatch(t, []string{"a.com", "b.com"}, s.firing(start, "domain"))
+
+	// Still firing, start is kept.
+	now := start.Add(time.Minute)
+	alerts = update(now, domain("b.com"), domain("a.com"))
+	require.Len(t, alerts, 2)
+	for _, a := range alerts {
+		require.Equal(t, start, a.StartsAt)
+		require.Equal(t, now.Add(3*time.Minute), a.EndsAt)
+	}
+
+	// Resolved.
+	now = start.Add(2 * time.Minute)
+	alerts = update(now, domain("a.com"))
+	require.Len(t, alerts, 2)
+	require.False(t, alerts[0].Resolved(now))
+	require.True(t, alerts[1].Resolved(now))
+	require.Equal(t, "b.com", alerts[1].Labels["domain"])
+	require.Equal(t, start, alerts[1].StartsAt)
+	require.Equal(t, 1, n.Len())
+	require.Equal(t, []string{"a.com"}, s.firing(now, "domain"))
+
+	// Fires again with new start.
+	now = start.Add(3 * time.Minute)
+	alerts = update(now, domain("a.com"), domain("b.com"))
+	require.Equal(t, now, alerts[1].StartsAt)
+
+	// Nothing is observed.
+	now = start.Add(4 * time.Minute)
+	require.Len(t, update(now), 2)
+	require.Empty(t, update(now))
+	require.Empty(t, s.firing(now, "domain"))
+}
+
+func TestNotifier_Failed(t *testing.T) {
+	var (
+		n     = New(Options{ResolveTimeout: 3 * time.Minute})
+		start = time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+		api   = App{Namespace: "shop", Name: "api"}
+		web   = App{Namespace: "shop", Name: "web"}
+	)
+	finding := func(app App) Finding {
+		return Finding{
+			Kind:      KindRuntime,
+			Rule:      "binary-from-tmp",
+			Severity:  "high",
+			Namespace: app.Namespace,
+			App:       app.Name,
+		}
+	}
+	require.Len(t, n.Update(start, []Finding{finding(api), finding(web)}, nil), 2)
+
+	// Findings of api were not collected, so alert is kept.
+	now := start.Add(time.Minute)
+	alerts := n.Update(now, []Finding{finding(web)}, []App{api})
+	require.Len(t, alerts, 1)
+	require.Equal(t, "web", alerts[0].Labels[LabelApp])
+	require.Equal(t, 2, n.Len())
+
+	// Resolved by Alertmanager after timeout.
+	now = start.Add(5 * time.Minute)
+	require.Len(t, n.Update(now, []Finding{finding(web)}, []App{api}), 1)
+	require.Equal(t, 1, n.Len())
+}
@@ -0,0 +1,87 @@
+package notify
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// stub is local Alertmanager that keeps the last state of received alerts,
+// like Alertmanager does.
+type stub struct {
+	mux    sync.Mutex
+	alerts map[string]Alert
+	posts  int
+	url    string
+}
+
+func newStub(t *testing.T) *stub {
+	t.Helper()
+	s := &stub{alerts: map[string]Alert{}}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v2/alerts" {
+			http.NotFound(w, r)
+			return
+		}
+		var alerts []Alert
+		if err := json.NewDecoder(r.Body).Decode(&alerts); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		s.mux.Lock()
+		defer s.mux.Unlock()
+		s.posts++
+		for _, a := range alerts {
+			if a.Labels[LabelAlertName] == "" {
+				http.Error(w, "alertname is required", http.StatusBadRequest)
+				return
+			}
+			key := labelsKey(a.Labels)
+			if prev, ok := s.alerts[key]; ok && !prev.Resolved(a.StartsAt) {
+				a.StartsAt = prev.StartsAt
+			}
+			s.alerts[key] = a
+		}
+	}))
+	t.Cleanup(srv.Close)
+	s.url = srv.URL
+	return s
+}
+
+// firing returns firing alerts at now by value of label.
+func (s *stub) firing(now time.Time, label string) []string {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	var out []string
+	for _, a := range s.alerts {
+		if !a.Resolved(now) {
+			out = append(out, a.Labels[label])
+		}
+	}
+	return out
+}
+
+func TestAlertmanager_Send(t *testing.T) {
+	s := newStub(t)
+	ctx := t.Context()
+	am := NewAlertmanager(s.url+"/", nil)
+
+	now := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	require.NoError(t, am.Send(ctx, []Alert{
+		{Labels: map[string]string{LabelAlertName: "a"}, StartsAt: now, EndsAt: now.Add(time.Minute)},
+		{Labels: map[string]string{LabelAlertName: "b"}, StartsAt: now, EndsAt: now},
+	}))
+	require.Equal(t, []string{"a"}, s.firing(now, LabelAlertName))
+
+	// Nothing to send.
+	require.NoError(t, am.Send(ctx, nil))
+	require.Equal(t, 1, s.posts)
+
+	err := am.Send(ctx, []Alert{{Labels: map[string]string{}}})
+	require.ErrorContains(t, err, "400 Bad Request: alertname is required")
+}
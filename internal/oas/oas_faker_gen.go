@@ -3,131 +3,1747 @@
 package oas
 
 import (
+	"fmt"
 	"time"
 )
 
+// SetFake set fake values.
+func (s *AlertInstance) SetFake() {
+	{
+		{
+			s.State.SetFake()
+		}
+	}
+	{
+		{
+			s.Since.SetFake()
+		}
+	}
+	{
+		{
+			s.Value.SetFake()
+		}
+	}
+	{
+		{
+			s.Labels.SetFake()
+		}
+	}
+	{
+		{
+			s.Annotations.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *AlertInstanceState) SetFake() {
+	*s = AlertInstanceStatePending
+}
+
+// SetFake set fake values.
+func (s *AlertRule) SetFake() {
+	{
+		{
+			s.Name = "string"
+		}
+	}
+	{
+		{
+			s.Group = "string"
+		}
+	}
+	{
+		{
+			s.State.SetFake()
+		}
+	}
+	{
+		{
+			s.Query = "string"
+		}
+	}
+	{
+		{
+			s.Duration.SetFake()
+		}
+	}
+	{
+		{
+			s.Health = "string"
+		}
+	}
+	{
+		{
+			s.LastError.SetFake()
+		}
+	}
+	{
+		{
+			s.Labels.SetFake()
+		}
+	}
+	{
+		{
+			s.Annotations.SetFake()
+		}
+	}
+	{
+		{
+			s.Alerts = nil
+			for i := 0; i < 0; i++ {
+				var elem AlertInstance
+				{
+					elem.SetFake()
+				}
+				s.Alerts = append(s.Alerts, elem)
+			}
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *AlertRuleState) SetFake() {
+	*s = AlertRuleStateInactive
+}
+
+// SetFake set fake values.
+func (s *Anomaly) SetFake() {
+	{
+		{
+			s.Kind.SetFake()
+		}
+	}
+	{
+		{
+			s.Image = "string"
+		}
+	}
+	{
+		{
+			s.Binary = "string"
+		}
+	}
+	{
+		{
+			s.ParentBinary.SetFake()
+		}
+	}
+	{
+		{
+			s.Count = int64(0)
+		}
+	}
+	{
+		{
+			s.FirstSeen = time.Now()
+		}
+	}
+	{
+		{
+			s.LastSeen = time.Now()
+		}
+	}
+	{
+		{
+			s.Pod = "string"
+		}
+	}
+	{
+		{
+			s.Container = "string"
+		}
+	}
+	{
+		{
+			s.ExecID = "string"
+		}
+	}
+	{
+		{
+			s.Arguments = "string"
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *AnomalyKind) SetFake() {
+	*s = AnomalyKindBinary
+}
+
+// SetFake set fake values.
+func (s *AnomalyReport) SetFake() {
+	{
+		{
+			s.Name = "string"
+		}
+	}
+	{
+		{
+			s.Namespace = "string"
+		}
+	}
+	{
+		{
+			s.Start = time.Now()
+		}
+	}
+	{
+		{
+			s.End = time.Now()
+		}
+	}
+	{
+		{
+			s.Images = nil
+			for i := 0; i < 0; i++ {
+				var elem ImageBaseline
+				{
+					elem.SetFake()
+				}
+				s.Images = append(s.Images, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Anomalies = nil
+			for i := 0; i < 0; i++ {
+				var elem Anomaly
+				{
+					elem.SetFake()
+				}
+				s.Anomalies = append(s.Anomalies, elem)
+			}
+		}
+	}
+}
+
 // SetFake set fake values.
 func (s *Application) SetFake() {
 	{
 		{
-			s.Name = "string"
+			s.Name = "string"
+		}
+	}
+	{
+		{
+			s.Namespace = "string"
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *ApplicationAlerts) SetFake() {
+	{
+		{
+			s.Name = "string"
+		}
+	}
+	{
+		{
+			s.Namespace = "string"
+		}
+	}
+	{
+		{
+			s.Rules = nil
+			for i := 0; i < 0; i++ {
+				var elem AlertRule
+				{
+					elem.SetFake()
+				}
+				s.Rules = append(s.Rules, elem)
+			}
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *ApplicationList) SetFake() {
+	var unwrapped []Application
+	{
+		unwrapped = nil
+		for i := 0; i < 0; i++ {
+			var elem Application
+			{
+				elem.SetFake()
+			}
+			unwrapped = append(unwrapped, elem)
+		}
+	}
+	*s = ApplicationList(unwrapped)
+}
+
+// SetFake set fake values.
+func (s *ApplicationResources) SetFake() {
+	{
+		{
+			s.Name = "string"
+		}
+	}
+	{
+		{
+			s.Namespace = "string"
+		}
+	}
+	{
+		{
+			s.Pods = nil
+			for i := 0; i < 0; i++ {
+				var elem PodResourcesSeries
+				{
+					elem.SetFake()
+				}
+				s.Pods = append(s.Pods, elem)
+			}
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *ApplicationSummary) SetFake() {
+	{
+		{
+			s.Name = "string"
+		}
+	}
+	{
+		{
+			s.Namespace = "string"
+		}
+	}
+	{
+		{
+			s.Pods = nil
+			for i := 0; i < 0; i++ {
+				var elem Pod
+				{
+					elem.SetFake()
+				}
+				s.Pods = append(s.Pods, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Events = nil
+			for i := 0; i < 0; i++ {
+				var elem KubeEvent
+				{
+					elem.SetFake()
+				}
+				s.Events = append(s.Events, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Network.SetFake()
+		}
+	}
+	{
+		{
+			s.Security.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *AuditChange) SetFake() {
+	{
+		{
+			s.Status.SetFake()
+		}
+	}
+	{
+		{
+			s.Flows.SetFake()
+		}
+	}
+	{
+		{
+			s.BaselineCount = int64(0)
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *AuditChangeStatus) SetFake() {
+	*s = AuditChangeStatusNew
+}
+
+// SetFake set fake values.
+func (s *AuditReport) SetFake() {
+	{
+		{
+			s.Name = "string"
+		}
+	}
+	{
+		{
+			s.Namespace = "string"
+		}
+	}
+	{
+		{
+			s.Start = time.Now()
+		}
+	}
+	{
+		{
+			s.End = time.Now()
+		}
+	}
+	{
+		{
+			s.BaselineStart = time.Now()
+		}
+	}
+	{
+		{
+			s.BaselineEnd = time.Now()
+		}
+	}
+	{
+		{
+			s.Changes = nil
+			for i := 0; i < 0; i++ {
+				var elem AuditChange
+				{
+					elem.SetFake()
+				}
+				s.Changes = append(s.Changes, elem)
+			}
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *ContainerStatus) SetFake() {
+	{
+		{
+			s.Name = "string"
+		}
+	}
+	{
+		{
+			s.Init = true
+		}
+	}
+	{
+		{
+			s.Ready = true
+		}
+	}
+	{
+		{
+			s.Restarts = int(0)
+		}
+	}
+	{
+		{
+			s.State.SetFake()
+		}
+	}
+	{
+		{
+			s.Reason.SetFake()
+		}
+	}
+	{
+		{
+			s.Message.SetFake()
+		}
+	}
+	{
+		{
+			s.StartedAt.SetFake()
+		}
+	}
+	{
+		{
+			s.Terminated.SetFake()
+		}
+	}
+	{
+		{
+			s.LastTermination.SetFake()
+		}
+	}
+	{
+		{
+			s.OomKilled = true
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *ContainerStatusState) SetFake() {
+	*s = ContainerStatusStateWaiting
+}
+
+// SetFake set fake values.
+func (s *ContainerTermination) SetFake() {
+	{
+		{
+			s.ExitCode = int32(0)
+		}
+	}
+	{
+		{
+			s.Signal.SetFake()
+		}
+	}
+	{
+		{
+			s.Reason.SetFake()
+		}
+	}
+	{
+		{
+			s.Message.SetFake()
+		}
+	}
+	{
+		{
+			s.FinishedAt.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *DNSDomain) SetFake() {
+	{
+		{
+			s.Query = "string"
+		}
+	}
+	{
+		{
+			s.Lookups = int64(0)
+		}
+	}
+	{
+		{
+			s.Nxdomain = int64(0)
+		}
+	}
+	{
+		{
+			s.Servfail = int64(0)
+		}
+	}
+	{
+		{
+			s.Errors = int64(0)
+		}
+	}
+	{
+		{
+			s.Slow = int64(0)
+		}
+	}
+	{
+		{
+			s.LatencyP95Ms = float64(0)
+		}
+	}
+	{
+		{
+			s.Ips = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.Ips = append(s.Ips, elem)
+			}
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *DNSFailure) SetFake() {
+	{
+		{
+			s.Query = "string"
+		}
+	}
+	{
+		{
+			s.Rcode = int(0)
+		}
+	}
+	{
+		{
+			s.Count = int64(0)
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *DNSReport) SetFake() {
+	{
+		{
+			s.Name = "string"
+		}
+	}
+	{
+		{
+			s.Namespace = "string"
+		}
+	}
+	{
+		{
+			s.Window = int(0)
+		}
+	}
+	{
+		{
+			s.Domains = nil
+			for i := 0; i < 0; i++ {
+				var elem DNSDomain
+				{
+					elem.SetFake()
+				}
+				s.Domains = append(s.Domains, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Egress = nil
+			for i := 0; i < 0; i++ {
+				var elem EgressDestination
+				{
+					elem.SetFake()
+				}
+				s.Egress = append(s.Egress, elem)
+			}
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *DropReasonCount) SetFake() {
+	{
+		{
+			s.Reason = "string"
+		}
+	}
+	{
+		{
+			s.Count = int64(0)
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *EgressDestination) SetFake() {
+	{
+		{
+			s.IP = "string"
+		}
+	}
+	{
+		{
+			s.Names = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.Names = append(s.Names, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Protocol = "string"
+		}
+	}
+	{
+		{
+			s.Port = uint32(0)
+		}
+	}
+	{
+		{
+			s.Flows = int64(0)
+		}
+	}
+	{
+		{
+			s.FirstSeen = time.Now()
+		}
+	}
+	{
+		{
+			s.LastSeen = time.Now()
+		}
+	}
+	{
+		{
+			s.New = true
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *Error) SetFake() {
+	{
+		{
+			s.ErrorMessage = "string"
+		}
+	}
+	{
+		{
+			s.TraceID.SetFake()
+		}
+	}
+	{
+		{
+			s.SpanID.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *Flow) SetFake() {
+	{
+		{
+			s.Time = time.Now()
+		}
+	}
+	{
+		{
+			s.Verdict = "string"
+		}
+	}
+	{
+		{
+			s.DropReason.SetFake()
+		}
+	}
+	{
+		{
+			s.TrafficDirection = "string"
+		}
+	}
+	{
+		{
+			s.Protocol = "string"
+		}
+	}
+	{
+		{
+			s.TCPFlags = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.TCPFlags = append(s.TCPFlags, elem)
+			}
+		}
+	}
+	{
+		{
+			s.L7.SetFake()
+		}
+	}
+	{
+		{
+			s.TraceID.SetFake()
+		}
+	}
+	{
+		{
+			s.Count.SetFake()
+		}
+	}
+	{
+		{
+			s.LastTime.SetFake()
+		}
+	}
+	{
+		{
+			s.Source.SetFake()
+		}
+	}
+	{
+		{
+			s.Destination.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *FlowDrop) SetFake() {
+	{
+		{
+			s.Direction.SetFake()
+		}
+	}
+	{
+		{
+			s.Peer = "string"
+		}
+	}
+	{
+		{
+			s.PeerLabels = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.PeerLabels = append(s.PeerLabels, elem)
+			}
+		}
+	}
+	{
+		{
+			s.PeerIP.SetFake()
+		}
+	}
+	{
+		{
+			s.Protocol = "string"
+		}
+	}
+	{
+		{
+			s.Port = int(0)
+		}
+	}
+	{
+		{
+			s.Reason = "string"
+		}
+	}
+	{
+		{
+			s.Policy.SetFake()
+		}
+	}
+	{
+		{
+			s.Count = int64(0)
+		}
+	}
+	{
+		{
+			s.LastSeen = time.Now()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *FlowDropDirection) SetFake() {
+	*s = FlowDropDirectionIngress
+}
+
+// SetFake set fake values.
+func (s *FlowEndpoint) SetFake() {
+	{
+		{
+			s.Namespace.SetFake()
+		}
+	}
+	{
+		{
+			s.Pod.SetFake()
+		}
+	}
+	{
+		{
+			s.IP = "string"
+		}
+	}
+	{
+		{
+			s.Port.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *FlowList) SetFake() {
+	var unwrapped []Flow
+	{
+		unwrapped = nil
+		for i := 0; i < 0; i++ {
+			var elem Flow
+			{
+				elem.SetFake()
+			}
+			unwrapped = append(unwrapped, elem)
+		}
+	}
+	*s = FlowList(unwrapped)
+}
+
+// SetFake set fake values.
+func (s *HTTPEndpoint) SetFake() {
+	{
+		{
+			s.Method = "string"
+		}
+	}
+	{
+		{
+			s.Route = "string"
+		}
+	}
+	{
+		{
+			s.Requests = int64(0)
+		}
+	}
+	{
+		{
+			s.Rate = float64(0)
+		}
+	}
+	{
+		{
+			s.ClientErrors = int64(0)
+		}
+	}
+	{
+		{
+			s.Errors = int64(0)
+		}
+	}
+	{
+		{
+			s.ErrorRatio = float64(0)
+		}
+	}
+	{
+		{
+			s.LatencyP50Ms = float64(0)
+		}
+	}
+	{
+		{
+			s.LatencyP90Ms = float64(0)
+		}
+	}
+	{
+		{
+			s.LatencyP99Ms = float64(0)
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *HTTPReport) SetFake() {
+	{
+		{
+			s.Name = "string"
+		}
+	}
+	{
+		{
+			s.Namespace = "string"
+		}
+	}
+	{
+		{
+			s.Window = int(0)
+		}
+	}
+	{
+		{
+			s.Endpoints = nil
+			for i := 0; i < 0; i++ {
+				var elem HTTPEndpoint
+				{
+					elem.SetFake()
+				}
+				s.Endpoints = append(s.Endpoints, elem)
+			}
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *HTTPStatusCount) SetFake() {
+	{
+		{
+			s.Code = int(0)
+		}
+	}
+	{
+		{
+			s.Count = int64(0)
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *Health) SetFake() {
+	{
+		{
+			s.Status = "string"
+		}
+	}
+	{
+		{
+			s.Version = "string"
+		}
+	}
+	{
+		{
+			s.Commit = "string"
+		}
+	}
+	{
+		{
+			s.BuildDate = time.Now()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *ImageBaseline) SetFake() {
+	{
+		{
+			s.Image = "string"
+		}
+	}
+	{
+		{
+			s.FirstSeen = time.Now()
+		}
+	}
+	{
+		{
+			s.TrainedUntil = time.Now()
+		}
+	}
+	{
+		{
+			s.Training = true
+		}
+	}
+	{
+		{
+			s.Binaries = int(0)
+		}
+	}
+	{
+		{
+			s.Pairs = int(0)
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *KafkaReport) SetFake() {
+	{
+		{
+			s.Name = "string"
+		}
+	}
+	{
+		{
+			s.Namespace = "string"
+		}
+	}
+	{
+		{
+			s.Window = int(0)
+		}
+	}
+	{
+		{
+			s.Produces = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.Produces = append(s.Produces, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Consumes = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.Consumes = append(s.Consumes, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Requests = nil
+			for i := 0; i < 0; i++ {
+				var elem KafkaRequests
+				{
+					elem.SetFake()
+				}
+				s.Requests = append(s.Requests, elem)
+			}
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *KafkaRequests) SetFake() {
+	{
+		{
+			s.Topic = "string"
+		}
+	}
+	{
+		{
+			s.APIKey = "string"
+		}
+	}
+	{
+		{
+			s.Broker = "string"
+		}
+	}
+	{
+		{
+			s.Requests = int64(0)
+		}
+	}
+	{
+		{
+			s.Rate = float64(0)
+		}
+	}
+	{
+		{
+			s.Errors = int64(0)
+		}
+	}
+	{
+		{
+			s.ErrorCodes = nil
+			for i := 0; i < 0; i++ {
+				var elem int32
+				{
+					elem = int32(0)
+				}
+				s.ErrorCodes = append(s.ErrorCodes, elem)
+			}
+		}
+	}
+	{
+		{
+			s.LatencyP50Ms = float64(0)
+		}
+	}
+	{
+		{
+			s.LatencyP99Ms = float64(0)
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *KubeEvent) SetFake() {
+	{
+		{
+			s.Time = time.Now()
+		}
+	}
+	{
+		{
+			s.Type = "string"
+		}
+	}
+	{
+		{
+			s.Reason = "string"
+		}
+	}
+	{
+		{
+			s.Message = "string"
+		}
+	}
+	{
+		{
+			s.Object = "string"
+		}
+	}
+	{
+		{
+			s.Count = int(0)
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *LabelMap) SetFake() {
+	var (
+		elem string
+		m    map[string]string = s.init()
+	)
+	for i := 0; i < 0; i++ {
+		m[fmt.Sprintf("fake%d", i)] = elem
+	}
+}
+
+// SetFake set fake values.
+func (s *LogEntry) SetFake() {
+	{
+		{
+			s.Time = time.Now()
+		}
+	}
+	{
+		{
+			s.Pod = "string"
+		}
+	}
+	{
+		{
+			s.Container.SetFake()
+		}
+	}
+	{
+		{
+			s.Line = "string"
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *LogEntryList) SetFake() {
+	var unwrapped []LogEntry
+	{
+		unwrapped = nil
+		for i := 0; i < 0; i++ {
+			var elem LogEntry
+			{
+				elem.SetFake()
+			}
+			unwrapped = append(unwrapped, elem)
+		}
+	}
+	*s = LogEntryList(unwrapped)
+}
+
+// SetFake set fake values.
+func (s *NetpolReport) SetFake() {
+	{
+		{
+			s.Name = "string"
+		}
+	}
+	{
+		{
+			s.Namespace = "string"
+		}
+	}
+	{
+		{
+			s.Window = int(0)
+		}
+	}
+	{
+		{
+			s.Drops = nil
+			for i := 0; i < 0; i++ {
+				var elem FlowDrop
+				{
+					elem.SetFake()
+				}
+				s.Drops = append(s.Drops, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Policy = "string"
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *NetworkSummary) SetFake() {
+	{
+		{
+			s.Window = int(0)
+		}
+	}
+	{
+		{
+			s.Flows = int64(0)
+		}
+	}
+	{
+		{
+			s.Dropped = int64(0)
+		}
+	}
+	{
+		{
+			s.DNSFailures = int64(0)
+		}
+	}
+	{
+		{
+			s.TopPeers = nil
+			for i := 0; i < 0; i++ {
+				var elem PeerTraffic
+				{
+					elem.SetFake()
+				}
+				s.TopPeers = append(s.TopPeers, elem)
+			}
+		}
+	}
+	{
+		{
+			s.DropReasons = nil
+			for i := 0; i < 0; i++ {
+				var elem DropReasonCount
+				{
+					elem.SetFake()
+				}
+				s.DropReasons = append(s.DropReasons, elem)
+			}
+		}
+	}
+	{
+		{
+			s.HTTPStatuses = nil
+			for i := 0; i < 0; i++ {
+				var elem HTTPStatusCount
+				{
+					elem.SetFake()
+				}
+				s.HTTPStatuses = append(s.HTTPStatuses, elem)
+			}
+		}
+	}
+	{
+		{
+			s.TopDNSFailures = nil
+			for i := 0; i < 0; i++ {
+				var elem DNSFailure
+				{
+					elem.SetFake()
+				}
+				s.TopDNSFailures = append(s.TopDNSFailures, elem)
+			}
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *OptBool) SetFake() {
+	var elem bool
+	{
+		elem = true
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptContainerTermination) SetFake() {
+	var elem ContainerTermination
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptDateTime) SetFake() {
+	var elem time.Time
+	{
+		elem = time.Now()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptFloat64) SetFake() {
+	var elem float64
+	{
+		elem = float64(0)
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptInt) SetFake() {
+	var elem int
+	{
+		elem = int(0)
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptInt32) SetFake() {
+	var elem int32
+	{
+		elem = int32(0)
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptInt64) SetFake() {
+	var elem int64
+	{
+		elem = int64(0)
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptNetworkSummary) SetFake() {
+	var elem NetworkSummary
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptSecuritySummary) SetFake() {
+	var elem SecuritySummary
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptSpanID) SetFake() {
+	var elem SpanID
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptString) SetFake() {
+	var elem string
+	{
+		elem = "string"
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptTraceID) SetFake() {
+	var elem TraceID
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptUint32) SetFake() {
+	var elem uint32
+	{
+		elem = uint32(0)
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *PeerTraffic) SetFake() {
+	{
+		{
+			s.Peer = "string"
+		}
+	}
+	{
+		{
+			s.Flows = int64(0)
+		}
+	}
+	{
+		{
+			s.Dropped = int64(0)
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *Pod) SetFake() {
+	{
+		{
+			s.Name = "string"
+		}
+	}
+	{
+		{
+			s.Namespace = "string"
+		}
+	}
+	{
+		{
+			s.Status = "string"
+		}
+	}
+	{
+		{
+			s.Reason.SetFake()
+		}
+	}
+	{
+		{
+			s.Resources.SetFake()
 		}
 	}
 	{
 		{
-			s.Namespace = "string"
+			s.Restarts = int(0)
+		}
+	}
+	{
+		{
+			s.Containers = nil
+			for i := 0; i < 0; i++ {
+				var elem ContainerStatus
+				{
+					elem.SetFake()
+				}
+				s.Containers = append(s.Containers, elem)
+			}
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *ApplicationList) SetFake() {
-	var unwrapped []Application
+func (s *PodResources) SetFake() {
 	{
-		unwrapped = nil
-		for i := 0; i < 0; i++ {
-			var elem Application
-			{
-				elem.SetFake()
-			}
-			unwrapped = append(unwrapped, elem)
+		{
+			s.CPUUsageTotalMillicores = float64(0)
+		}
+	}
+	{
+		{
+			s.MemUsageTotalBytes = int64(0)
+		}
+	}
+	{
+		{
+			s.NetRxBytesPerSecond = int64(0)
+		}
+	}
+	{
+		{
+			s.NetTxBytesPerSecond = int64(0)
 		}
 	}
-	*s = ApplicationList(unwrapped)
 }
 
 // SetFake set fake values.
-func (s *ApplicationSummary) SetFake() {
+func (s *PodResourcesPoint) SetFake() {
 	{
 		{
-			s.Name = "string"
+			s.Time = time.Now()
 		}
 	}
 	{
 		{
-			s.Namespace = "string"
+			s.Resources.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *PodResourcesSeries) SetFake() {
+	{
+		{
+			s.Pod = "string"
 		}
 	}
 	{
 		{
-			s.Pods = nil
+			s.Points = nil
 			for i := 0; i < 0; i++ {
-				var elem Pod
+				var elem PodResourcesPoint
 				{
 					elem.SetFake()
 				}
-				s.Pods = append(s.Pods, elem)
+				s.Points = append(s.Points, elem)
 			}
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *Error) SetFake() {
+func (s *ProcessExec) SetFake() {
 	{
 		{
-			s.ErrorMessage = "string"
+			s.Time = time.Now()
 		}
 	}
 	{
 		{
-			s.TraceID.SetFake()
+			s.Pod = "string"
 		}
 	}
 	{
 		{
-			s.SpanID.SetFake()
+			s.Container = "string"
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *Health) SetFake() {
 	{
 		{
-			s.Status = "string"
+			s.ExecID = "string"
 		}
 	}
 	{
 		{
-			s.Version = "string"
+			s.Binary = "string"
 		}
 	}
 	{
 		{
-			s.Commit = "string"
+			s.Arguments = "string"
 		}
 	}
 	{
 		{
-			s.BuildDate = time.Now()
+			s.UID = uint32(0)
+		}
+	}
+	{
+		{
+			s.ParentExecID.SetFake()
+		}
+	}
+	{
+		{
+			s.ParentBinary.SetFake()
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *OptSpanID) SetFake() {
-	var elem SpanID
+func (s *ProcessExecList) SetFake() {
+	var unwrapped []ProcessExec
 	{
-		elem.SetFake()
+		unwrapped = nil
+		for i := 0; i < 0; i++ {
+			var elem ProcessExec
+			{
+				elem.SetFake()
+			}
+			unwrapped = append(unwrapped, elem)
+		}
 	}
-	s.SetTo(elem)
+	*s = ProcessExecList(unwrapped)
 }
 
 // SetFake set fake values.
-func (s *OptTraceID) SetFake() {
-	var elem TraceID
+func (s *ProcessNode) SetFake() {
 	{
-		elem.SetFake()
+		{
+			s.ExecID = "string"
+		}
+	}
+	{
+		{
+			s.Pid = uint32(0)
+		}
+	}
+	{
+		{
+			s.UID = uint32(0)
+		}
+	}
+	{
+		{
+			s.Binary = "string"
+		}
+	}
+	{
+		{
+			s.Arguments = "string"
+		}
+	}
+	{
+		{
+			s.Cwd.SetFake()
+		}
+	}
+	{
+		{
+			s.Container.SetFake()
+		}
+	}
+	{
+		{
+			s.StartTime.SetFake()
+		}
+	}
+	{
+		{
+			s.Exited = true
+		}
+	}
+	{
+		{
+			s.ExitTime.SetFake()
+		}
+	}
+	{
+		{
+			s.ExitStatus.SetFake()
+		}
+	}
+	{
+		{
+			s.ExitSignal.SetFake()
+		}
+	}
+	{
+		{
+			s.DurationMs.SetFake()
+		}
+	}
+	{
+		{
+			s.Partial = true
+		}
+	}
+	{
+		{
+			s.Children = nil
+			for i := 0; i < 0; i++ {
+				var elem ProcessNode
+				{
+					elem.SetFake()
+				}
+				s.Children = append(s.Children, elem)
+			}
+		}
 	}
-	s.SetTo(elem)
 }
 
 // SetFake set fake values.
-func (s *Pod) SetFake() {
+func (s *ProcessTree) SetFake() {
 	{
 		{
-			s.Name = "string"
+			s.Pod = "string"
 		}
 	}
 	{
@@ -137,36 +1753,117 @@ func (s *Pod) SetFake() {
 	}
 	{
 		{
-			s.Status = "string"
+			s.Application.SetFake()
 		}
 	}
 	{
 		{
-			s.Resources.SetFake()
+			s.Start = time.Now()
+		}
+	}
+	{
+		{
+			s.End = time.Now()
+		}
+	}
+	{
+		{
+			s.Truncated.SetFake()
+		}
+	}
+	{
+		{
+			s.Processes = nil
+			for i := 0; i < 0; i++ {
+				var elem ProcessNode
+				{
+					elem.SetFake()
+				}
+				s.Processes = append(s.Processes, elem)
+			}
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *PodResources) SetFake() {
+func (s *SecuritySummary) SetFake() {
 	{
 		{
-			s.CPUUsageTotalMillicores = float64(0)
+			s.Window = int(0)
 		}
 	}
 	{
 		{
-			s.MemUsageTotalBytes = int64(0)
+			s.Execs = int64(0)
 		}
 	}
 	{
 		{
-			s.NetRxBytesPerSecond = int64(0)
+			s.Binaries = int64(0)
 		}
 	}
+}
+
+// SetFake set fake values.
+func (s *Span) SetFake() {
 	{
 		{
-			s.NetTxBytesPerSecond = int64(0)
+			s.SpanID.SetFake()
+		}
+	}
+	{
+		{
+			s.ParentSpanID.SetFake()
+		}
+	}
+	{
+		{
+			s.Name = "string"
+		}
+	}
+	{
+		{
+			s.Service = "string"
+		}
+	}
+	{
+		{
+			s.Kind.SetFake()
+		}
+	}
+	{
+		{
+			s.Start = time.Now()
+		}
+	}
+	{
+		{
+			s.End = time.Now()
+		}
+	}
+	{
+		{
+			s.Status.SetFake()
+		}
+	}
+	{
+		{
+			s.StatusMessage.SetFake()
+		}
+	}
+	{
+		{
+			s.Namespace.SetFake()
+		}
+	}
+	{
+		{
+			s.Pod.SetFake()
+		}
+	}
+	{
+		{
+			s.Attributes.SetFake()
 		}
 	}
 }
@@ -180,6 +1877,42 @@ func (s *SpanID) SetFake() {
 	*s = SpanID(unwrapped)
 }
 
+// SetFake set fake values.
+func (s *SpanKind) SetFake() {
+	*s = SpanKindUnspecified
+}
+
+// SetFake set fake values.
+func (s *SpanStatus) SetFake() {
+	*s = SpanStatusUnset
+}
+
+// SetFake set fake values.
+func (s *Trace) SetFake() {
+	{
+		{
+			s.TraceID.SetFake()
+		}
+	}
+	{
+		{
+			s.Spans = nil
+			for i := 0; i < 0; i++ {
+				var elem Span
+				{
+					elem.SetFake()
+				}
+				s.Spans = append(s.Spans, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Flows.SetFake()
+		}
+	}
+}
+
 // SetFake set fake values.
 func (s *TraceID) SetFake() {
 	var unwrapped string
@@ -6,7 +6,21 @@ package oas
 type OperationName = string
 
 const (
-	GetApplicationOperation  OperationName = "GetApplication"
-	GetApplicationsOperation OperationName = "GetApplications"
-	GetHealthOperation       OperationName = "GetHealth"
+	GetApplicationOperation          OperationName = "GetApplication"
+	GetApplicationAlertsOperation    OperationName = "GetApplicationAlerts"
+	GetApplicationAnomaliesOperation OperationName = "GetApplicationAnomalies"
+	GetApplicationAuditOperation     OperationName = "GetApplicationAudit"
+	GetApplicationDNSOperation       OperationName = "GetApplicationDNS"
+	GetApplicationExecsOperation     OperationName = "GetApplicationExecs"
+	GetApplicationFlowsOperation     OperationName = "GetApplicationFlows"
+	GetApplicationHTTPOperation      OperationName = "GetApplicationHTTP"
+	GetApplicationKafkaOperation     OperationName = "GetApplicationKafka"
+	GetApplicationLogsOperation      OperationName = "GetApplicationLogs"
+	GetApplicationNetpolOperation    OperationName = "GetApplicationNetpol"
+	GetApplicationResourcesOperation OperationName = "GetApplicationResources"
+	GetApplicationsOperation         OperationName = "GetApplications"
+	GetHealthOperation               OperationName = "GetHealth"
+	GetPodProcessesOperation         OperationName = "GetPodProcesses"
+	GetTraceOperation                OperationName = "GetTrace"
+	WatchApplicationOperation        OperationName = "WatchApplication"
 )
@@ -4,335 +4,3424 @@ package oas
 
 import (
 	"fmt"
+	"io"
 	"time"
+
+	"github.com/go-faster/errors"
 )
 
 func (s *ErrorStatusCode) Error() string {
 	return fmt.Sprintf("code %d: %+v", s.StatusCode, s.Response)
 }
 
-// Ref: #/components/schemas/Application
-type Application struct {
-	// Application name.
-	Name string `json:"name"`
-	// Application namespace.
-	Namespace string `json:"namespace"`
+// Ref: #/components/schemas/AlertInstance
+type AlertInstance struct {
+	State AlertInstanceState `json:"state"`
+	// Time when alert became active.
+	Since OptDateTime `json:"since"`
+	// Value of alert expression.
+	Value       OptString `json:"value"`
+	Labels      LabelMap  `json:"labels"`
+	Annotations LabelMap  `json:"annotations"`
 }
 
-// GetName returns the value of Name.
-func (s *Application) GetName() string {
-	return s.Name
+// GetState returns the value of State.
+func (s *AlertInstance) GetState() AlertInstanceState {
+	return s.State
 }
 
-// GetNamespace returns the value of Namespace.
-func (s *Application) GetNamespace() string {
-	return s.Namespace
+// GetSince returns the value of Since.
+func (s *AlertInstance) GetSince() OptDateTime {
+	return s.Since
 }
 
-// SetName sets the value of Name.
-func (s *Application) SetName(val string) {
-	s.Name = val
+// GetValue returns the value of Value.
+func (s *AlertInstance) GetValue() OptString {
+	return s.Value
 }
 
-// SetNamespace sets the value of Namespace.
-func (s *Application) SetNamespace(val string) {
-	s.Namespace = val
+// GetLabels returns the value of Labels.
+func (s *AlertInstance) GetLabels() LabelMap {
+	return s.Labels
 }
 
-type ApplicationList []Application
+// GetAnnotations returns the value of Annotations.
+func (s *AlertInstance) GetAnnotations() LabelMap {
+	return s.Annotations
+}
 
-// Ref: #/components/schemas/ApplicationSummary
-type ApplicationSummary struct {
-	// Application name.
+// SetState sets the value of State.
+func (s *AlertInstance) SetState(val AlertInstanceState) {
+	s.State = val
+}
+
+// SetSince sets the value of Since.
+func (s *AlertInstance) SetSince(val OptDateTime) {
+	s.Since = val
+}
+
+// SetValue sets the value of Value.
+func (s *AlertInstance) SetValue(val OptString) {
+	s.Value = val
+}
+
+// SetLabels sets the value of Labels.
+func (s *AlertInstance) SetLabels(val LabelMap) {
+	s.Labels = val
+}
+
+// SetAnnotations sets the value of Annotations.
+func (s *AlertInstance) SetAnnotations(val LabelMap) {
+	s.Annotations = val
+}
+
+type AlertInstanceState string
+
+const (
+	AlertInstanceStatePending AlertInstanceState = "pending"
+	AlertInstanceStateFiring  AlertInstanceState = "firing"
+)
+
+// AllValues returns all AlertInstanceState values.
+func (AlertInstanceState) AllValues() []AlertInstanceState {
+	return []AlertInstanceState{
+		AlertInstanceStatePending,
+		AlertInstanceStateFiring,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s AlertInstanceState) MarshalText() ([]byte, error) {
+	switch s {
+	case AlertInstanceStatePending:
+		return []byte(s), nil
+	case AlertInstanceStateFiring:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *AlertInstanceState) UnmarshalText(data []byte) error {
+	switch AlertInstanceState(data) {
+	case AlertInstanceStatePending:
+		*s = AlertInstanceStatePending
+		return nil
+	case AlertInstanceStateFiring:
+		*s = AlertInstanceStateFiring
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/AlertRule
+type AlertRule struct {
+	// Alert name.
 	Name string `json:"name"`
-	// Application namespace.
-	Namespace string `json:"namespace"`
-	Pods      []Pod  `json:"pods"`
+	// Rule group name.
+	Group string         `json:"group"`
+	State AlertRuleState `json:"state"`
+	// Alert expression.
+	Query string `json:"query"`
+	// Duration expression must hold before firing.
+	Duration OptString `json:"duration"`
+	// Rule evaluation health.
+	Health      string    `json:"health"`
+	LastError   OptString `json:"last_error"`
+	Labels      LabelMap  `json:"labels"`
+	Annotations LabelMap  `json:"annotations"`
+	// Active alerts that match application.
+	Alerts []AlertInstance `json:"alerts"`
 }
 
 // GetName returns the value of Name.
-func (s *ApplicationSummary) GetName() string {
+func (s *AlertRule) GetName() string {
 	return s.Name
 }
 
-// GetNamespace returns the value of Namespace.
-func (s *ApplicationSummary) GetNamespace() string {
-	return s.Namespace
+// GetGroup returns the value of Group.
+func (s *AlertRule) GetGroup() string {
+	return s.Group
 }
 
-// GetPods returns the value of Pods.
-func (s *ApplicationSummary) GetPods() []Pod {
-	return s.Pods
+// GetState returns the value of State.
+func (s *AlertRule) GetState() AlertRuleState {
+	return s.State
+}
+
+// GetQuery returns the value of Query.
+func (s *AlertRule) GetQuery() string {
+	return s.Query
+}
+
+// GetDuration returns the value of Duration.
+func (s *AlertRule) GetDuration() OptString {
+	return s.Duration
+}
+
+// GetHealth returns the value of Health.
+func (s *AlertRule) GetHealth() string {
+	return s.Health
+}
+
+// GetLastError returns the value of LastError.
+func (s *AlertRule) GetLastError() OptString {
+	return s.LastError
+}
+
+// GetLabels returns the value of Labels.
+func (s *AlertRule) GetLabels() LabelMap {
+	return s.Labels
+}
+
+// GetAnnotations returns the value of Annotations.
+func (s *AlertRule) GetAnnotations() LabelMap {
+	return s.Annotations
+}
+
+// GetAlerts returns the value of Alerts.
+func (s *AlertRule) GetAlerts() []AlertInstance {
+	return s.Alerts
 }
 
 // SetName sets the value of Name.
-func (s *ApplicationSummary) SetName(val string) {
+func (s *AlertRule) SetName(val string) {
 	s.Name = val
 }
 
-// SetNamespace sets the value of Namespace.
-func (s *ApplicationSummary) SetNamespace(val string) {
-	s.Namespace = val
+// SetGroup sets the value of Group.
+func (s *AlertRule) SetGroup(val string) {
+	s.Group = val
 }
 
-// SetPods sets the value of Pods.
-func (s *ApplicationSummary) SetPods(val []Pod) {
-	s.Pods = val
+// SetState sets the value of State.
+func (s *AlertRule) SetState(val AlertRuleState) {
+	s.State = val
 }
 
-// Error occurred while processing request.
-// Ref: #/components/schemas/Error
-type Error struct {
-	// Human-readable error message.
-	ErrorMessage string     `json:"error_message"`
-	TraceID      OptTraceID `json:"trace_id"`
-	SpanID       OptSpanID  `json:"span_id"`
+// SetQuery sets the value of Query.
+func (s *AlertRule) SetQuery(val string) {
+	s.Query = val
 }
 
-// GetErrorMessage returns the value of ErrorMessage.
-func (s *Error) GetErrorMessage() string {
-	return s.ErrorMessage
+// SetDuration sets the value of Duration.
+func (s *AlertRule) SetDuration(val OptString) {
+	s.Duration = val
 }
 
-// GetTraceID returns the value of TraceID.
-func (s *Error) GetTraceID() OptTraceID {
-	return s.TraceID
+// SetHealth sets the value of Health.
+func (s *AlertRule) SetHealth(val string) {
+	s.Health = val
 }
 
-// GetSpanID returns the value of SpanID.
-func (s *Error) GetSpanID() OptSpanID {
-	return s.SpanID
+// SetLastError sets the value of LastError.
+func (s *AlertRule) SetLastError(val OptString) {
+	s.LastError = val
 }
 
-// SetErrorMessage sets the value of ErrorMessage.
-func (s *Error) SetErrorMessage(val string) {
-	s.ErrorMessage = val
+// SetLabels sets the value of Labels.
+func (s *AlertRule) SetLabels(val LabelMap) {
+	s.Labels = val
 }
 
-// SetTraceID sets the value of TraceID.
-func (s *Error) SetTraceID(val OptTraceID) {
-	s.TraceID = val
+// SetAnnotations sets the value of Annotations.
+func (s *AlertRule) SetAnnotations(val LabelMap) {
+	s.Annotations = val
 }
 
-// SetSpanID sets the value of SpanID.
-func (s *Error) SetSpanID(val OptSpanID) {
-	s.SpanID = val
+// SetAlerts sets the value of Alerts.
+func (s *AlertRule) SetAlerts(val []AlertInstance) {
+	s.Alerts = val
 }
 
-// ErrorStatusCode wraps Error with StatusCode.
-type ErrorStatusCode struct {
-	StatusCode int
-	Response   Error
+type AlertRuleState string
+
+const (
+	AlertRuleStateInactive AlertRuleState = "inactive"
+	AlertRuleStatePending  AlertRuleState = "pending"
+	AlertRuleStateFiring   AlertRuleState = "firing"
+)
+
+// AllValues returns all AlertRuleState values.
+func (AlertRuleState) AllValues() []AlertRuleState {
+	return []AlertRuleState{
+		AlertRuleStateInactive,
+		AlertRuleStatePending,
+		AlertRuleStateFiring,
+	}
 }
 
-// GetStatusCode returns the value of StatusCode.
-func (s *ErrorStatusCode) GetStatusCode() int {
-	return s.StatusCode
+// MarshalText implements encoding.TextMarshaler.
+func (s AlertRuleState) MarshalText() ([]byte, error) {
+	switch s {
+	case AlertRuleStateInactive:
+		return []byte(s), nil
+	case AlertRuleStatePending:
+		return []byte(s), nil
+	case AlertRuleStateFiring:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// GetResponse returns the value of Response.
-func (s *ErrorStatusCode) GetResponse() Error {
-	return s.Response
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *AlertRuleState) UnmarshalText(data []byte) error {
+	switch AlertRuleState(data) {
+	case AlertRuleStateInactive:
+		*s = AlertRuleStateInactive
+		return nil
+	case AlertRuleStatePending:
+		*s = AlertRuleStatePending
+		return nil
+	case AlertRuleStateFiring:
+		*s = AlertRuleStateFiring
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetStatusCode sets the value of StatusCode.
-func (s *ErrorStatusCode) SetStatusCode(val int) {
-	s.StatusCode = val
+// Ref: #/components/schemas/Anomaly
+type Anomaly struct {
+	// Binary if binary was not executed in image during training,
+	// parent if binary was executed during training, but not by
+	// this parent binary.
+	Kind AnomalyKind `json:"kind"`
+	// Container image id.
+	Image string `json:"image"`
+	// Executed binary.
+	Binary string `json:"binary"`
+	// Parent process binary.
+	ParentBinary OptString `json:"parent_binary"`
+	// Number of execs in window.
+	Count     int64     `json:"count"`
+	FirstSeen time.Time `json:"first_seen"`
+	LastSeen  time.Time `json:"last_seen"`
+	// Pod of the last exec.
+	Pod string `json:"pod"`
+	// Container of the last exec.
+	Container string `json:"container"`
+	// Tetragon process execution id of the last exec.
+	ExecID string `json:"exec_id"`
+	// Process arguments of the last exec.
+	Arguments string `json:"arguments"`
 }
 
-// SetResponse sets the value of Response.
-func (s *ErrorStatusCode) SetResponse(val Error) {
-	s.Response = val
+// GetKind returns the value of Kind.
+func (s *Anomaly) GetKind() AnomalyKind {
+	return s.Kind
 }
 
-// Ref: #/components/schemas/Health
-type Health struct {
-	// Health status.
-	Status string `json:"status"`
-	// Service version.
-	Version string `json:"version"`
-	// Service commit.
-	Commit string `json:"commit"`
-	// Service build date.
-	BuildDate time.Time `json:"build_date"`
+// GetImage returns the value of Image.
+func (s *Anomaly) GetImage() string {
+	return s.Image
 }
 
-// GetStatus returns the value of Status.
-func (s *Health) GetStatus() string {
-	return s.Status
+// GetBinary returns the value of Binary.
+func (s *Anomaly) GetBinary() string {
+	return s.Binary
 }
 
-// GetVersion returns the value of Version.
-func (s *Health) GetVersion() string {
-	return s.Version
+// GetParentBinary returns the value of ParentBinary.
+func (s *Anomaly) GetParentBinary() OptString {
+	return s.ParentBinary
 }
 
-// GetCommit returns the value of Commit.
-func (s *Health) GetCommit() string {
-	return s.Commit
+// GetCount returns the value of Count.
+func (s *Anomaly) GetCount() int64 {
+	return s.Count
 }
 
-// GetBuildDate returns the value of BuildDate.
-func (s *Health) GetBuildDate() time.Time {
-	return s.BuildDate
+// GetFirstSeen returns the value of FirstSeen.
+func (s *Anomaly) GetFirstSeen() time.Time {
+	return s.FirstSeen
 }
 
-// SetStatus sets the value of Status.
-func (s *Health) SetStatus(val string) {
-	s.Status = val
+// GetLastSeen returns the value of LastSeen.
+func (s *Anomaly) GetLastSeen() time.Time {
+	return s.LastSeen
 }
 
-// SetVersion sets the value of Version.
-func (s *Health) SetVersion(val string) {
-	s.Version = val
+// GetPod returns the value of Pod.
+func (s *Anomaly) GetPod() string {
+	return s.Pod
 }
 
-// SetCommit sets the value of Commit.
-func (s *Health) SetCommit(val string) {
-	s.Commit = val
+// GetContainer returns the value of Container.
+func (s *Anomaly) GetContainer() string {
+	return s.Container
 }
 
-// SetBuildDate sets the value of BuildDate.
-func (s *Health) SetBuildDate(val time.Time) {
-	s.BuildDate = val
+// GetExecID returns the value of ExecID.
+func (s *Anomaly) GetExecID() string {
+	return s.ExecID
 }
 
-// NewOptSpanID returns new OptSpanID with value set to v.
-func NewOptSpanID(v SpanID) OptSpanID {
-	return OptSpanID{
-		Value: v,
-		Set:   true,
-	}
+// GetArguments returns the value of Arguments.
+func (s *Anomaly) GetArguments() string {
+	return s.Arguments
 }
 
-// OptSpanID is optional SpanID.
-type OptSpanID struct {
-	Value SpanID
-	Set   bool
+// SetKind sets the value of Kind.
+func (s *Anomaly) SetKind(val AnomalyKind) {
+	s.Kind = val
 }
 
-// IsSet returns true if OptSpanID was set.
-func (o OptSpanID) IsSet() bool { return o.Set }
+// SetImage sets the value of Image.
+func (s *Anomaly) SetImage(val string) {
+	s.Image = val
+}
 
-// Reset unsets value.
-func (o *OptSpanID) Reset() {
-	var v SpanID
-	o.Value = v
-	o.Set = false
+// SetBinary sets the value of Binary.
+func (s *Anomaly) SetBinary(val string) {
+	s.Binary = val
 }
 
-// SetTo sets value to v.
-func (o *OptSpanID) SetTo(v SpanID) {
-	o.Set = true
-	o.Value = v
+// SetParentBinary sets the value of ParentBinary.
+func (s *Anomaly) SetParentBinary(val OptString) {
+	s.ParentBinary = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptSpanID) Get() (v SpanID, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetCount sets the value of Count.
+func (s *Anomaly) SetCount(val int64) {
+	s.Count = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptSpanID) Or(d SpanID) SpanID {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetFirstSeen sets the value of FirstSeen.
+func (s *Anomaly) SetFirstSeen(val time.Time) {
+	s.FirstSeen = val
 }
 
-// NewOptTraceID returns new OptTraceID with value set to v.
-func NewOptTraceID(v TraceID) OptTraceID {
-	return OptTraceID{
-		Value: v,
-		Set:   true,
-	}
+// SetLastSeen sets the value of LastSeen.
+func (s *Anomaly) SetLastSeen(val time.Time) {
+	s.LastSeen = val
 }
 
-// OptTraceID is optional TraceID.
-type OptTraceID struct {
-	Value TraceID
-	Set   bool
+// SetPod sets the value of Pod.
+func (s *Anomaly) SetPod(val string) {
+	s.Pod = val
 }
 
-// IsSet returns true if OptTraceID was set.
-func (o OptTraceID) IsSet() bool { return o.Set }
+// SetContainer sets the value of Container.
+func (s *Anomaly) SetContainer(val string) {
+	s.Container = val
+}
 
-// Reset unsets value.
-func (o *OptTraceID) Reset() {
-	var v TraceID
-	o.Value = v
-	o.Set = false
+// SetExecID sets the value of ExecID.
+func (s *Anomaly) SetExecID(val string) {
+	s.ExecID = val
 }
 
-// SetTo sets value to v.
-func (o *OptTraceID) SetTo(v TraceID) {
-	o.Set = true
-	o.Value = v
+// SetArguments sets the value of Arguments.
+func (s *Anomaly) SetArguments(val string) {
+	s.Arguments = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptTraceID) Get() (v TraceID, ok bool) {
-	if !o.Set {
-		return v, false
+// Binary if binary was not executed in image during training,
+// parent if binary was executed during training, but not by
+// this parent binary.
+type AnomalyKind string
+
+const (
+	AnomalyKindBinary AnomalyKind = "binary"
+	AnomalyKindParent AnomalyKind = "parent"
+)
+
+// AllValues returns all AnomalyKind values.
+func (AnomalyKind) AllValues() []AnomalyKind {
+	return []AnomalyKind{
+		AnomalyKindBinary,
+		AnomalyKindParent,
 	}
-	return o.Value, true
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptTraceID) Or(d TraceID) TraceID {
-	if v, ok := o.Get(); ok {
-		return v
+// MarshalText implements encoding.TextMarshaler.
+func (s AnomalyKind) MarshalText() ([]byte, error) {
+	switch s {
+	case AnomalyKindBinary:
+		return []byte(s), nil
+	case AnomalyKindParent:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
-	return d
 }
 
-// Ref: #/components/schemas/Pod
-type Pod struct {
-	// Pod name.
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *AnomalyKind) UnmarshalText(data []byte) error {
+	switch AnomalyKind(data) {
+	case AnomalyKindBinary:
+		*s = AnomalyKindBinary
+		return nil
+	case AnomalyKindParent:
+		*s = AnomalyKindParent
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/AnomalyReport
+type AnomalyReport struct {
+	// Application name.
 	Name string `json:"name"`
-	// Pod namespace.
-	Namespace string `json:"namespace"`
-	// Pod status.
-	Status    string       `json:"status"`
-	Resources PodResources `json:"resources"`
+	// Application namespace.
+	Namespace string    `json:"namespace"`
+	Start     time.Time `json:"start"`
+	End       time.Time `json:"end"`
+	// Baselines of images executed in window.
+	Images    []ImageBaseline `json:"images"`
+	Anomalies []Anomaly       `json:"anomalies"`
 }
 
 // GetName returns the value of Name.
-func (s *Pod) GetName() string {
+func (s *AnomalyReport) GetName() string {
 	return s.Name
 }
 
 // GetNamespace returns the value of Namespace.
-func (s *Pod) GetNamespace() string {
+func (s *AnomalyReport) GetNamespace() string {
 	return s.Namespace
 }
 
-// GetStatus returns the value of Status.
-func (s *Pod) GetStatus() string {
-	return s.Status
+// GetStart returns the value of Start.
+func (s *AnomalyReport) GetStart() time.Time {
+	return s.Start
 }
 
-// GetResources returns the value of Resources.
-func (s *Pod) GetResources() PodResources {
-	return s.Resources
+// GetEnd returns the value of End.
+func (s *AnomalyReport) GetEnd() time.Time {
+	return s.End
+}
+
+// GetImages returns the value of Images.
+func (s *AnomalyReport) GetImages() []ImageBaseline {
+	return s.Images
+}
+
+// GetAnomalies returns the value of Anomalies.
+func (s *AnomalyReport) GetAnomalies() []Anomaly {
+	return s.Anomalies
 }
 
 // SetName sets the value of Name.
-func (s *Pod) SetName(val string) {
+func (s *AnomalyReport) SetName(val string) {
 	s.Name = val
 }
 
 // SetNamespace sets the value of Namespace.
-func (s *Pod) SetNamespace(val string) {
+func (s *AnomalyReport) SetNamespace(val string) {
 	s.Namespace = val
 }
 
-// SetStatus sets the value of Status.
-func (s *Pod) SetStatus(val string) {
-	s.Status = val
+// SetStart sets the value of Start.
+func (s *AnomalyReport) SetStart(val time.Time) {
+	s.Start = val
+}
+
+// SetEnd sets the value of End.
+func (s *AnomalyReport) SetEnd(val time.Time) {
+	s.End = val
+}
+
+// SetImages sets the value of Images.
+func (s *AnomalyReport) SetImages(val []ImageBaseline) {
+	s.Images = val
+}
+
+// SetAnomalies sets the value of Anomalies.
+func (s *AnomalyReport) SetAnomalies(val []Anomaly) {
+	s.Anomalies = val
+}
+
+// Ref: #/components/schemas/Application
+type Application struct {
+	// Application name.
+	Name string `json:"name"`
+	// Application namespace.
+	Namespace string `json:"namespace"`
+}
+
+// GetName returns the value of Name.
+func (s *Application) GetName() string {
+	return s.Name
+}
+
+// GetNamespace returns the value of Namespace.
+func (s *Application) GetNamespace() string {
+	return s.Namespace
+}
+
+// SetName sets the value of Name.
+func (s *Application) SetName(val string) {
+	s.Name = val
+}
+
+// SetNamespace sets the value of Namespace.
+func (s *Application) SetNamespace(val string) {
+	s.Namespace = val
+}
+
+// Ref: #/components/schemas/ApplicationAlerts
+type ApplicationAlerts struct {
+	// Application name.
+	Name string `json:"name"`
+	// Application namespace.
+	Namespace string `json:"namespace"`
+	// Alerting rules, firing first.
+	Rules []AlertRule `json:"rules"`
+}
+
+// GetName returns the value of Name.
+func (s *ApplicationAlerts) GetName() string {
+	return s.Name
+}
+
+// GetNamespace returns the value of Namespace.
+func (s *ApplicationAlerts) GetNamespace() string {
+	return s.Namespace
+}
+
+// GetRules returns the value of Rules.
+func (s *ApplicationAlerts) GetRules() []AlertRule {
+	return s.Rules
+}
+
+// SetName sets the value of Name.
+func (s *ApplicationAlerts) SetName(val string) {
+	s.Name = val
+}
+
+// SetNamespace sets the value of Namespace.
+func (s *ApplicationAlerts) SetNamespace(val string) {
+	s.Namespace = val
+}
+
+// SetRules sets the value of Rules.
+func (s *ApplicationAlerts) SetRules(val []AlertRule) {
+	s.Rules = val
+}
+
+type ApplicationList []Application
+
+// Ref: #/components/schemas/ApplicationResources
+type ApplicationResources struct {
+	// Application name.
+	Name string `json:"name"`
+	// Application namespace.
+	Namespace string               `json:"namespace"`
+	Pods      []PodResourcesSeries `json:"pods"`
+}
+
+// GetName returns the value of Name.
+func (s *ApplicationResources) GetName() string {
+	return s.Name
+}
+
+// GetNamespace returns the value of Namespace.
+func (s *ApplicationResources) GetNamespace() string {
+	return s.Namespace
+}
+
+// GetPods returns the value of Pods.
+func (s *ApplicationResources) GetPods() []PodResourcesSeries {
+	return s.Pods
+}
+
+// SetName sets the value of Name.
+func (s *ApplicationResources) SetName(val string) {
+	s.Name = val
+}
+
+// SetNamespace sets the value of Namespace.
+func (s *ApplicationResources) SetNamespace(val string) {
+	s.Namespace = val
+}
+
+// SetPods sets the value of Pods.
+func (s *ApplicationResources) SetPods(val []PodResourcesSeries) {
+	s.Pods = val
+}
+
+// Ref: #/components/schemas/ApplicationSummary
+type ApplicationSummary struct {
+	// Application name.
+	Name string `json:"name"`
+	// Application namespace.
+	Namespace string `json:"namespace"`
+	Pods      []Pod  `json:"pods"`
+	// Recent events of application pods and their owners, newest first.
+	Events   []KubeEvent        `json:"events"`
+	Network  OptNetworkSummary  `json:"network"`
+	Security OptSecuritySummary `json:"security"`
+}
+
+// GetName returns the value of Name.
+func (s *ApplicationSummary) GetName() string {
+	return s.Name
+}
+
+// GetNamespace returns the value of Namespace.
+func (s *ApplicationSummary) GetNamespace() string {
+	return s.Namespace
+}
+
+// GetPods returns the value of Pods.
+func (s *ApplicationSummary) GetPods() []Pod {
+	return s.Pods
+}
+
+// GetEvents returns the value of Events.
+func (s *ApplicationSummary) GetEvents() []KubeEvent {
+	return s.Events
+}
+
+// GetNetwork returns the value of Network.
+func (s *ApplicationSummary) GetNetwork() OptNetworkSummary {
+	return s.Network
+}
+
+// GetSecurity returns the value of Security.
+func (s *ApplicationSummary) GetSecurity() OptSecuritySummary {
+	return s.Security
+}
+
+// SetName sets the value of Name.
+func (s *ApplicationSummary) SetName(val string) {
+	s.Name = val
+}
+
+// SetNamespace sets the value of Namespace.
+func (s *ApplicationSummary) SetNamespace(val string) {
+	s.Namespace = val
+}
+
+// SetPods sets the value of Pods.
+func (s *ApplicationSummary) SetPods(val []Pod) {
+	s.Pods = val
+}
+
+// SetEvents sets the value of Events.
+func (s *ApplicationSummary) SetEvents(val []KubeEvent) {
+	s.Events = val
+}
+
+// SetNetwork sets the value of Network.
+func (s *ApplicationSummary) SetNetwork(val OptNetworkSummary) {
+	s.Network = val
+}
+
+// SetSecurity sets the value of Security.
+func (s *ApplicationSummary) SetSecurity(val OptSecuritySummary) {
+	s.Security = val
+}
+
+// Ref: #/components/schemas/AuditChange
+type AuditChange struct {
+	// New - only in current window, same - in both windows,
+	// gone - only in baseline window.
+	Status AuditChangeStatus `json:"status"`
+	Flows  FlowDrop          `json:"flows"`
+	// Count in baseline window, count of flows is count in current window.
+	BaselineCount int64 `json:"baseline_count"`
+}
+
+// GetStatus returns the value of Status.
+func (s *AuditChange) GetStatus() AuditChangeStatus {
+	return s.Status
+}
+
+// GetFlows returns the value of Flows.
+func (s *AuditChange) GetFlows() FlowDrop {
+	return s.Flows
+}
+
+// GetBaselineCount returns the value of BaselineCount.
+func (s *AuditChange) GetBaselineCount() int64 {
+	return s.BaselineCount
+}
+
+// SetStatus sets the value of Status.
+func (s *AuditChange) SetStatus(val AuditChangeStatus) {
+	s.Status = val
+}
+
+// SetFlows sets the value of Flows.
+func (s *AuditChange) SetFlows(val FlowDrop) {
+	s.Flows = val
+}
+
+// SetBaselineCount sets the value of BaselineCount.
+func (s *AuditChange) SetBaselineCount(val int64) {
+	s.BaselineCount = val
+}
+
+// New - only in current window, same - in both windows,
+// gone - only in baseline window.
+type AuditChangeStatus string
+
+const (
+	AuditChangeStatusNew  AuditChangeStatus = "new"
+	AuditChangeStatusSame AuditChangeStatus = "same"
+	AuditChangeStatusGone AuditChangeStatus = "gone"
+)
+
+// AllValues returns all AuditChangeStatus values.
+func (AuditChangeStatus) AllValues() []AuditChangeStatus {
+	return []AuditChangeStatus{
+		AuditChangeStatusNew,
+		AuditChangeStatusSame,
+		AuditChangeStatusGone,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s AuditChangeStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case AuditChangeStatusNew:
+		return []byte(s), nil
+	case AuditChangeStatusSame:
+		return []byte(s), nil
+	case AuditChangeStatusGone:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *AuditChangeStatus) UnmarshalText(data []byte) error {
+	switch AuditChangeStatus(data) {
+	case AuditChangeStatusNew:
+		*s = AuditChangeStatusNew
+		return nil
+	case AuditChangeStatusSame:
+		*s = AuditChangeStatusSame
+		return nil
+	case AuditChangeStatusGone:
+		*s = AuditChangeStatusGone
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/AuditReport
+type AuditReport struct {
+	// Application name.
+	Name string `json:"name"`
+	// Application namespace.
+	Namespace     string        `json:"namespace"`
+	Start         time.Time     `json:"start"`
+	End           time.Time     `json:"end"`
+	BaselineStart time.Time     `json:"baseline_start"`
+	BaselineEnd   time.Time     `json:"baseline_end"`
+	Changes       []AuditChange `json:"changes"`
+}
+
+// GetName returns the value of Name.
+func (s *AuditReport) GetName() string {
+	return s.Name
+}
+
+// GetNamespace returns the value of Namespace.
+func (s *AuditReport) GetNamespace() string {
+	return s.Namespace
+}
+
+// GetStart returns the value of Start.
+func (s *AuditReport) GetStart() time.Time {
+	return s.Start
+}
+
+// GetEnd returns the value of End.
+func (s *AuditReport) GetEnd() time.Time {
+	return s.End
+}
+
+// GetBaselineStart returns the value of BaselineStart.
+func (s *AuditReport) GetBaselineStart() time.Time {
+	return s.BaselineStart
+}
+
+// GetBaselineEnd returns the value of BaselineEnd.
+func (s *AuditReport) GetBaselineEnd() time.Time {
+	return s.BaselineEnd
+}
+
+// GetChanges returns the value of Changes.
+func (s *AuditReport) GetChanges() []AuditChange {
+	return s.Changes
+}
+
+// SetName sets the value of Name.
+func (s *AuditReport) SetName(val string) {
+	s.Name = val
+}
+
+// SetNamespace sets the value of Namespace.
+func (s *AuditReport) SetNamespace(val string) {
+	s.Namespace = val
+}
+
+// SetStart sets the value of Start.
+func (s *AuditReport) SetStart(val time.Time) {
+	s.Start = val
+}
+
+// SetEnd sets the value of End.
+func (s *AuditReport) SetEnd(val time.Time) {
+	s.End = val
+}
+
+// SetBaselineStart sets the value of BaselineStart.
+func (s *AuditReport) SetBaselineStart(val time.Time) {
+	s.BaselineStart = val
+}
+
+// SetBaselineEnd sets the value of BaselineEnd.
+func (s *AuditReport) SetBaselineEnd(val time.Time) {
+	s.BaselineEnd = val
+}
+
+// SetChanges sets the value of Changes.
+func (s *AuditReport) SetChanges(val []AuditChange) {
+	s.Changes = val
+}
+
+type BearerAuth struct {
+	Token string
+	Roles []string
+}
+
+// GetToken returns the value of Token.
+func (s *BearerAuth) GetToken() string {
+	return s.Token
+}
+
+// GetRoles returns the value of Roles.
+func (s *BearerAuth) GetRoles() []string {
+	return s.Roles
+}
+
+// SetToken sets the value of Token.
+func (s *BearerAuth) SetToken(val string) {
+	s.Token = val
+}
+
+// SetRoles sets the value of Roles.
+func (s *BearerAuth) SetRoles(val []string) {
+	s.Roles = val
+}
+
+// Ref: #/components/schemas/ContainerStatus
+type ContainerStatus struct {
+	// Container name.
+	Name string `json:"name"`
+	// Whether container is init container.
+	Init     bool                 `json:"init"`
+	Ready    bool                 `json:"ready"`
+	Restarts int                  `json:"restarts"`
+	State    ContainerStatusState `json:"state"`
+	// Reason of waiting or terminated state.
+	Reason OptString `json:"reason"`
+	// Message of waiting or terminated state.
+	Message         OptString               `json:"message"`
+	StartedAt       OptDateTime             `json:"started_at"`
+	Terminated      OptContainerTermination `json:"terminated"`
+	LastTermination OptContainerTermination `json:"last_termination"`
+	// Whether current or last run was OOMKilled.
+	OomKilled bool `json:"oom_killed"`
+}
+
+// GetName returns the value of Name.
+func (s *ContainerStatus) GetName() string {
+	return s.Name
+}
+
+// GetInit returns the value of Init.
+func (s *ContainerStatus) GetInit() bool {
+	return s.Init
+}
+
+// GetReady returns the value of Ready.
+func (s *ContainerStatus) GetReady() bool {
+	return s.Ready
+}
+
+// GetRestarts returns the value of Restarts.
+func (s *ContainerStatus) GetRestarts() int {
+	return s.Restarts
+}
+
+// GetState returns the value of State.
+func (s *ContainerStatus) GetState() ContainerStatusState {
+	return s.State
+}
+
+// GetReason returns the value of Reason.
+func (s *ContainerStatus) GetReason() OptString {
+	return s.Reason
+}
+
+// GetMessage returns the value of Message.
+func (s *ContainerStatus) GetMessage() OptString {
+	return s.Message
+}
+
+// GetStartedAt returns the value of StartedAt.
+func (s *ContainerStatus) GetStartedAt() OptDateTime {
+	return s.StartedAt
+}
+
+// GetTerminated returns the value of Terminated.
+func (s *ContainerStatus) GetTerminated() OptContainerTermination {
+	return s.Terminated
+}
+
+// GetLastTermination returns the value of LastTermination.
+func (s *ContainerStatus) GetLastTermination() OptContainerTermination {
+	return s.LastTermination
+}
+
+// GetOomKilled returns the value of OomKilled.
+func (s *ContainerStatus) GetOomKilled() bool {
+	return s.OomKilled
+}
+
+// SetName sets the value of Name.
+func (s *ContainerStatus) SetName(val string) {
+	s.Name = val
+}
+
+// SetInit sets the value of Init.
+func (s *ContainerStatus) SetInit(val bool) {
+	s.Init = val
+}
+
+// SetReady sets the value of Ready.
+func (s *ContainerStatus) SetReady(val bool) {
+	s.Ready = val
+}
+
+// SetRestarts sets the value of Restarts.
+func (s *ContainerStatus) SetRestarts(val int) {
+	s.Restarts = val
+}
+
+// SetState sets the value of State.
+func (s *ContainerStatus) SetState(val ContainerStatusState) {
+	s.State = val
+}
+
+// SetReason sets the value of Reason.
+func (s *ContainerStatus) SetReason(val OptString) {
+	s.Reason = val
+}
+
+// SetMessage sets the value of Message.
+func (s *ContainerStatus) SetMessage(val OptString) {
+	s.Message = val
+}
+
+// SetStartedAt sets the value of StartedAt.
+func (s *ContainerStatus) SetStartedAt(val OptDateTime) {
+	s.StartedAt = val
+}
+
+// SetTerminated sets the value of Terminated.
+func (s *ContainerStatus) SetTerminated(val OptContainerTermination) {
+	s.Terminated = val
+}
+
+// SetLastTermination sets the value of LastTermination.
+func (s *ContainerStatus) SetLastTermination(val OptContainerTermination) {
+	s.LastTermination = val
+}
+
+// SetOomKilled sets the value of OomKilled.
+func (s *ContainerStatus) SetOomKilled(val bool) {
+	s.OomKilled = val
+}
+
+type ContainerStatusState string
+
+const (
+	ContainerStatusStateWaiting    ContainerStatusState = "waiting"
+	ContainerStatusStateRunning    ContainerStatusState = "running"
+	ContainerStatusStateTerminated ContainerStatusState = "terminated"
+)
+
+// AllValues returns all ContainerStatusState values.
+func (ContainerStatusState) AllValues() []ContainerStatusState {
+	return []ContainerStatusState{
+		ContainerStatusStateWaiting,
+		ContainerStatusStateRunning,
+		ContainerStatusStateTerminated,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ContainerStatusState) MarshalText() ([]byte, error) {
+	switch s {
+	case ContainerStatusStateWaiting:
+		return []byte(s), nil
+	case ContainerStatusStateRunning:
+		return []byte(s), nil
+	case ContainerStatusStateTerminated:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ContainerStatusState) UnmarshalText(data []byte) error {
+	switch ContainerStatusState(data) {
+	case ContainerStatusStateWaiting:
+		*s = ContainerStatusStateWaiting
+		return nil
+	case ContainerStatusStateRunning:
+		*s = ContainerStatusStateRunning
+		return nil
+	case ContainerStatusStateTerminated:
+		*s = ContainerStatusStateTerminated
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/ContainerTermination
+type ContainerTermination struct {
+	ExitCode   int32       `json:"exit_code"`
+	Signal     OptInt32    `json:"signal"`
+	Reason     OptString   `json:"reason"`
+	Message    OptString   `json:"message"`
+	FinishedAt OptDateTime `json:"finished_at"`
+}
+
+// GetExitCode returns the value of ExitCode.
+func (s *ContainerTermination) GetExitCode() int32 {
+	return s.ExitCode
+}
+
+// GetSignal returns the value of Signal.
+func (s *ContainerTermination) GetSignal() OptInt32 {
+	return s.Signal
+}
+
+// GetReason returns the value of Reason.
+func (s *ContainerTermination) GetReason() OptString {
+	return s.Reason
+}
+
+// GetMessage returns the value of Message.
+func (s *ContainerTermination) GetMessage() OptString {
+	return s.Message
+}
+
+// GetFinishedAt returns the value of FinishedAt.
+func (s *ContainerTermination) GetFinishedAt() OptDateTime {
+	return s.FinishedAt
+}
+
+// SetExitCode sets the value of ExitCode.
+func (s *ContainerTermination) SetExitCode(val int32) {
+	s.ExitCode = val
+}
+
+// SetSignal sets the value of Signal.
+func (s *ContainerTermination) SetSignal(val OptInt32) {
+	s.Signal = val
+}
+
+// SetReason sets the value of Reason.
+func (s *ContainerTermination) SetReason(val OptString) {
+	s.Reason = val
+}
+
+// SetMessage sets the value of Message.
+func (s *ContainerTermination) SetMessage(val OptString) {
+	s.Message = val
+}
+
+// SetFinishedAt sets the value of FinishedAt.
+func (s *ContainerTermination) SetFinishedAt(val OptDateTime) {
+	s.FinishedAt = val
+}
+
+// Ref: #/components/schemas/DNSDomain
+type DNSDomain struct {
+	Query string `json:"query"`
+	// Count of responses.
+	Lookups  int64 `json:"lookups"`
+	Nxdomain int64 `json:"nxdomain"`
+	Servfail int64 `json:"servfail"`
+	// Count of responses with other non-zero response code.
+	Errors int64 `json:"errors"`
+	// Count of lookups slower than 100ms.
+	Slow         int64   `json:"slow"`
+	LatencyP95Ms float64 `json:"latency_p95_ms"`
+	// Sample of response IPs.
+	Ips []string `json:"ips"`
+}
+
+// GetQuery returns the value of Query.
+func (s *DNSDomain) GetQuery() string {
+	return s.Query
+}
+
+// GetLookups returns the value of Lookups.
+func (s *DNSDomain) GetLookups() int64 {
+	return s.Lookups
+}
+
+// GetNxdomain returns the value of Nxdomain.
+func (s *DNSDomain) GetNxdomain() int64 {
+	return s.Nxdomain
+}
+
+// GetServfail returns the value of Servfail.
+func (s *DNSDomain) GetServfail() int64 {
+	return s.Servfail
+}
+
+// GetErrors returns the value of Errors.
+func (s *DNSDomain) GetErrors() int64 {
+	return s.Errors
+}
+
+// GetSlow returns the value of Slow.
+func (s *DNSDomain) GetSlow() int64 {
+	return s.Slow
+}
+
+// GetLatencyP95Ms returns the value of LatencyP95Ms.
+func (s *DNSDomain) GetLatencyP95Ms() float64 {
+	return s.LatencyP95Ms
+}
+
+// GetIps returns the value of Ips.
+func (s *DNSDomain) GetIps() []string {
+	return s.Ips
+}
+
+// SetQuery sets the value of Query.
+func (s *DNSDomain) SetQuery(val string) {
+	s.Query = val
+}
+
+// SetLookups sets the value of Lookups.
+func (s *DNSDomain) SetLookups(val int64) {
+	s.Lookups = val
+}
+
+// SetNxdomain sets the value of Nxdomain.
+func (s *DNSDomain) SetNxdomain(val int64) {
+	s.Nxdomain = val
+}
+
+// SetServfail sets the value of Servfail.
+func (s *DNSDomain) SetServfail(val int64) {
+	s.Servfail = val
+}
+
+// SetErrors sets the value of Errors.
+func (s *DNSDomain) SetErrors(val int64) {
+	s.Errors = val
+}
+
+// SetSlow sets the value of Slow.
+func (s *DNSDomain) SetSlow(val int64) {
+	s.Slow = val
+}
+
+// SetLatencyP95Ms sets the value of LatencyP95Ms.
+func (s *DNSDomain) SetLatencyP95Ms(val float64) {
+	s.LatencyP95Ms = val
+}
+
+// SetIps sets the value of Ips.
+func (s *DNSDomain) SetIps(val []string) {
+	s.Ips = val
+}
+
+// Ref: #/components/schemas/DNSFailure
+type DNSFailure struct {
+	Query string `json:"query"`
+	// DNS response code.
+	Rcode int   `json:"rcode"`
+	Count int64 `json:"count"`
+}
+
+// GetQuery returns the value of Query.
+func (s *DNSFailure) GetQuery() string {
+	return s.Query
+}
+
+// GetRcode returns the value of Rcode.
+func (s *DNSFailure) GetRcode() int {
+	return s.Rcode
+}
+
+// GetCount returns the value of Count.
+func (s *DNSFailure) GetCount() int64 {
+	return s.Count
+}
+
+// SetQuery sets the value of Query.
+func (s *DNSFailure) SetQuery(val string) {
+	s.Query = val
+}
+
+// SetRcode sets the value of Rcode.
+func (s *DNSFailure) SetRcode(val int) {
+	s.Rcode = val
+}
+
+// SetCount sets the value of Count.
+func (s *DNSFailure) SetCount(val int64) {
+	s.Count = val
+}
+
+// Ref: #/components/schemas/DNSReport
+type DNSReport struct {
+	// Application name.
+	Name string `json:"name"`
+	// Application namespace.
+	Namespace string `json:"namespace"`
+	// Window in seconds.
+	Window  int         `json:"window"`
+	Domains []DNSDomain `json:"domains"`
+	// External destinations, new first.
+	Egress []EgressDestination `json:"egress"`
+}
+
+// GetName returns the value of Name.
+func (s *DNSReport) GetName() string {
+	return s.Name
+}
+
+// GetNamespace returns the value of Namespace.
+func (s *DNSReport) GetNamespace() string {
+	return s.Namespace
+}
+
+// GetWindow returns the value of Window.
+func (s *DNSReport) GetWindow() int {
+	return s.Window
+}
+
+// GetDomains returns the value of Domains.
+func (s *DNSReport) GetDomains() []DNSDomain {
+	return s.Domains
+}
+
+// GetEgress returns the value of Egress.
+func (s *DNSReport) GetEgress() []EgressDestination {
+	return s.Egress
+}
+
+// SetName sets the value of Name.
+func (s *DNSReport) SetName(val string) {
+	s.Name = val
+}
+
+// SetNamespace sets the value of Namespace.
+func (s *DNSReport) SetNamespace(val string) {
+	s.Namespace = val
+}
+
+// SetWindow sets the value of Window.
+func (s *DNSReport) SetWindow(val int) {
+	s.Window = val
+}
+
+// SetDomains sets the value of Domains.
+func (s *DNSReport) SetDomains(val []DNSDomain) {
+	s.Domains = val
+}
+
+// SetEgress sets the value of Egress.
+func (s *DNSReport) SetEgress(val []EgressDestination) {
+	s.Egress = val
+}
+
+// Ref: #/components/schemas/DropReasonCount
+type DropReasonCount struct {
+	Reason string `json:"reason"`
+	Count  int64  `json:"count"`
+}
+
+// GetReason returns the value of Reason.
+func (s *DropReasonCount) GetReason() string {
+	return s.Reason
+}
+
+// GetCount returns the value of Count.
+func (s *DropReasonCount) GetCount() int64 {
+	return s.Count
+}
+
+// SetReason sets the value of Reason.
+func (s *DropReasonCount) SetReason(val string) {
+	s.Reason = val
+}
+
+// SetCount sets the value of Count.
+func (s *DropReasonCount) SetCount(val int64) {
+	s.Count = val
+}
+
+// Ref: #/components/schemas/EgressDestination
+type EgressDestination struct {
+	IP string `json:"ip"`
+	// DNS names of IP observed by Cilium.
+	Names    []string `json:"names"`
+	Protocol string   `json:"protocol"`
+	Port     uint32   `json:"port"`
+	// Count of flows in window.
+	Flows     int64     `json:"flows"`
+	FirstSeen time.Time `json:"first_seen"`
+	LastSeen  time.Time `json:"last_seen"`
+	// Destination was not reached before window.
+	New bool `json:"new"`
+}
+
+// GetIP returns the value of IP.
+func (s *EgressDestination) GetIP() string {
+	return s.IP
+}
+
+// GetNames returns the value of Names.
+func (s *EgressDestination) GetNames() []string {
+	return s.Names
+}
+
+// GetProtocol returns the value of Protocol.
+func (s *EgressDestination) GetProtocol() string {
+	return s.Protocol
+}
+
+// GetPort returns the value of Port.
+func (s *EgressDestination) GetPort() uint32 {
+	return s.Port
+}
+
+// GetFlows returns the value of Flows.
+func (s *EgressDestination) GetFlows() int64 {
+	return s.Flows
+}
+
+// GetFirstSeen returns the value of FirstSeen.
+func (s *EgressDestination) GetFirstSeen() time.Time {
+	return s.FirstSeen
+}
+
+// GetLastSeen returns the value of LastSeen.
+func (s *EgressDestination) GetLastSeen() time.Time {
+	return s.LastSeen
+}
+
+// GetNew returns the value of New.
+func (s *EgressDestination) GetNew() bool {
+	return s.New
+}
+
+// SetIP sets the value of IP.
+func (s *EgressDestination) SetIP(val string) {
+	s.IP = val
+}
+
+// SetNames sets the value of Names.
+func (s *EgressDestination) SetNames(val []string) {
+	s.Names = val
+}
+
+// SetProtocol sets the value of Protocol.
+func (s *EgressDestination) SetProtocol(val string) {
+	s.Protocol = val
+}
+
+// SetPort sets the value of Port.
+func (s *EgressDestination) SetPort(val uint32) {
+	s.Port = val
+}
+
+// SetFlows sets the value of Flows.
+func (s *EgressDestination) SetFlows(val int64) {
+	s.Flows = val
+}
+
+// SetFirstSeen sets the value of FirstSeen.
+func (s *EgressDestination) SetFirstSeen(val time.Time) {
+	s.FirstSeen = val
+}
+
+// SetLastSeen sets the value of LastSeen.
+func (s *EgressDestination) SetLastSeen(val time.Time) {
+	s.LastSeen = val
+}
+
+// SetNew sets the value of New.
+func (s *EgressDestination) SetNew(val bool) {
+	s.New = val
+}
+
+// Error occurred while processing request.
+// Ref: #/components/schemas/Error
+type Error struct {
+	// Human-readable error message.
+	ErrorMessage string     `json:"error_message"`
+	TraceID      OptTraceID `json:"trace_id"`
+	SpanID       OptSpanID  `json:"span_id"`
+}
+
+// GetErrorMessage returns the value of ErrorMessage.
+func (s *Error) GetErrorMessage() string {
+	return s.ErrorMessage
+}
+
+// GetTraceID returns the value of TraceID.
+func (s *Error) GetTraceID() OptTraceID {
+	return s.TraceID
+}
+
+// GetSpanID returns the value of SpanID.
+func (s *Error) GetSpanID() OptSpanID {
+	return s.SpanID
+}
+
+// SetErrorMessage sets the value of ErrorMessage.
+func (s *Error) SetErrorMessage(val string) {
+	s.ErrorMessage = val
+}
+
+// SetTraceID sets the value of TraceID.
+func (s *Error) SetTraceID(val OptTraceID) {
+	s.TraceID = val
+}
+
+// SetSpanID sets the value of SpanID.
+func (s *Error) SetSpanID(val OptSpanID) {
+	s.SpanID = val
+}
+
+// ErrorStatusCode wraps Error with StatusCode.
+type ErrorStatusCode struct {
+	StatusCode int
+	Response   Error
+}
+
+// GetStatusCode returns the value of StatusCode.
+func (s *ErrorStatusCode) GetStatusCode() int {
+	return s.StatusCode
+}
+
+// GetResponse returns the value of Response.
+func (s *ErrorStatusCode) GetResponse() Error {
+	return s.Response
+}
+
+// SetStatusCode sets the value of StatusCode.
+func (s *ErrorStatusCode) SetStatusCode(val int) {
+	s.StatusCode = val
+}
+
+// SetResponse sets the value of Response.
+func (s *ErrorStatusCode) SetResponse(val Error) {
+	s.Response = val
+}
+
+// Ref: #/components/schemas/Flow
+type Flow struct {
+	// Flow observation time.
+	Time time.Time `json:"time"`
+	// Flow verdict.
+	Verdict string `json:"verdict"`
+	// Drop reason, only for DROPPED verdict.
+	DropReason OptString `json:"drop_reason"`
+	// Traffic direction.
+	TrafficDirection string `json:"traffic_direction"`
+	// L4 protocol.
+	Protocol string   `json:"protocol"`
+	TCPFlags []string `json:"tcp_flags"`
+	// L7 summary.
+	L7      OptString  `json:"l7"`
+	TraceID OptTraceID `json:"trace_id"`
+	// Count of flows folded into this one by ingest aggregation, time is time of the first one.
+	Count OptInt64 `json:"count"`
+	// Time of the last folded flow.
+	LastTime    OptDateTime  `json:"last_time"`
+	Source      FlowEndpoint `json:"source"`
+	Destination FlowEndpoint `json:"destination"`
+}
+
+// GetTime returns the value of Time.
+func (s *Flow) GetTime() time.Time {
+	return s.Time
+}
+
+// GetVerdict returns the value of Verdict.
+func (s *Flow) GetVerdict() string {
+	return s.Verdict
+}
+
+// GetDropReason returns the value of DropReason.
+func (s *Flow) GetDropReason() OptString {
+	return s.DropReason
+}
+
+// GetTrafficDirection returns the value of TrafficDirection.
+func (s *Flow) GetTrafficDirection() string {
+	return s.TrafficDirection
+}
+
+// GetProtocol returns the value of Protocol.
+func (s *Flow) GetProtocol() string {
+	return s.Protocol
+}
+
+// GetTCPFlags returns the value of TCPFlags.
+func (s *Flow) GetTCPFlags() []string {
+	return s.TCPFlags
+}
+
+// GetL7 returns the value of L7.
+func (s *Flow) GetL7() OptString {
+	return s.L7
+}
+
+// GetTraceID returns the value of TraceID.
+func (s *Flow) GetTraceID() OptTraceID {
+	return s.TraceID
+}
+
+// GetCount returns the value of Count.
+func (s *Flow) GetCount() OptInt64 {
+	return s.Count
+}
+
+// GetLastTime returns the value of LastTime.
+func (s *Flow) GetLastTime() OptDateTime {
+	return s.LastTime
+}
+
+// GetSource returns the value of Source.
+func (s *Flow) GetSource() FlowEndpoint {
+	return s.Source
+}
+
+// GetDestination returns the value of Destination.
+func (s *Flow) GetDestination() FlowEndpoint {
+	return s.Destination
+}
+
+// SetTime sets the value of Time.
+func (s *Flow) SetTime(val time.Time) {
+	s.Time = val
+}
+
+// SetVerdict sets the value of Verdict.
+func (s *Flow) SetVerdict(val string) {
+	s.Verdict = val
+}
+
+// SetDropReason sets the value of DropReason.
+func (s *Flow) SetDropReason(val OptString) {
+	s.DropReason = val
+}
+
+// SetTrafficDirection sets the value of TrafficDirection.
+func (s *Flow) SetTrafficDirection(val string) {
+	s.TrafficDirection = val
+}
+
+// SetProtocol sets the value of Protocol.
+func (s *Flow) SetProtocol(val string) {
+	s.Protocol = val
+}
+
+// SetTCPFlags sets the value of TCPFlags.
+func (s *Flow) SetTCPFlags(val []string) {
+	s.TCPFlags = val
+}
+
+// SetL7 sets the value of L7.
+func (s *Flow) SetL7(val OptString) {
+	s.L7 = val
+}
+
+// SetTraceID sets the value of TraceID.
+func (s *Flow) SetTraceID(val OptTraceID) {
+	s.TraceID = val
+}
+
+// SetCount sets the value of Count.
+func (s *Flow) SetCount(val OptInt64) {
+	s.Count = val
+}
+
+// SetLastTime sets the value of LastTime.
+func (s *Flow) SetLastTime(val OptDateTime) {
+	s.LastTime = val
+}
+
+// SetSource sets the value of Source.
+func (s *Flow) SetSource(val FlowEndpoint) {
+	s.Source = val
+}
+
+// SetDestination sets the value of Destination.
+func (s *Flow) SetDestination(val FlowEndpoint) {
+	s.Destination = val
+}
+
+// Group of dropped flows between application and peer.
+// Ref: #/components/schemas/FlowDrop
+type FlowDrop struct {
+	// Direction relative to application.
+	Direction FlowDropDirection `json:"direction"`
+	// Peer workload, pod, DNS name or IP.
+	Peer string `json:"peer"`
+	// Cilium identity labels of peer.
+	PeerLabels []string `json:"peer_labels"`
+	// Peer IP, only for peers outside of cluster.
+	PeerIP   OptString `json:"peer_ip"`
+	Protocol string    `json:"protocol"`
+	// Destination port, ICMP type for ICMP.
+	Port   int    `json:"port"`
+	Reason string `json:"reason"`
+	// Comma-separated policies that denied flows, empty if denied by default.
+	Policy   OptString `json:"policy"`
+	Count    int64     `json:"count"`
+	LastSeen time.Time `json:"last_seen"`
+}
+
+// GetDirection returns the value of Direction.
+func (s *FlowDrop) GetDirection() FlowDropDirection {
+	return s.Direction
+}
+
+// GetPeer returns the value of Peer.
+func (s *FlowDrop) GetPeer() string {
+	return s.Peer
+}
+
+// GetPeerLabels returns the value of PeerLabels.
+func (s *FlowDrop) GetPeerLabels() []string {
+	return s.PeerLabels
+}
+
+// GetPeerIP returns the value of PeerIP.
+func (s *FlowDrop) GetPeerIP() OptString {
+	return s.PeerIP
+}
+
+// GetProtocol returns the value of Protocol.
+func (s *FlowDrop) GetProtocol() string {
+	return s.Protocol
+}
+
+// GetPort returns the value of Port.
+func (s *FlowDrop) GetPort() int {
+	return s.Port
+}
+
+// GetReason returns the value of Reason.
+func (s *FlowDrop) GetReason() string {
+	return s.Reason
+}
+
+// GetPolicy returns the value of Policy.
+func (s *FlowDrop) GetPolicy() OptString {
+	return s.Policy
+}
+
+// GetCount returns the value of Count.
+func (s *FlowDrop) GetCount() int64 {
+	return s.Count
+}
+
+// GetLastSeen returns the value of LastSeen.
+func (s *FlowDrop) GetLastSeen() time.Time {
+	return s.LastSeen
+}
+
+// SetDirection sets the value of Direction.
+func (s *FlowDrop) SetDirection(val FlowDropDirection) {
+	s.Direction = val
+}
+
+// SetPeer sets the value of Peer.
+func (s *FlowDrop) SetPeer(val string) {
+	s.Peer = val
+}
+
+// SetPeerLabels sets the value of PeerLabels.
+func (s *FlowDrop) SetPeerLabels(val []string) {
+	s.PeerLabels = val
+}
+
+// SetPeerIP sets the value of PeerIP.
+func (s *FlowDrop) SetPeerIP(val OptString) {
+	s.PeerIP = val
+}
+
+// SetProtocol sets the value of Protocol.
+func (s *FlowDrop) SetProtocol(val string) {
+	s.Protocol = val
+}
+
+// SetPort sets the value of Port.
+func (s *FlowDrop) SetPort(val int) {
+	s.Port = val
+}
+
+// SetReason sets the value of Reason.
+func (s *FlowDrop) SetReason(val string) {
+	s.Reason = val
+}
+
+// SetPolicy sets the value of Policy.
+func (s *FlowDrop) SetPolicy(val OptString) {
+	s.Policy = val
+}
+
+// SetCount sets the value of Count.
+func (s *FlowDrop) SetCount(val int64) {
+	s.Count = val
+}
+
+// SetLastSeen sets the value of LastSeen.
+func (s *FlowDrop) SetLastSeen(val time.Time) {
+	s.LastSeen = val
+}
+
+// Direction relative to application.
+type FlowDropDirection string
+
+const (
+	FlowDropDirectionIngress FlowDropDirection = "ingress"
+	FlowDropDirectionEgress  FlowDropDirection = "egress"
+)
+
+// AllValues returns all FlowDropDirection values.
+func (FlowDropDirection) AllValues() []FlowDropDirection {
+	return []FlowDropDirection{
+		FlowDropDirectionIngress,
+		FlowDropDirectionEgress,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s FlowDropDirection) MarshalText() ([]byte, error) {
+	switch s {
+	case FlowDropDirectionIngress:
+		return []byte(s), nil
+	case FlowDropDirectionEgress:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *FlowDropDirection) UnmarshalText(data []byte) error {
+	switch FlowDropDirection(data) {
+	case FlowDropDirectionIngress:
+		*s = FlowDropDirectionIngress
+		return nil
+	case FlowDropDirectionEgress:
+		*s = FlowDropDirectionEgress
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/FlowEndpoint
+type FlowEndpoint struct {
+	// Endpoint namespace.
+	Namespace OptString `json:"namespace"`
+	// Endpoint pod name.
+	Pod OptString `json:"pod"`
+	// Endpoint IP address.
+	IP string `json:"ip"`
+	// Endpoint L4 port.
+	Port OptInt `json:"port"`
+}
+
+// GetNamespace returns the value of Namespace.
+func (s *FlowEndpoint) GetNamespace() OptString {
+	return s.Namespace
+}
+
+// GetPod returns the value of Pod.
+func (s *FlowEndpoint) GetPod() OptString {
+	return s.Pod
+}
+
+// GetIP returns the value of IP.
+func (s *FlowEndpoint) GetIP() string {
+	return s.IP
+}
+
+// GetPort returns the value of Port.
+func (s *FlowEndpoint) GetPort() OptInt {
+	return s.Port
+}
+
+// SetNamespace sets the value of Namespace.
+func (s *FlowEndpoint) SetNamespace(val OptString) {
+	s.Namespace = val
+}
+
+// SetPod sets the value of Pod.
+func (s *FlowEndpoint) SetPod(val OptString) {
+	s.Pod = val
+}
+
+// SetIP sets the value of IP.
+func (s *FlowEndpoint) SetIP(val string) {
+	s.IP = val
+}
+
+// SetPort sets the value of Port.
+func (s *FlowEndpoint) SetPort(val OptInt) {
+	s.Port = val
+}
+
+type FlowList []Flow
+
+type GetApplicationLogsOKTextEventStream struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetApplicationLogsOKTextEventStream) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*GetApplicationLogsOKTextEventStream) getApplicationLogsRes() {}
+
+// Ref: #/components/schemas/HTTPEndpoint
+type HTTPEndpoint struct {
+	Method   string `json:"method"`
+	Route    string `json:"route"`
+	Requests int64  `json:"requests"`
+	// Requests per second.
+	Rate float64 `json:"rate"`
+	// Count of 4xx responses.
+	ClientErrors int64 `json:"client_errors"`
+	// Count of 5xx responses.
+	Errors int64 `json:"errors"`
+	// Ratio of 5xx responses.
+	ErrorRatio   float64 `json:"error_ratio"`
+	LatencyP50Ms float64 `json:"latency_p50_ms"`
+	LatencyP90Ms float64 `json:"latency_p90_ms"`
+	LatencyP99Ms float64 `json:"latency_p99_ms"`
+}
+
+// GetMethod returns the value of Method.
+func (s *HTTPEndpoint) GetMethod() string {
+	return s.Method
+}
+
+// GetRoute returns the value of Route.
+func (s *HTTPEndpoint) GetRoute() string {
+	return s.Route
+}
+
+// GetRequests returns the value of Requests.
+func (s *HTTPEndpoint) GetRequests() int64 {
+	return s.Requests
+}
+
+// GetRate returns the value of Rate.
+func (s *HTTPEndpoint) GetRate() float64 {
+	return s.Rate
+}
+
+// GetClientErrors returns the value of ClientErrors.
+func (s *HTTPEndpoint) GetClientErrors() int64 {
+	return s.ClientErrors
+}
+
+// GetErrors returns the value of Errors.
+func (s *HTTPEndpoint) GetErrors() int64 {
+	return s.Errors
+}
+
+// GetErrorRatio returns the value of ErrorRatio.
+func (s *HTTPEndpoint) GetErrorRatio() float64 {
+	return s.ErrorRatio
+}
+
+// GetLatencyP50Ms returns the value of LatencyP50Ms.
+func (s *HTTPEndpoint) GetLatencyP50Ms() float64 {
+	return s.LatencyP50Ms
+}
+
+// GetLatencyP90Ms returns the value of LatencyP90Ms.
+func (s *HTTPEndpoint) GetLatencyP90Ms() float64 {
+	return s.LatencyP90Ms
+}
+
+// GetLatencyP99Ms returns the value of LatencyP99Ms.
+func (s *HTTPEndpoint) GetLatencyP99Ms() float64 {
+	return s.LatencyP99Ms
+}
+
+// SetMethod sets the value of Method.
+func (s *HTTPEndpoint) SetMethod(val string) {
+	s.Method = val
+}
+
+// SetRoute sets the value of Route.
+func (s *HTTPEndpoint) SetRoute(val string) {
+	s.Route = val
+}
+
+// SetRequests sets the value of Requests.
+func (s *HTTPEndpoint) SetRequests(val int64) {
+	s.Requests = val
+}
+
+// SetRate sets the value of Rate.
+func (s *HTTPEndpoint) SetRate(val float64) {
+	s.Rate = val
+}
+
+// SetClientErrors sets the value of ClientErrors.
+func (s *HTTPEndpoint) SetClientErrors(val int64) {
+	s.ClientErrors = val
+}
+
+// SetErrors sets the value of Errors.
+func (s *HTTPEndpoint) SetErrors(val int64) {
+	s.Errors = val
+}
+
+// SetErrorRatio sets the value of ErrorRatio.
+func (s *HTTPEndpoint) SetErrorRatio(val float64) {
+	s.ErrorRatio = val
+}
+
+// SetLatencyP50Ms sets the value of LatencyP50Ms.
+func (s *HTTPEndpoint) SetLatencyP50Ms(val float64) {
+	s.LatencyP50Ms = val
+}
+
+// SetLatencyP90Ms sets the value of LatencyP90Ms.
+func (s *HTTPEndpoint) SetLatencyP90Ms(val float64) {
+	s.LatencyP90Ms = val
+}
+
+// SetLatencyP99Ms sets the value of LatencyP99Ms.
+func (s *HTTPEndpoint) SetLatencyP99Ms(val float64) {
+	s.LatencyP99Ms = val
+}
+
+// Ref: #/components/schemas/HTTPReport
+type HTTPReport struct {
+	// Application name.
+	Name string `json:"name"`
+	// Application namespace.
+	Namespace string `json:"namespace"`
+	// Window in seconds.
+	Window    int            `json:"window"`
+	Endpoints []HTTPEndpoint `json:"endpoints"`
+}
+
+// GetName returns the value of Name.
+func (s *HTTPReport) GetName() string {
+	return s.Name
+}
+
+// GetNamespace returns the value of Namespace.
+func (s *HTTPReport) GetNamespace() string {
+	return s.Namespace
+}
+
+// GetWindow returns the value of Window.
+func (s *HTTPReport) GetWindow() int {
+	return s.Window
+}
+
+// GetEndpoints returns the value of Endpoints.
+func (s *HTTPReport) GetEndpoints() []HTTPEndpoint {
+	return s.Endpoints
+}
+
+// SetName sets the value of Name.
+func (s *HTTPReport) SetName(val string) {
+	s.Name = val
+}
+
+// SetNamespace sets the value of Namespace.
+func (s *HTTPReport) SetNamespace(val string) {
+	s.Namespace = val
+}
+
+// SetWindow sets the value of Window.
+func (s *HTTPReport) SetWindow(val int) {
+	s.Window = val
+}
+
+// SetEndpoints sets the value of Endpoints.
+func (s *HTTPReport) SetEndpoints(val []HTTPEndpoint) {
+	s.Endpoints = val
+}
+
+// Ref: #/components/schemas/HTTPStatusCount
+type HTTPStatusCount struct {
+	Code  int   `json:"code"`
+	Count int64 `json:"count"`
+}
+
+// GetCode returns the value of Code.
+func (s *HTTPStatusCount) GetCode() int {
+	return s.Code
+}
+
+// GetCount returns the value of Count.
+func (s *HTTPStatusCount) GetCount() int64 {
+	return s.Count
+}
+
+// SetCode sets the value of Code.
+func (s *HTTPStatusCount) SetCode(val int) {
+	s.Code = val
+}
+
+// SetCount sets the value of Count.
+func (s *HTTPStatusCount) SetCount(val int64) {
+	s.Count = val
+}
+
+// Ref: #/components/schemas/Health
+type Health struct {
+	// Health status.
+	Status string `json:"status"`
+	// Service version.
+	Version string `json:"version"`
+	// Service commit.
+	Commit string `json:"commit"`
+	// Service build date.
+	BuildDate time.Time `json:"build_date"`
+}
+
+// GetStatus returns the value of Status.
+func (s *Health) GetStatus() string {
+	return s.Status
+}
+
+// GetVersion returns the value of Version.
+func (s *Health) GetVersion() string {
+	return s.Version
+}
+
+// GetCommit returns the value of Commit.
+func (s *Health) GetCommit() string {
+	return s.Commit
+}
+
+// GetBuildDate returns the value of BuildDate.
+func (s *Health) GetBuildDate() time.Time {
+	return s.BuildDate
+}
+
+// SetStatus sets the value of Status.
+func (s *Health) SetStatus(val string) {
+	s.Status = val
+}
+
+// SetVersion sets the value of Version.
+func (s *Health) SetVersion(val string) {
+	s.Version = val
+}
+
+// SetCommit sets the value of Commit.
+func (s *Health) SetCommit(val string) {
+	s.Commit = val
+}
+
+// SetBuildDate sets the value of BuildDate.
+func (s *Health) SetBuildDate(val time.Time) {
+	s.BuildDate = val
+}
+
+// Ref: #/components/schemas/ImageBaseline
+type ImageBaseline struct {
+	// Container image id.
+	Image string `json:"image"`
+	// Time of the first exec in image.
+	FirstSeen time.Time `json:"first_seen"`
+	// End of training window.
+	TrainedUntil time.Time `json:"trained_until"`
+	// Whether image is still in training window.
+	Training bool `json:"training"`
+	// Number of binaries in baseline.
+	Binaries int `json:"binaries"`
+	// Number of parent-to-child binary pairs in baseline.
+	Pairs int `json:"pairs"`
+}
+
+// GetImage returns the value of Image.
+func (s *ImageBaseline) GetImage() string {
+	return s.Image
+}
+
+// GetFirstSeen returns the value of FirstSeen.
+func (s *ImageBaseline) GetFirstSeen() time.Time {
+	return s.FirstSeen
+}
+
+// GetTrainedUntil returns the value of TrainedUntil.
+func (s *ImageBaseline) GetTrainedUntil() time.Time {
+	return s.TrainedUntil
+}
+
+// GetTraining returns the value of Training.
+func (s *ImageBaseline) GetTraining() bool {
+	return s.Training
+}
+
+// GetBinaries returns the value of Binaries.
+func (s *ImageBaseline) GetBinaries() int {
+	return s.Binaries
+}
+
+// GetPairs returns the value of Pairs.
+func (s *ImageBaseline) GetPairs() int {
+	return s.Pairs
+}
+
+// SetImage sets the value of Image.
+func (s *ImageBaseline) SetImage(val string) {
+	s.Image = val
+}
+
+// SetFirstSeen sets the value of FirstSeen.
+func (s *ImageBaseline) SetFirstSeen(val time.Time) {
+	s.FirstSeen = val
+}
+
+// SetTrainedUntil sets the value of TrainedUntil.
+func (s *ImageBaseline) SetTrainedUntil(val time.Time) {
+	s.TrainedUntil = val
+}
+
+// SetTraining sets the value of Training.
+func (s *ImageBaseline) SetTraining(val bool) {
+	s.Training = val
+}
+
+// SetBinaries sets the value of Binaries.
+func (s *ImageBaseline) SetBinaries(val int) {
+	s.Binaries = val
+}
+
+// SetPairs sets the value of Pairs.
+func (s *ImageBaseline) SetPairs(val int) {
+	s.Pairs = val
+}
+
+// Ref: #/components/schemas/KafkaReport
+type KafkaReport struct {
+	// Application name.
+	Name string `json:"name"`
+	// Application namespace.
+	Namespace string `json:"namespace"`
+	// Window in seconds.
+	Window int `json:"window"`
+	// Topics application produces to.
+	Produces []string `json:"produces"`
+	// Topics application consumes from.
+	Consumes []string        `json:"consumes"`
+	Requests []KafkaRequests `json:"requests"`
+}
+
+// GetName returns the value of Name.
+func (s *KafkaReport) GetName() string {
+	return s.Name
+}
+
+// GetNamespace returns the value of Namespace.
+func (s *KafkaReport) GetNamespace() string {
+	return s.Namespace
+}
+
+// GetWindow returns the value of Window.
+func (s *KafkaReport) GetWindow() int {
+	return s.Window
+}
+
+// GetProduces returns the value of Produces.
+func (s *KafkaReport) GetProduces() []string {
+	return s.Produces
+}
+
+// GetConsumes returns the value of Consumes.
+func (s *KafkaReport) GetConsumes() []string {
+	return s.Consumes
+}
+
+// GetRequests returns the value of Requests.
+func (s *KafkaReport) GetRequests() []KafkaRequests {
+	return s.Requests
+}
+
+// SetName sets the value of Name.
+func (s *KafkaReport) SetName(val string) {
+	s.Name = val
+}
+
+// SetNamespace sets the value of Namespace.
+func (s *KafkaReport) SetNamespace(val string) {
+	s.Namespace = val
+}
+
+// SetWindow sets the value of Window.
+func (s *KafkaReport) SetWindow(val int) {
+	s.Window = val
+}
+
+// SetProduces sets the value of Produces.
+func (s *KafkaReport) SetProduces(val []string) {
+	s.Produces = val
+}
+
+// SetConsumes sets the value of Consumes.
+func (s *KafkaReport) SetConsumes(val []string) {
+	s.Consumes = val
+}
+
+// SetRequests sets the value of Requests.
+func (s *KafkaReport) SetRequests(val []KafkaRequests) {
+	s.Requests = val
+}
+
+// Ref: #/components/schemas/KafkaRequests
+type KafkaRequests struct {
+	Topic  string `json:"topic"`
+	APIKey string `json:"api_key"`
+	// Broker workload, pod, DNS name or IP.
+	Broker   string `json:"broker"`
+	Requests int64  `json:"requests"`
+	// Requests per second.
+	Rate float64 `json:"rate"`
+	// Count of responses with non-zero error code.
+	Errors int64 `json:"errors"`
+	// Kafka error codes of responses.
+	ErrorCodes   []int32 `json:"error_codes"`
+	LatencyP50Ms float64 `json:"latency_p50_ms"`
+	LatencyP99Ms float64 `json:"latency_p99_ms"`
+}
+
+// GetTopic returns the value of Topic.
+func (s *KafkaRequests) GetTopic() string {
+	return s.Topic
+}
+
+// GetAPIKey returns the value of APIKey.
+func (s *KafkaRequests) GetAPIKey() string {
+	return s.APIKey
+}
+
+// GetBroker returns the value of Broker.
+func (s *KafkaRequests) GetBroker() string {
+	return s.Broker
+}
+
+// GetRequests returns the value of Requests.
+func (s *KafkaRequests) GetRequests() int64 {
+	return s.Requests
+}
+
+// GetRate returns the value of Rate.
+func (s *KafkaRequests) GetRate() float64 {
+	return s.Rate
+}
+
+// GetErrors returns the value of Errors.
+func (s *KafkaRequests) GetErrors() int64 {
+	return s.Errors
+}
+
+// GetErrorCodes returns the value of ErrorCodes.
+func (s *KafkaRequests) GetErrorCodes() []int32 {
+	return s.ErrorCodes
+}
+
+// GetLatencyP50Ms returns the value of LatencyP50Ms.
+func (s *KafkaRequests) GetLatencyP50Ms() float64 {
+	return s.LatencyP50Ms
+}
+
+// GetLatencyP99Ms returns the value of LatencyP99Ms.
+func (s *KafkaRequests) GetLatencyP99Ms() float64 {
+	return s.LatencyP99Ms
+}
+
+// SetTopic sets the value of Topic.
+func (s *KafkaRequests) SetTopic(val string) {
+	s.Topic = val
+}
+
+// SetAPIKey sets the value of APIKey.
+func (s *KafkaRequests) SetAPIKey(val string) {
+	s.APIKey = val
+}
+
+// SetBroker sets the value of Broker.
+func (s *KafkaRequests) SetBroker(val string) {
+	s.Broker = val
+}
+
+// SetRequests sets the value of Requests.
+func (s *KafkaRequests) SetRequests(val int64) {
+	s.Requests = val
+}
+
+// SetRate sets the value of Rate.
+func (s *KafkaRequests) SetRate(val float64) {
+	s.Rate = val
+}
+
+// SetErrors sets the value of Errors.
+func (s *KafkaRequests) SetErrors(val int64) {
+	s.Errors = val
+}
+
+// SetErrorCodes sets the value of ErrorCodes.
+func (s *KafkaRequests) SetErrorCodes(val []int32) {
+	s.ErrorCodes = val
+}
+
+// SetLatencyP50Ms sets the value of LatencyP50Ms.
+func (s *KafkaRequests) SetLatencyP50Ms(val float64) {
+	s.LatencyP50Ms = val
+}
+
+// SetLatencyP99Ms sets the value of LatencyP99Ms.
+func (s *KafkaRequests) SetLatencyP99Ms(val float64) {
+	s.LatencyP99Ms = val
+}
+
+// Ref: #/components/schemas/KubeEvent
+type KubeEvent struct {
+	// Last time event was observed.
+	Time    time.Time `json:"time"`
+	Type    string    `json:"type"`
+	Reason  string    `json:"reason"`
+	Message string    `json:"message"`
+	// Involved object as kind/name.
+	Object string `json:"object"`
+	Count  int    `json:"count"`
+}
+
+// GetTime returns the value of Time.
+func (s *KubeEvent) GetTime() time.Time {
+	return s.Time
+}
+
+// GetType returns the value of Type.
+func (s *KubeEvent) GetType() string {
+	return s.Type
+}
+
+// GetReason returns the value of Reason.
+func (s *KubeEvent) GetReason() string {
+	return s.Reason
+}
+
+// GetMessage returns the value of Message.
+func (s *KubeEvent) GetMessage() string {
+	return s.Message
+}
+
+// GetObject returns the value of Object.
+func (s *KubeEvent) GetObject() string {
+	return s.Object
+}
+
+// GetCount returns the value of Count.
+func (s *KubeEvent) GetCount() int {
+	return s.Count
+}
+
+// SetTime sets the value of Time.
+func (s *KubeEvent) SetTime(val time.Time) {
+	s.Time = val
+}
+
+// SetType sets the value of Type.
+func (s *KubeEvent) SetType(val string) {
+	s.Type = val
+}
+
+// SetReason sets the value of Reason.
+func (s *KubeEvent) SetReason(val string) {
+	s.Reason = val
+}
+
+// SetMessage sets the value of Message.
+func (s *KubeEvent) SetMessage(val string) {
+	s.Message = val
+}
+
+// SetObject sets the value of Object.
+func (s *KubeEvent) SetObject(val string) {
+	s.Object = val
+}
+
+// SetCount sets the value of Count.
+func (s *KubeEvent) SetCount(val int) {
+	s.Count = val
+}
+
+// Ref: #/components/schemas/LabelMap
+type LabelMap map[string]string
+
+func (s *LabelMap) init() LabelMap {
+	m := *s
+	if m == nil {
+		m = map[string]string{}
+		*s = m
+	}
+	return m
+}
+
+// Ref: #/components/schemas/LogEntry
+type LogEntry struct {
+	Time time.Time `json:"time"`
+	// Pod name.
+	Pod string `json:"pod"`
+	// Container name.
+	Container OptString `json:"container"`
+	// Log line without trailing newline.
+	Line string `json:"line"`
+}
+
+// GetTime returns the value of Time.
+func (s *LogEntry) GetTime() time.Time {
+	return s.Time
+}
+
+// GetPod returns the value of Pod.
+func (s *LogEntry) GetPod() string {
+	return s.Pod
+}
+
+// GetContainer returns the value of Container.
+func (s *LogEntry) GetContainer() OptString {
+	return s.Container
+}
+
+// GetLine returns the value of Line.
+func (s *LogEntry) GetLine() string {
+	return s.Line
+}
+
+// SetTime sets the value of Time.
+func (s *LogEntry) SetTime(val time.Time) {
+	s.Time = val
+}
+
+// SetPod sets the value of Pod.
+func (s *LogEntry) SetPod(val string) {
+	s.Pod = val
+}
+
+// SetContainer sets the value of Container.
+func (s *LogEntry) SetContainer(val OptString) {
+	s.Container = val
+}
+
+// SetLine sets the value of Line.
+func (s *LogEntry) SetLine(val string) {
+	s.Line = val
+}
+
+type LogEntryList []LogEntry
+
+func (*LogEntryList) getApplicationLogsRes() {}
+
+// Ref: #/components/schemas/NetpolReport
+type NetpolReport struct {
+	// Application name.
+	Name string `json:"name"`
+	// Application namespace.
+	Namespace string `json:"namespace"`
+	// Window in seconds.
+	Window int        `json:"window"`
+	Drops  []FlowDrop `json:"drops"`
+	// Candidate CiliumNetworkPolicy manifest in YAML, empty if no flows were denied by policy.
+	Policy string `json:"policy"`
+}
+
+// GetName returns the value of Name.
+func (s *NetpolReport) GetName() string {
+	return s.Name
+}
+
+// GetNamespace returns the value of Namespace.
+func (s *NetpolReport) GetNamespace() string {
+	return s.Namespace
+}
+
+// GetWindow returns the value of Window.
+func (s *NetpolReport) GetWindow() int {
+	return s.Window
+}
+
+// GetDrops returns the value of Drops.
+func (s *NetpolReport) GetDrops() []FlowDrop {
+	return s.Drops
+}
+
+// GetPolicy returns the value of Policy.
+func (s *NetpolReport) GetPolicy() string {
+	return s.Policy
+}
+
+// SetName sets the value of Name.
+func (s *NetpolReport) SetName(val string) {
+	s.Name = val
+}
+
+// SetNamespace sets the value of Namespace.
+func (s *NetpolReport) SetNamespace(val string) {
+	s.Namespace = val
+}
+
+// SetWindow sets the value of Window.
+func (s *NetpolReport) SetWindow(val int) {
+	s.Window = val
+}
+
+// SetDrops sets the value of Drops.
+func (s *NetpolReport) SetDrops(val []FlowDrop) {
+	s.Drops = val
+}
+
+// SetPolicy sets the value of Policy.
+func (s *NetpolReport) SetPolicy(val string) {
+	s.Policy = val
+}
+
+// Aggregated hubble flows of application pods over window.
+// Ref: #/components/schemas/NetworkSummary
+type NetworkSummary struct {
+	// Window in seconds.
+	Window  int   `json:"window"`
+	Flows   int64 `json:"flows"`
+	Dropped int64 `json:"dropped"`
+	// Count of DNS responses with non-zero rcode.
+	DNSFailures int64 `json:"dns_failures"`
+	// Peers with most flows.
+	TopPeers       []PeerTraffic     `json:"top_peers"`
+	DropReasons    []DropReasonCount `json:"drop_reasons"`
+	HTTPStatuses   []HTTPStatusCount `json:"http_statuses"`
+	TopDNSFailures []DNSFailure      `json:"top_dns_failures"`
+}
+
+// GetWindow returns the value of Window.
+func (s *NetworkSummary) GetWindow() int {
+	return s.Window
+}
+
+// GetFlows returns the value of Flows.
+func (s *NetworkSummary) GetFlows() int64 {
+	return s.Flows
+}
+
+// GetDropped returns the value of Dropped.
+func (s *NetworkSummary) GetDropped() int64 {
+	return s.Dropped
+}
+
+// GetDNSFailures returns the value of DNSFailures.
+func (s *NetworkSummary) GetDNSFailures() int64 {
+	return s.DNSFailures
+}
+
+// GetTopPeers returns the value of TopPeers.
+func (s *NetworkSummary) GetTopPeers() []PeerTraffic {
+	return s.TopPeers
+}
+
+// GetDropReasons returns the value of DropReasons.
+func (s *NetworkSummary) GetDropReasons() []DropReasonCount {
+	return s.DropReasons
+}
+
+// GetHTTPStatuses returns the value of HTTPStatuses.
+func (s *NetworkSummary) GetHTTPStatuses() []HTTPStatusCount {
+	return s.HTTPStatuses
+}
+
+// GetTopDNSFailures returns the value of TopDNSFailures.
+func (s *NetworkSummary) GetTopDNSFailures() []DNSFailure {
+	return s.TopDNSFailures
+}
+
+// SetWindow sets the value of Window.
+func (s *NetworkSummary) SetWindow(val int) {
+	s.Window = val
+}
+
+// SetFlows sets the value of Flows.
+func (s *NetworkSummary) SetFlows(val int64) {
+	s.Flows = val
+}
+
+// SetDropped sets the value of Dropped.
+func (s *NetworkSummary) SetDropped(val int64) {
+	s.Dropped = val
+}
+
+// SetDNSFailures sets the value of DNSFailures.
+func (s *NetworkSummary) SetDNSFailures(val int64) {
+	s.DNSFailures = val
+}
+
+// SetTopPeers sets the value of TopPeers.
+func (s *NetworkSummary) SetTopPeers(val []PeerTraffic) {
+	s.TopPeers = val
+}
+
+// SetDropReasons sets the value of DropReasons.
+func (s *NetworkSummary) SetDropReasons(val []DropReasonCount) {
+	s.DropReasons = val
+}
+
+// SetHTTPStatuses sets the value of HTTPStatuses.
+func (s *NetworkSummary) SetHTTPStatuses(val []HTTPStatusCount) {
+	s.HTTPStatuses = val
+}
+
+// SetTopDNSFailures sets the value of TopDNSFailures.
+func (s *NetworkSummary) SetTopDNSFailures(val []DNSFailure) {
+	s.TopDNSFailures = val
+}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptContainerTermination returns new OptContainerTermination with value set to v.
+func NewOptContainerTermination(v ContainerTermination) OptContainerTermination {
+	return OptContainerTermination{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptContainerTermination is optional ContainerTermination.
+type OptContainerTermination struct {
+	Value ContainerTermination
+	Set   bool
+}
+
+// IsSet returns true if OptContainerTermination was set.
+func (o OptContainerTermination) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptContainerTermination) Reset() {
+	var v ContainerTermination
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptContainerTermination) SetTo(v ContainerTermination) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptContainerTermination) Get() (v ContainerTermination, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptContainerTermination) Or(d ContainerTermination) ContainerTermination {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFloat64 returns new OptFloat64 with value set to v.
+func NewOptFloat64(v float64) OptFloat64 {
+	return OptFloat64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFloat64 is optional float64.
+type OptFloat64 struct {
+	Value float64
+	Set   bool
+}
+
+// IsSet returns true if OptFloat64 was set.
+func (o OptFloat64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFloat64) Reset() {
+	var v float64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFloat64) SetTo(v float64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFloat64) Get() (v float64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt32 returns new OptInt32 with value set to v.
+func NewOptInt32(v int32) OptInt32 {
+	return OptInt32{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt32 is optional int32.
+type OptInt32 struct {
+	Value int32
+	Set   bool
+}
+
+// IsSet returns true if OptInt32 was set.
+func (o OptInt32) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt32) Reset() {
+	var v int32
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt32) SetTo(v int32) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt32) Get() (v int32, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt32) Or(d int32) int32 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt64 returns new OptInt64 with value set to v.
+func NewOptInt64(v int64) OptInt64 {
+	return OptInt64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt64 is optional int64.
+type OptInt64 struct {
+	Value int64
+	Set   bool
+}
+
+// IsSet returns true if OptInt64 was set.
+func (o OptInt64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt64) Reset() {
+	var v int64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt64) SetTo(v int64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt64) Get() (v int64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt64) Or(d int64) int64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNetworkSummary returns new OptNetworkSummary with value set to v.
+func NewOptNetworkSummary(v NetworkSummary) OptNetworkSummary {
+	return OptNetworkSummary{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNetworkSummary is optional NetworkSummary.
+type OptNetworkSummary struct {
+	Value NetworkSummary
+	Set   bool
+}
+
+// IsSet returns true if OptNetworkSummary was set.
+func (o OptNetworkSummary) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNetworkSummary) Reset() {
+	var v NetworkSummary
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptNetworkSummary) SetTo(v NetworkSummary) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNetworkSummary) Get() (v NetworkSummary, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNetworkSummary) Or(d NetworkSummary) NetworkSummary {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptSecuritySummary returns new OptSecuritySummary with value set to v.
+func NewOptSecuritySummary(v SecuritySummary) OptSecuritySummary {
+	return OptSecuritySummary{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptSecuritySummary is optional SecuritySummary.
+type OptSecuritySummary struct {
+	Value SecuritySummary
+	Set   bool
+}
+
+// IsSet returns true if OptSecuritySummary was set.
+func (o OptSecuritySummary) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptSecuritySummary) Reset() {
+	var v SecuritySummary
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptSecuritySummary) SetTo(v SecuritySummary) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptSecuritySummary) Get() (v SecuritySummary, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptSecuritySummary) Or(d SecuritySummary) SecuritySummary {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptSpanID returns new OptSpanID with value set to v.
+func NewOptSpanID(v SpanID) OptSpanID {
+	return OptSpanID{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptSpanID is optional SpanID.
+type OptSpanID struct {
+	Value SpanID
+	Set   bool
+}
+
+// IsSet returns true if OptSpanID was set.
+func (o OptSpanID) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptSpanID) Reset() {
+	var v SpanID
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptSpanID) SetTo(v SpanID) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptSpanID) Get() (v SpanID, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptSpanID) Or(d SpanID) SpanID {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptTraceID returns new OptTraceID with value set to v.
+func NewOptTraceID(v TraceID) OptTraceID {
+	return OptTraceID{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptTraceID is optional TraceID.
+type OptTraceID struct {
+	Value TraceID
+	Set   bool
+}
+
+// IsSet returns true if OptTraceID was set.
+func (o OptTraceID) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptTraceID) Reset() {
+	var v TraceID
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptTraceID) SetTo(v TraceID) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptTraceID) Get() (v TraceID, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptTraceID) Or(d TraceID) TraceID {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUint32 returns new OptUint32 with value set to v.
+func NewOptUint32(v uint32) OptUint32 {
+	return OptUint32{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUint32 is optional uint32.
+type OptUint32 struct {
+	Value uint32
+	Set   bool
+}
+
+// IsSet returns true if OptUint32 was set.
+func (o OptUint32) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUint32) Reset() {
+	var v uint32
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUint32) SetTo(v uint32) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUint32) Get() (v uint32, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUint32) Or(d uint32) uint32 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// Ref: #/components/schemas/PeerTraffic
+type PeerTraffic struct {
+	// Peer workload, pod, DNS name or IP.
+	Peer    string `json:"peer"`
+	Flows   int64  `json:"flows"`
+	Dropped int64  `json:"dropped"`
+}
+
+// GetPeer returns the value of Peer.
+func (s *PeerTraffic) GetPeer() string {
+	return s.Peer
+}
+
+// GetFlows returns the value of Flows.
+func (s *PeerTraffic) GetFlows() int64 {
+	return s.Flows
+}
+
+// GetDropped returns the value of Dropped.
+func (s *PeerTraffic) GetDropped() int64 {
+	return s.Dropped
+}
+
+// SetPeer sets the value of Peer.
+func (s *PeerTraffic) SetPeer(val string) {
+	s.Peer = val
+}
+
+// SetFlows sets the value of Flows.
+func (s *PeerTraffic) SetFlows(val int64) {
+	s.Flows = val
+}
+
+// SetDropped sets the value of Dropped.
+func (s *PeerTraffic) SetDropped(val int64) {
+	s.Dropped = val
+}
+
+// Ref: #/components/schemas/Pod
+type Pod struct {
+	// Pod name.
+	Name string `json:"name"`
+	// Pod namespace.
+	Namespace string `json:"namespace"`
+	// Pod status.
+	Status string `json:"status"`
+	// Reason of pod status, e.g. Evicted.
+	Reason    OptString    `json:"reason"`
+	Resources PodResources `json:"resources"`
+	// Total restart count of pod containers.
+	Restarts   int               `json:"restarts"`
+	Containers []ContainerStatus `json:"containers"`
+}
+
+// GetName returns the value of Name.
+func (s *Pod) GetName() string {
+	return s.Name
+}
+
+// GetNamespace returns the value of Namespace.
+func (s *Pod) GetNamespace() string {
+	return s.Namespace
+}
+
+// GetStatus returns the value of Status.
+func (s *Pod) GetStatus() string {
+	return s.Status
+}
+
+// GetReason returns the value of Reason.
+func (s *Pod) GetReason() OptString {
+	return s.Reason
+}
+
+// GetResources returns the value of Resources.
+func (s *Pod) GetResources() PodResources {
+	return s.Resources
+}
+
+// GetRestarts returns the value of Restarts.
+func (s *Pod) GetRestarts() int {
+	return s.Restarts
+}
+
+// GetContainers returns the value of Containers.
+func (s *Pod) GetContainers() []ContainerStatus {
+	return s.Containers
+}
+
+// SetName sets the value of Name.
+func (s *Pod) SetName(val string) {
+	s.Name = val
+}
+
+// SetNamespace sets the value of Namespace.
+func (s *Pod) SetNamespace(val string) {
+	s.Namespace = val
+}
+
+// SetStatus sets the value of Status.
+func (s *Pod) SetStatus(val string) {
+	s.Status = val
+}
+
+// SetReason sets the value of Reason.
+func (s *Pod) SetReason(val OptString) {
+	s.Reason = val
 }
 
 // SetResources sets the value of Resources.
@@ -340,58 +3429,854 @@ func (s *Pod) SetResources(val PodResources) {
 	s.Resources = val
 }
 
-// Ref: #/components/schemas/PodResources
-type PodResources struct {
-	// Total CPU usage in millicores.
-	CPUUsageTotalMillicores float64 `json:"cpu_usage_total_millicores"`
-	// Total memory usage in bytes.
-	MemUsageTotalBytes int64 `json:"mem_usage_total_bytes"`
-	// Network receive bytes per second.
-	NetRxBytesPerSecond int64 `json:"net_rx_bytes_per_second"`
-	// Network transmit bytes per second.
-	NetTxBytesPerSecond int64 `json:"net_tx_bytes_per_second"`
+// SetRestarts sets the value of Restarts.
+func (s *Pod) SetRestarts(val int) {
+	s.Restarts = val
+}
+
+// SetContainers sets the value of Containers.
+func (s *Pod) SetContainers(val []ContainerStatus) {
+	s.Containers = val
+}
+
+// Ref: #/components/schemas/PodResources
+type PodResources struct {
+	// Total CPU usage in millicores.
+	CPUUsageTotalMillicores float64 `json:"cpu_usage_total_millicores"`
+	// Total memory usage in bytes.
+	MemUsageTotalBytes int64 `json:"mem_usage_total_bytes"`
+	// Network receive bytes per second.
+	NetRxBytesPerSecond int64 `json:"net_rx_bytes_per_second"`
+	// Network transmit bytes per second.
+	NetTxBytesPerSecond int64 `json:"net_tx_bytes_per_second"`
+}
+
+// GetCPUUsageTotalMillicores returns the value of CPUUsageTotalMillicores.
+func (s *PodResources) GetCPUUsageTotalMillicores() float64 {
+	return s.CPUUsageTotalMillicores
+}
+
+// GetMemUsageTotalBytes returns the value of MemUsageTotalBytes.
+func (s *PodResources) GetMemUsageTotalBytes() int64 {
+	return s.MemUsageTotalBytes
+}
+
+// GetNetRxBytesPerSecond returns the value of NetRxBytesPerSecond.
+func (s *PodResources) GetNetRxBytesPerSecond() int64 {
+	return s.NetRxBytesPerSecond
+}
+
+// GetNetTxBytesPerSecond returns the value of NetTxBytesPerSecond.
+func (s *PodResources) GetNetTxBytesPerSecond() int64 {
+	return s.NetTxBytesPerSecond
+}
+
+// SetCPUUsageTotalMillicores sets the value of CPUUsageTotalMillicores.
+func (s *PodResources) SetCPUUsageTotalMillicores(val float64) {
+	s.CPUUsageTotalMillicores = val
+}
+
+// SetMemUsageTotalBytes sets the value of MemUsageTotalBytes.
+func (s *PodResources) SetMemUsageTotalBytes(val int64) {
+	s.MemUsageTotalBytes = val
+}
+
+// SetNetRxBytesPerSecond sets the value of NetRxBytesPerSecond.
+func (s *PodResources) SetNetRxBytesPerSecond(val int64) {
+	s.NetRxBytesPerSecond = val
+}
+
+// SetNetTxBytesPerSecond sets the value of NetTxBytesPerSecond.
+func (s *PodResources) SetNetTxBytesPerSecond(val int64) {
+	s.NetTxBytesPerSecond = val
+}
+
+// Ref: #/components/schemas/PodResourcesPoint
+type PodResourcesPoint struct {
+	// Sample time.
+	Time      time.Time    `json:"time"`
+	Resources PodResources `json:"resources"`
+}
+
+// GetTime returns the value of Time.
+func (s *PodResourcesPoint) GetTime() time.Time {
+	return s.Time
+}
+
+// GetResources returns the value of Resources.
+func (s *PodResourcesPoint) GetResources() PodResources {
+	return s.Resources
+}
+
+// SetTime sets the value of Time.
+func (s *PodResourcesPoint) SetTime(val time.Time) {
+	s.Time = val
+}
+
+// SetResources sets the value of Resources.
+func (s *PodResourcesPoint) SetResources(val PodResources) {
+	s.Resources = val
+}
+
+// Ref: #/components/schemas/PodResourcesSeries
+type PodResourcesSeries struct {
+	// Pod name.
+	Pod    string              `json:"pod"`
+	Points []PodResourcesPoint `json:"points"`
+}
+
+// GetPod returns the value of Pod.
+func (s *PodResourcesSeries) GetPod() string {
+	return s.Pod
+}
+
+// GetPoints returns the value of Points.
+func (s *PodResourcesSeries) GetPoints() []PodResourcesPoint {
+	return s.Points
+}
+
+// SetPod sets the value of Pod.
+func (s *PodResourcesSeries) SetPod(val string) {
+	s.Pod = val
+}
+
+// SetPoints sets the value of Points.
+func (s *PodResourcesSeries) SetPoints(val []PodResourcesPoint) {
+	s.Points = val
+}
+
+// Ref: #/components/schemas/ProcessExec
+type ProcessExec struct {
+	// Execution time.
+	Time time.Time `json:"time"`
+	// Pod name.
+	Pod string `json:"pod"`
+	// Container name.
+	Container string `json:"container"`
+	// Tetragon process execution id.
+	ExecID string `json:"exec_id"`
+	// Executed binary.
+	Binary string `json:"binary"`
+	// Process arguments.
+	Arguments string `json:"arguments"`
+	// Process user id.
+	UID uint32 `json:"uid"`
+	// Tetragon parent process execution id.
+	ParentExecID OptString `json:"parent_exec_id"`
+	// Parent process binary.
+	ParentBinary OptString `json:"parent_binary"`
+}
+
+// GetTime returns the value of Time.
+func (s *ProcessExec) GetTime() time.Time {
+	return s.Time
+}
+
+// GetPod returns the value of Pod.
+func (s *ProcessExec) GetPod() string {
+	return s.Pod
+}
+
+// GetContainer returns the value of Container.
+func (s *ProcessExec) GetContainer() string {
+	return s.Container
+}
+
+// GetExecID returns the value of ExecID.
+func (s *ProcessExec) GetExecID() string {
+	return s.ExecID
+}
+
+// GetBinary returns the value of Binary.
+func (s *ProcessExec) GetBinary() string {
+	return s.Binary
+}
+
+// GetArguments returns the value of Arguments.
+func (s *ProcessExec) GetArguments() string {
+	return s.Arguments
+}
+
+// GetUID returns the value of UID.
+func (s *ProcessExec) GetUID() uint32 {
+	return s.UID
+}
+
+// GetParentExecID returns the value of ParentExecID.
+func (s *ProcessExec) GetParentExecID() OptString {
+	return s.ParentExecID
+}
+
+// GetParentBinary returns the value of ParentBinary.
+func (s *ProcessExec) GetParentBinary() OptString {
+	return s.ParentBinary
+}
+
+// SetTime sets the value of Time.
+func (s *ProcessExec) SetTime(val time.Time) {
+	s.Time = val
+}
+
+// SetPod sets the value of Pod.
+func (s *ProcessExec) SetPod(val string) {
+	s.Pod = val
+}
+
+// SetContainer sets the value of Container.
+func (s *ProcessExec) SetContainer(val string) {
+	s.Container = val
+}
+
+// SetExecID sets the value of ExecID.
+func (s *ProcessExec) SetExecID(val string) {
+	s.ExecID = val
 }
 
-// GetCPUUsageTotalMillicores returns the value of CPUUsageTotalMillicores.
-func (s *PodResources) GetCPUUsageTotalMillicores() float64 {
-	return s.CPUUsageTotalMillicores
+// SetBinary sets the value of Binary.
+func (s *ProcessExec) SetBinary(val string) {
+	s.Binary = val
 }
 
-// GetMemUsageTotalBytes returns the value of MemUsageTotalBytes.
-func (s *PodResources) GetMemUsageTotalBytes() int64 {
-	return s.MemUsageTotalBytes
+// SetArguments sets the value of Arguments.
+func (s *ProcessExec) SetArguments(val string) {
+	s.Arguments = val
 }
 
-// GetNetRxBytesPerSecond returns the value of NetRxBytesPerSecond.
-func (s *PodResources) GetNetRxBytesPerSecond() int64 {
-	return s.NetRxBytesPerSecond
+// SetUID sets the value of UID.
+func (s *ProcessExec) SetUID(val uint32) {
+	s.UID = val
 }
 
-// GetNetTxBytesPerSecond returns the value of NetTxBytesPerSecond.
-func (s *PodResources) GetNetTxBytesPerSecond() int64 {
-	return s.NetTxBytesPerSecond
+// SetParentExecID sets the value of ParentExecID.
+func (s *ProcessExec) SetParentExecID(val OptString) {
+	s.ParentExecID = val
 }
 
-// SetCPUUsageTotalMillicores sets the value of CPUUsageTotalMillicores.
-func (s *PodResources) SetCPUUsageTotalMillicores(val float64) {
-	s.CPUUsageTotalMillicores = val
+// SetParentBinary sets the value of ParentBinary.
+func (s *ProcessExec) SetParentBinary(val OptString) {
+	s.ParentBinary = val
 }
 
-// SetMemUsageTotalBytes sets the value of MemUsageTotalBytes.
-func (s *PodResources) SetMemUsageTotalBytes(val int64) {
-	s.MemUsageTotalBytes = val
+type ProcessExecList []ProcessExec
+
+// Ref: #/components/schemas/ProcessNode
+type ProcessNode struct {
+	// Tetragon process execution id.
+	ExecID string `json:"exec_id"`
+	// Process id.
+	Pid uint32 `json:"pid"`
+	// Process user id.
+	UID uint32 `json:"uid"`
+	// Executed binary.
+	Binary string `json:"binary"`
+	// Process arguments.
+	Arguments string `json:"arguments"`
+	// Process working directory.
+	Cwd OptString `json:"cwd"`
+	// Container name.
+	Container OptString `json:"container"`
+	// Process start time.
+	StartTime OptDateTime `json:"start_time"`
+	// Whether process exit was observed.
+	Exited bool `json:"exited"`
+	// Process exit time.
+	ExitTime OptDateTime `json:"exit_time"`
+	// Exit status.
+	ExitStatus OptUint32 `json:"exit_status"`
+	// Signal that terminated process.
+	ExitSignal OptString `json:"exit_signal"`
+	// Process duration in milliseconds, until now if not exited.
+	DurationMs OptFloat64 `json:"duration_ms"`
+	// Process is known only as parent of other processes.
+	Partial  bool          `json:"partial"`
+	Children []ProcessNode `json:"children"`
 }
 
-// SetNetRxBytesPerSecond sets the value of NetRxBytesPerSecond.
-func (s *PodResources) SetNetRxBytesPerSecond(val int64) {
-	s.NetRxBytesPerSecond = val
+// GetExecID returns the value of ExecID.
+func (s *ProcessNode) GetExecID() string {
+	return s.ExecID
 }
 
-// SetNetTxBytesPerSecond sets the value of NetTxBytesPerSecond.
-func (s *PodResources) SetNetTxBytesPerSecond(val int64) {
-	s.NetTxBytesPerSecond = val
+// GetPid returns the value of Pid.
+func (s *ProcessNode) GetPid() uint32 {
+	return s.Pid
+}
+
+// GetUID returns the value of UID.
+func (s *ProcessNode) GetUID() uint32 {
+	return s.UID
+}
+
+// GetBinary returns the value of Binary.
+func (s *ProcessNode) GetBinary() string {
+	return s.Binary
+}
+
+// GetArguments returns the value of Arguments.
+func (s *ProcessNode) GetArguments() string {
+	return s.Arguments
+}
+
+// GetCwd returns the value of Cwd.
+func (s *ProcessNode) GetCwd() OptString {
+	return s.Cwd
+}
+
+// GetContainer returns the value of Container.
+func (s *ProcessNode) GetContainer() OptString {
+	return s.Container
+}
+
+// GetStartTime returns the value of StartTime.
+func (s *ProcessNode) GetStartTime() OptDateTime {
+	return s.StartTime
+}
+
+// GetExited returns the value of Exited.
+func (s *ProcessNode) GetExited() bool {
+	return s.Exited
+}
+
+// GetExitTime returns the value of ExitTime.
+func (s *ProcessNode) GetExitTime() OptDateTime {
+	return s.ExitTime
+}
+
+// GetExitStatus returns the value of ExitStatus.
+func (s *ProcessNode) GetExitStatus() OptUint32 {
+	return s.ExitStatus
+}
+
+// GetExitSignal returns the value of ExitSignal.
+func (s *ProcessNode) GetExitSignal() OptString {
+	return s.ExitSignal
+}
+
+// GetDurationMs returns the value of DurationMs.
+func (s *ProcessNode) GetDurationMs() OptFloat64 {
+	return s.DurationMs
+}
+
+// GetPartial returns the value of Partial.
+func (s *ProcessNode) GetPartial() bool {
+	return s.Partial
+}
+
+// GetChildren returns the value of Children.
+func (s *ProcessNode) GetChildren() []ProcessNode {
+	return s.Children
+}
+
+// SetExecID sets the value of ExecID.
+func (s *ProcessNode) SetExecID(val string) {
+	s.ExecID = val
+}
+
+// SetPid sets the value of Pid.
+func (s *ProcessNode) SetPid(val uint32) {
+	s.Pid = val
+}
+
+// SetUID sets the value of UID.
+func (s *ProcessNode) SetUID(val uint32) {
+	s.UID = val
+}
+
+// SetBinary sets the value of Binary.
+func (s *ProcessNode) SetBinary(val string) {
+	s.Binary = val
+}
+
+// SetArguments sets the value of Arguments.
+func (s *ProcessNode) SetArguments(val string) {
+	s.Arguments = val
+}
+
+// SetCwd sets the value of Cwd.
+func (s *ProcessNode) SetCwd(val OptString) {
+	s.Cwd = val
+}
+
+// SetContainer sets the value of Container.
+func (s *ProcessNode) SetContainer(val OptString) {
+	s.Container = val
+}
+
+// SetStartTime sets the value of StartTime.
+func (s *ProcessNode) SetStartTime(val OptDateTime) {
+	s.StartTime = val
+}
+
+// SetExited sets the value of Exited.
+func (s *ProcessNode) SetExited(val bool) {
+	s.Exited = val
+}
+
+// SetExitTime sets the value of ExitTime.
+func (s *ProcessNode) SetExitTime(val OptDateTime) {
+	s.ExitTime = val
+}
+
+// SetExitStatus sets the value of ExitStatus.
+func (s *ProcessNode) SetExitStatus(val OptUint32) {
+	s.ExitStatus = val
+}
+
+// SetExitSignal sets the value of ExitSignal.
+func (s *ProcessNode) SetExitSignal(val OptString) {
+	s.ExitSignal = val
+}
+
+// SetDurationMs sets the value of DurationMs.
+func (s *ProcessNode) SetDurationMs(val OptFloat64) {
+	s.DurationMs = val
+}
+
+// SetPartial sets the value of Partial.
+func (s *ProcessNode) SetPartial(val bool) {
+	s.Partial = val
+}
+
+// SetChildren sets the value of Children.
+func (s *ProcessNode) SetChildren(val []ProcessNode) {
+	s.Children = val
+}
+
+// Ref: #/components/schemas/ProcessTree
+type ProcessTree struct {
+	// Pod name.
+	Pod string `json:"pod"`
+	// Pod namespace.
+	Namespace string `json:"namespace"`
+	// Application name.
+	Application OptString `json:"application"`
+	Start       time.Time `json:"start"`
+	End         time.Time `json:"end"`
+	// Whether events were limited and tree may be incomplete.
+	Truncated OptBool `json:"truncated"`
+	// Root processes.
+	Processes []ProcessNode `json:"processes"`
+}
+
+// GetPod returns the value of Pod.
+func (s *ProcessTree) GetPod() string {
+	return s.Pod
+}
+
+// GetNamespace returns the value of Namespace.
+func (s *ProcessTree) GetNamespace() string {
+	return s.Namespace
+}
+
+// GetApplication returns the value of Application.
+func (s *ProcessTree) GetApplication() OptString {
+	return s.Application
+}
+
+// GetStart returns the value of Start.
+func (s *ProcessTree) GetStart() time.Time {
+	return s.Start
+}
+
+// GetEnd returns the value of End.
+func (s *ProcessTree) GetEnd() time.Time {
+	return s.End
+}
+
+// GetTruncated returns the value of Truncated.
+func (s *ProcessTree) GetTruncated() OptBool {
+	return s.Truncated
+}
+
+// GetProcesses returns the value of Processes.
+func (s *ProcessTree) GetProcesses() []ProcessNode {
+	return s.Processes
+}
+
+// SetPod sets the value of Pod.
+func (s *ProcessTree) SetPod(val string) {
+	s.Pod = val
+}
+
+// SetNamespace sets the value of Namespace.
+func (s *ProcessTree) SetNamespace(val string) {
+	s.Namespace = val
+}
+
+// SetApplication sets the value of Application.
+func (s *ProcessTree) SetApplication(val OptString) {
+	s.Application = val
+}
+
+// SetStart sets the value of Start.
+func (s *ProcessTree) SetStart(val time.Time) {
+	s.Start = val
+}
+
+// SetEnd sets the value of End.
+func (s *ProcessTree) SetEnd(val time.Time) {
+	s.End = val
+}
+
+// SetTruncated sets the value of Truncated.
+func (s *ProcessTree) SetTruncated(val OptBool) {
+	s.Truncated = val
+}
+
+// SetProcesses sets the value of Processes.
+func (s *ProcessTree) SetProcesses(val []ProcessNode) {
+	s.Processes = val
+}
+
+// Aggregated tetragon events of application pods over window.
+// Ref: #/components/schemas/SecuritySummary
+type SecuritySummary struct {
+	// Window in seconds.
+	Window int `json:"window"`
+	// Count of process executions.
+	Execs int64 `json:"execs"`
+	// Count of distinct executed binaries.
+	Binaries int64 `json:"binaries"`
+}
+
+// GetWindow returns the value of Window.
+func (s *SecuritySummary) GetWindow() int {
+	return s.Window
+}
+
+// GetExecs returns the value of Execs.
+func (s *SecuritySummary) GetExecs() int64 {
+	return s.Execs
+}
+
+// GetBinaries returns the value of Binaries.
+func (s *SecuritySummary) GetBinaries() int64 {
+	return s.Binaries
+}
+
+// SetWindow sets the value of Window.
+func (s *SecuritySummary) SetWindow(val int) {
+	s.Window = val
+}
+
+// SetExecs sets the value of Execs.
+func (s *SecuritySummary) SetExecs(val int64) {
+	s.Execs = val
+}
+
+// SetBinaries sets the value of Binaries.
+func (s *SecuritySummary) SetBinaries(val int64) {
+	s.Binaries = val
+}
+
+// Ref: #/components/schemas/Span
+type Span struct {
+	SpanID       SpanID    `json:"span_id"`
+	ParentSpanID OptSpanID `json:"parent_span_id"`
+	Name         string    `json:"name"`
+	// Service name of span resource.
+	Service       string     `json:"service"`
+	Kind          SpanKind   `json:"kind"`
+	Start         time.Time  `json:"start"`
+	End           time.Time  `json:"end"`
+	Status        SpanStatus `json:"status"`
+	StatusMessage OptString  `json:"status_message"`
+	// Kubernetes namespace of span resource.
+	Namespace OptString `json:"namespace"`
+	// Kubernetes pod of span resource.
+	Pod        OptString `json:"pod"`
+	Attributes LabelMap  `json:"attributes"`
+}
+
+// GetSpanID returns the value of SpanID.
+func (s *Span) GetSpanID() SpanID {
+	return s.SpanID
+}
+
+// GetParentSpanID returns the value of ParentSpanID.
+func (s *Span) GetParentSpanID() OptSpanID {
+	return s.ParentSpanID
+}
+
+// GetName returns the value of Name.
+func (s *Span) GetName() string {
+	return s.Name
+}
+
+// GetService returns the value of Service.
+func (s *Span) GetService() string {
+	return s.Service
+}
+
+// GetKind returns the value of Kind.
+func (s *Span) GetKind() SpanKind {
+	return s.Kind
+}
+
+// GetStart returns the value of Start.
+func (s *Span) GetStart() time.Time {
+	return s.Start
+}
+
+// GetEnd returns the value of End.
+func (s *Span) GetEnd() time.Time {
+	return s.End
+}
+
+// GetStatus returns the value of Status.
+func (s *Span) GetStatus() SpanStatus {
+	return s.Status
+}
+
+// GetStatusMessage returns the value of StatusMessage.
+func (s *Span) GetStatusMessage() OptString {
+	return s.StatusMessage
+}
+
+// GetNamespace returns the value of Namespace.
+func (s *Span) GetNamespace() OptString {
+	return s.Namespace
+}
+
+// GetPod returns the value of Pod.
+func (s *Span) GetPod() OptString {
+	return s.Pod
+}
+
+// GetAttributes returns the value of Attributes.
+func (s *Span) GetAttributes() LabelMap {
+	return s.Attributes
+}
+
+// SetSpanID sets the value of SpanID.
+func (s *Span) SetSpanID(val SpanID) {
+	s.SpanID = val
+}
+
+// SetParentSpanID sets the value of ParentSpanID.
+func (s *Span) SetParentSpanID(val OptSpanID) {
+	s.ParentSpanID = val
+}
+
+// SetName sets the value of Name.
+func (s *Span) SetName(val string) {
+	s.Name = val
+}
+
+// SetService sets the value of Service.
+func (s *Span) SetService(val string) {
+	s.Service = val
+}
+
+// SetKind sets the value of Kind.
+func (s *Span) SetKind(val SpanKind) {
+	s.Kind = val
+}
+
+// SetStart sets the value of Start.
+func (s *Span) SetStart(val time.Time) {
+	s.Start = val
+}
+
+// SetEnd sets the value of End.
+func (s *Span) SetEnd(val time.Time) {
+	s.End = val
+}
+
+// SetStatus sets the value of Status.
+func (s *Span) SetStatus(val SpanStatus) {
+	s.Status = val
+}
+
+// SetStatusMessage sets the value of StatusMessage.
+func (s *Span) SetStatusMessage(val OptString) {
+	s.StatusMessage = val
+}
+
+// SetNamespace sets the value of Namespace.
+func (s *Span) SetNamespace(val OptString) {
+	s.Namespace = val
+}
+
+// SetPod sets the value of Pod.
+func (s *Span) SetPod(val OptString) {
+	s.Pod = val
+}
+
+// SetAttributes sets the value of Attributes.
+func (s *Span) SetAttributes(val LabelMap) {
+	s.Attributes = val
 }
 
 type SpanID string
 
+type SpanKind string
+
+const (
+	SpanKindUnspecified SpanKind = "unspecified"
+	SpanKindInternal    SpanKind = "internal"
+	SpanKindServer      SpanKind = "server"
+	SpanKindClient      SpanKind = "client"
+	SpanKindProducer    SpanKind = "producer"
+	SpanKindConsumer    SpanKind = "consumer"
+)
+
+// AllValues returns all SpanKind values.
+func (SpanKind) AllValues() []SpanKind {
+	return []SpanKind{
+		SpanKindUnspecified,
+		SpanKindInternal,
+		SpanKindServer,
+		SpanKindClient,
+		SpanKindProducer,
+		SpanKindConsumer,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SpanKind) MarshalText() ([]byte, error) {
+	switch s {
+	case SpanKindUnspecified:
+		return []byte(s), nil
+	case SpanKindInternal:
+		return []byte(s), nil
+	case SpanKindServer:
+		return []byte(s), nil
+	case SpanKindClient:
+		return []byte(s), nil
+	case SpanKindProducer:
+		return []byte(s), nil
+	case SpanKindConsumer:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SpanKind) UnmarshalText(data []byte) error {
+	switch SpanKind(data) {
+	case SpanKindUnspecified:
+		*s = SpanKindUnspecified
+		return nil
+	case SpanKindInternal:
+		*s = SpanKindInternal
+		return nil
+	case SpanKindServer:
+		*s = SpanKindServer
+		return nil
+	case SpanKindClient:
+		*s = SpanKindClient
+		return nil
+	case SpanKindProducer:
+		*s = SpanKindProducer
+		return nil
+	case SpanKindConsumer:
+		*s = SpanKindConsumer
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type SpanStatus string
+
+const (
+	SpanStatusUnset SpanStatus = "unset"
+	SpanStatusOk    SpanStatus = "ok"
+	SpanStatusError SpanStatus = "error"
+)
+
+// AllValues returns all SpanStatus values.
+func (SpanStatus) AllValues() []SpanStatus {
+	return []SpanStatus{
+		SpanStatusUnset,
+		SpanStatusOk,
+		SpanStatusError,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SpanStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case SpanStatusUnset:
+		return []byte(s), nil
+	case SpanStatusOk:
+		return []byte(s), nil
+	case SpanStatusError:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SpanStatus) UnmarshalText(data []byte) error {
+	switch SpanStatus(data) {
+	case SpanStatusUnset:
+		*s = SpanStatusUnset
+		return nil
+	case SpanStatusOk:
+		*s = SpanStatusOk
+		return nil
+	case SpanStatusError:
+		*s = SpanStatusError
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/Trace
+type Trace struct {
+	TraceID TraceID `json:"trace_id"`
+	// Spans ordered by start time.
+	Spans []Span   `json:"spans"`
+	Flows FlowList `json:"flows"`
+}
+
+// GetTraceID returns the value of TraceID.
+func (s *Trace) GetTraceID() TraceID {
+	return s.TraceID
+}
+
+// GetSpans returns the value of Spans.
+func (s *Trace) GetSpans() []Span {
+	return s.Spans
+}
+
+// GetFlows returns the value of Flows.
+func (s *Trace) GetFlows() FlowList {
+	return s.Flows
+}
+
+// SetTraceID sets the value of TraceID.
+func (s *Trace) SetTraceID(val TraceID) {
+	s.TraceID = val
+}
+
+// SetSpans sets the value of Spans.
+func (s *Trace) SetSpans(val []Span) {
+	s.Spans = val
+}
+
+// SetFlows sets the value of Flows.
+func (s *Trace) SetFlows(val FlowList) {
+	s.Flows = val
+}
+
 type TraceID string
+
+type WatchApplicationOK struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s WatchApplicationOK) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
@@ -14,6 +14,92 @@ type Handler interface {
 	//
 	// GET /applications/{name}
 	GetApplication(ctx context.Context, params GetApplicationParams) (*ApplicationSummary, error)
+	// GetApplicationAlerts implements getApplicationAlerts operation.
+	//
+	// Get alerting rules and active alerts of application.
+	//
+	// GET /applications/{name}/alerts
+	GetApplicationAlerts(ctx context.Context, params GetApplicationAlertsParams) (*ApplicationAlerts, error)
+	// GetApplicationAnomalies implements getApplicationAnomalies operation.
+	//
+	// Get process execs in application pods that are outside of baseline
+	// of container image, grouped by image, binary and parent binary.
+	// Baseline of image consists of binaries and parent-to-child binary
+	// pairs first executed during training window, that starts with the
+	// first exec in image. Images in training have no anomalies.
+	//
+	// GET /applications/{name}/anomalies
+	GetApplicationAnomalies(ctx context.Context, params GetApplicationAnomaliesParams) (*AnomalyReport, error)
+	// GetApplicationAudit implements getApplicationAudit operation.
+	//
+	// Get flows of application with AUDIT verdict, that would be dropped if
+	// policy was enforced, grouped by direction, peer identity, port and
+	// policy.
+	// Current window is compared with baseline window of the same length,
+	// that ends baseline_offset seconds before now.
+	//
+	// GET /applications/{name}/audit
+	GetApplicationAudit(ctx context.Context, params GetApplicationAuditParams) (*AuditReport, error)
+	// GetApplicationDNS implements getApplicationDNS operation.
+	//
+	// Get domains resolved by application with response codes and latency,
+	// and external destinations reached by application.
+	// External destination is new if it was not reached before window,
+	// within flow retention.
+	//
+	// GET /applications/{name}/dns
+	GetApplicationDNS(ctx context.Context, params GetApplicationDNSParams) (*DNSReport, error)
+	// GetApplicationExecs implements getApplicationExecs operation.
+	//
+	// Get recent tetragon process executions in application pods.
+	//
+	// GET /applications/{name}/execs
+	GetApplicationExecs(ctx context.Context, params GetApplicationExecsParams) (ProcessExecList, error)
+	// GetApplicationFlows implements getApplicationFlows operation.
+	//
+	// Get recent hubble flows of application pods.
+	//
+	// GET /applications/{name}/flows
+	GetApplicationFlows(ctx context.Context, params GetApplicationFlowsParams) (FlowList, error)
+	// GetApplicationHTTP implements getApplicationHTTP operation.
+	//
+	// Get rate, errors and latency of HTTP requests served by application
+	// per method and route, from L7-visible hubble flows.
+	// Route is URL path with identifiers like numbers and UUIDs collapsed,
+	// e.g. /users/{id}.
+	//
+	// GET /applications/{name}/http
+	GetApplicationHTTP(ctx context.Context, params GetApplicationHTTPParams) (*HTTPReport, error)
+	// GetApplicationKafka implements getApplicationKafka operation.
+	//
+	// Get Kafka requests of application as a client from L7-visible
+	// hubble flows, grouped by topic, api key and broker.
+	//
+	// GET /applications/{name}/kafka
+	GetApplicationKafka(ctx context.Context, params GetApplicationKafkaParams) (*KafkaReport, error)
+	// GetApplicationLogs implements getApplicationLogs operation.
+	//
+	// Get logs of all application pods.
+	// History is queried from Loki, or from pod log API if Loki is not
+	// configured. If follow is set, logs are streamed as server-sent events
+	// "log" carrying LogEntry: history first, then live tail of pods.
+	//
+	// GET /applications/{name}/logs
+	GetApplicationLogs(ctx context.Context, params GetApplicationLogsParams) (GetApplicationLogsRes, error)
+	// GetApplicationNetpol implements getApplicationNetpol operation.
+	//
+	// Get dropped flows of application grouped by direction, peer identity
+	// and port, with candidate CiliumNetworkPolicy that allows flows
+	// denied by policy.
+	//
+	// GET /applications/{name}/netpol
+	GetApplicationNetpol(ctx context.Context, params GetApplicationNetpolParams) (*NetpolReport, error)
+	// GetApplicationResources implements getApplicationResources operation.
+	//
+	// Get application resource usage history per pod.
+	//
+	// GET /applications/{name}/resources
+	GetApplicationResources(ctx context.Context, params GetApplicationResourcesParams) (*ApplicationResources, error)
 	// GetApplications implements getApplications operation.
 	//
 	// Get application list.
@@ -26,6 +112,31 @@ type Handler interface {
 	//
 	// GET /health
 	GetHealth(ctx context.Context) (*Health, error)
+	// GetPodProcesses implements getPodProcesses operation.
+	//
+	// Get process tree of application pod reconstructed from tetragon
+	// process exec and exit events, including short-lived processes.
+	// Processes started before window are included only as parents of
+	// processes from window.
+	//
+	// GET /pods/{pod}/processes
+	GetPodProcesses(ctx context.Context, params GetPodProcessesParams) (*ProcessTree, error)
+	// GetTrace implements getTrace operation.
+	//
+	// Get trace by id with hubble flows of the same trace.
+	// Spans and flows of namespaces that user can not access are omitted.
+	//
+	// GET /traces/{trace_id}
+	GetTrace(ctx context.Context, params GetTraceParams) (*Trace, error)
+	// WatchApplication implements watchApplication operation.
+	//
+	// Watch application state.
+	// Streams server-sent events. Events "pod_added", "pod_updated" and
+	// "pod_deleted" carry Pod, event "resources" carries ApplicationSummary
+	// with resource sample of all application pods.
+	//
+	// GET /applications/{name}/watch
+	WatchApplication(ctx context.Context, params WatchApplicationParams) (WatchApplicationOK, error)
 	// NewError creates *ErrorStatusCode from error returned by handler.
 	//
 	// Used for common default response.
@@ -35,18 +146,20 @@ type Handler interface {
 // Server implements http server based on OpenAPI v3 specification and
 // calls Handler to handle requests.
 type Server struct {
-	h Handler
+	h   Handler
+	sec SecurityHandler
 	baseServer
 }
 
 // NewServer creates new Server.
-func NewServer(h Handler, opts ...ServerOption) (*Server, error) {
+func NewServer(h Handler, sec SecurityHandler, opts ...ServerOption) (*Server, error) {
 	s, err := newServerConfig(opts...).baseServer()
 	if err != nil {
 		return nil, err
 	}
 	return &Server{
 		h:          h,
+		sec:        sec,
 		baseServer: s,
 	}, nil
 }
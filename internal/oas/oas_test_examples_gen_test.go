@@ -11,6 +11,90 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+func TestAlertInstance_EncodeDecode(t *testing.T) {
+	var typ AlertInstance
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 AlertInstance
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestAlertInstanceState_EncodeDecode(t *testing.T) {
+	var typ AlertInstanceState
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 AlertInstanceState
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestAlertRule_EncodeDecode(t *testing.T) {
+	var typ AlertRule
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 AlertRule
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestAlertRuleState_EncodeDecode(t *testing.T) {
+	var typ AlertRuleState
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 AlertRuleState
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestAnomaly_EncodeDecode(t *testing.T) {
+	var typ Anomaly
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 Anomaly
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestAnomalyKind_EncodeDecode(t *testing.T) {
+	var typ AnomalyKind
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 AnomalyKind
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestAnomalyReport_EncodeDecode(t *testing.T) {
+	var typ AnomalyReport
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 AnomalyReport
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
 func TestApplication_EncodeDecode(t *testing.T) {
 	var typ Application
 	typ.SetFake()
@@ -23,6 +107,18 @@ func TestApplication_EncodeDecode(t *testing.T) {
 	var typ2 Application
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
+func TestApplicationAlerts_EncodeDecode(t *testing.T) {
+	var typ ApplicationAlerts
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 ApplicationAlerts
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
 func TestApplicationList_EncodeDecode(t *testing.T) {
 	var typ ApplicationList
 	typ.SetFake()
@@ -35,6 +131,18 @@ func TestApplicationList_EncodeDecode(t *testing.T) {
 	var typ2 ApplicationList
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
+func TestApplicationResources_EncodeDecode(t *testing.T) {
+	var typ ApplicationResources
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 ApplicationResources
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
 func TestApplicationSummary_EncodeDecode(t *testing.T) {
 	var typ ApplicationSummary
 	typ.SetFake()
@@ -47,6 +155,138 @@ func TestApplicationSummary_EncodeDecode(t *testing.T) {
 	var typ2 ApplicationSummary
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
+func TestAuditChange_EncodeDecode(t *testing.T) {
+	var typ AuditChange
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 AuditChange
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestAuditChangeStatus_EncodeDecode(t *testing.T) {
+	var typ AuditChangeStatus
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 AuditChangeStatus
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestAuditReport_EncodeDecode(t *testing.T) {
+	var typ AuditReport
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 AuditReport
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestContainerStatus_EncodeDecode(t *testing.T) {
+	var typ ContainerStatus
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 ContainerStatus
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestContainerStatusState_EncodeDecode(t *testing.T) {
+	var typ ContainerStatusState
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 ContainerStatusState
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestContainerTermination_EncodeDecode(t *testing.T) {
+	var typ ContainerTermination
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 ContainerTermination
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestDNSDomain_EncodeDecode(t *testing.T) {
+	var typ DNSDomain
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 DNSDomain
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestDNSFailure_EncodeDecode(t *testing.T) {
+	var typ DNSFailure
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 DNSFailure
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestDNSReport_EncodeDecode(t *testing.T) {
+	var typ DNSReport
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 DNSReport
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestDropReasonCount_EncodeDecode(t *testing.T) {
+	var typ DropReasonCount
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 DropReasonCount
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestEgressDestination_EncodeDecode(t *testing.T) {
+	var typ EgressDestination
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 EgressDestination
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
 func TestError_EncodeDecode(t *testing.T) {
 	var typ Error
 	typ.SetFake()
@@ -59,6 +299,102 @@ func TestError_EncodeDecode(t *testing.T) {
 	var typ2 Error
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
+func TestFlow_EncodeDecode(t *testing.T) {
+	var typ Flow
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 Flow
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestFlowDrop_EncodeDecode(t *testing.T) {
+	var typ FlowDrop
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 FlowDrop
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestFlowDropDirection_EncodeDecode(t *testing.T) {
+	var typ FlowDropDirection
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 FlowDropDirection
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestFlowEndpoint_EncodeDecode(t *testing.T) {
+	var typ FlowEndpoint
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 FlowEndpoint
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestFlowList_EncodeDecode(t *testing.T) {
+	var typ FlowList
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 FlowList
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestHTTPEndpoint_EncodeDecode(t *testing.T) {
+	var typ HTTPEndpoint
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 HTTPEndpoint
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestHTTPReport_EncodeDecode(t *testing.T) {
+	var typ HTTPReport
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 HTTPReport
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestHTTPStatusCount_EncodeDecode(t *testing.T) {
+	var typ HTTPStatusCount
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 HTTPStatusCount
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
 func TestHealth_EncodeDecode(t *testing.T) {
 	var typ Health
 	typ.SetFake()
@@ -71,6 +407,128 @@ func TestHealth_EncodeDecode(t *testing.T) {
 	var typ2 Health
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
+func TestImageBaseline_EncodeDecode(t *testing.T) {
+	var typ ImageBaseline
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 ImageBaseline
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestKafkaReport_EncodeDecode(t *testing.T) {
+	var typ KafkaReport
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 KafkaReport
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestKafkaRequests_EncodeDecode(t *testing.T) {
+	var typ KafkaRequests
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 KafkaRequests
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestKubeEvent_EncodeDecode(t *testing.T) {
+	var typ KubeEvent
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 KubeEvent
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestLabelMap_EncodeDecode(t *testing.T) {
+	var typ LabelMap
+	typ = make(LabelMap)
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 LabelMap
+	typ2 = make(LabelMap)
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestLogEntry_EncodeDecode(t *testing.T) {
+	var typ LogEntry
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 LogEntry
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestLogEntryList_EncodeDecode(t *testing.T) {
+	var typ LogEntryList
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 LogEntryList
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestNetpolReport_EncodeDecode(t *testing.T) {
+	var typ NetpolReport
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 NetpolReport
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestNetworkSummary_EncodeDecode(t *testing.T) {
+	var typ NetworkSummary
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 NetworkSummary
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestPeerTraffic_EncodeDecode(t *testing.T) {
+	var typ PeerTraffic
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 PeerTraffic
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
 func TestPod_EncodeDecode(t *testing.T) {
 	var typ Pod
 	typ.SetFake()
@@ -95,6 +553,102 @@ func TestPodResources_EncodeDecode(t *testing.T) {
 	var typ2 PodResources
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
+func TestPodResourcesPoint_EncodeDecode(t *testing.T) {
+	var typ PodResourcesPoint
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 PodResourcesPoint
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestPodResourcesSeries_EncodeDecode(t *testing.T) {
+	var typ PodResourcesSeries
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 PodResourcesSeries
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestProcessExec_EncodeDecode(t *testing.T) {
+	var typ ProcessExec
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 ProcessExec
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestProcessExecList_EncodeDecode(t *testing.T) {
+	var typ ProcessExecList
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 ProcessExecList
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestProcessNode_EncodeDecode(t *testing.T) {
+	var typ ProcessNode
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 ProcessNode
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestProcessTree_EncodeDecode(t *testing.T) {
+	var typ ProcessTree
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 ProcessTree
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestSecuritySummary_EncodeDecode(t *testing.T) {
+	var typ SecuritySummary
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 SecuritySummary
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestSpan_EncodeDecode(t *testing.T) {
+	var typ Span
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 Span
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
 func TestSpanID_EncodeDecode(t *testing.T) {
 	var typ SpanID
 	typ.SetFake()
@@ -107,6 +661,42 @@ func TestSpanID_EncodeDecode(t *testing.T) {
 	var typ2 SpanID
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
+func TestSpanKind_EncodeDecode(t *testing.T) {
+	var typ SpanKind
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 SpanKind
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestSpanStatus_EncodeDecode(t *testing.T) {
+	var typ SpanStatus
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 SpanStatus
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestTrace_EncodeDecode(t *testing.T) {
+	var typ Trace
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 Trace
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
 func TestTraceID_EncodeDecode(t *testing.T) {
 	var typ TraceID
 	typ.SetFake()
@@ -22,6 +22,125 @@ func (UnimplementedHandler) GetApplication(ctx context.Context, params GetApplic
 	return r, ht.ErrNotImplemented
 }
 
+// GetApplicationAlerts implements getApplicationAlerts operation.
+//
+// Get alerting rules and active alerts of application.
+//
+// GET /applications/{name}/alerts
+func (UnimplementedHandler) GetApplicationAlerts(ctx context.Context, params GetApplicationAlertsParams) (r *ApplicationAlerts, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetApplicationAnomalies implements getApplicationAnomalies operation.
+//
+// Get process execs in application pods that are outside of baseline
+// of container image, grouped by image, binary and parent binary.
+// Baseline of image consists of binaries and parent-to-child binary
+// pairs first executed during training window, that starts with the
+// first exec in image. Images in training have no anomalies.
+//
+// GET /applications/{name}/anomalies
+func (UnimplementedHandler) GetApplicationAnomalies(ctx context.Context, params GetApplicationAnomaliesParams) (r *AnomalyReport, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetApplicationAudit implements getApplicationAudit operation.
+//
+// Get flows of application with AUDIT verdict, that would be dropped if
+// policy was enforced, grouped by direction, peer identity, port and
+// policy.
+// Current window is compared with baseline window of the same length,
+// that ends baseline_offset seconds before now.
+//
+// GET /applications/{name}/audit
+func (UnimplementedHandler) GetApplicationAudit(ctx context.Context, params GetApplicationAuditParams) (r *AuditReport, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetApplicationDNS implements getApplicationDNS operation.
+//
+// Get domains resolved by application with response codes and latency,
+// and external destinations reached by application.
+// External destination is new if it was not reached before window,
+// within flow retention.
+//
+// GET /applications/{name}/dns
+func (UnimplementedHandler) GetApplicationDNS(ctx context.Context, params GetApplicationDNSParams) (r *DNSReport, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetApplicationExecs implements getApplicationExecs operation.
+//
+// Get recent tetragon process executions in application pods.
+//
+// GET /applications/{name}/execs
+func (UnimplementedHandler) GetApplicationExecs(ctx context.Context, params GetApplicationExecsParams) (r ProcessExecList, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetApplicationFlows implements getApplicationFlows operation.
+//
+// Get recent hubble flows of application pods.
+//
+// GET /applications/{name}/flows
+func (UnimplementedHandler) GetApplicationFlows(ctx context.Context, params GetApplicationFlowsParams) (r FlowList, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetApplicationHTTP implements getApplicationHTTP operation.
+//
+// Get rate, errors and latency of HTTP requests served by application
+// per method and route, from L7-visible hubble flows.
+// Route is URL path with identifiers like numbers and UUIDs collapsed,
+// e.g. /users/{id}.
+//
+// GET /applications/{name}/http
+func (UnimplementedHandler) GetApplicationHTTP(ctx context.Context, params GetApplicationHTTPParams) (r *HTTPReport, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetApplicationKafka implements getApplicationKafka operation.
+//
+// Get Kafka requests of application as a client from L7-visible
+// hubble flows, grouped by topic, api key and broker.
+//
+// GET /applications/{name}/kafka
+func (UnimplementedHandler) GetApplicationKafka(ctx context.Context, params GetApplicationKafkaParams) (r *KafkaReport, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetApplicationLogs implements getApplicationLogs operation.
+//
+// Get logs of all application pods.
+// History is queried from Loki, or from pod log API if Loki is not
+// configured. If follow is set, logs are streamed as server-sent events
+// "log" carrying LogEntry: history first, then live tail of pods.
+//
+// GET /applications/{name}/logs
+func (UnimplementedHandler) GetApplicationLogs(ctx context.Context, params GetApplicationLogsParams) (r GetApplicationLogsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetApplicationNetpol implements getApplicationNetpol operation.
+//
+// Get dropped flows of application grouped by direction, peer identity
+// and port, with candidate CiliumNetworkPolicy that allows flows
+// denied by policy.
+//
+// GET /applications/{name}/netpol
+func (UnimplementedHandler) GetApplicationNetpol(ctx context.Context, params GetApplicationNetpolParams) (r *NetpolReport, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetApplicationResources implements getApplicationResources operation.
+//
+// Get application resource usage history per pod.
+//
+// GET /applications/{name}/resources
+func (UnimplementedHandler) GetApplicationResources(ctx context.Context, params GetApplicationResourcesParams) (r *ApplicationResources, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetApplications implements getApplications operation.
 //
 // Get application list.
@@ -40,6 +159,40 @@ func (UnimplementedHandler) GetHealth(ctx context.Context) (r *Health, _ error)
 	return r, ht.ErrNotImplemented
 }
 
+// GetPodProcesses implements getPodProcesses operation.
+//
+// Get process tree of application pod reconstructed from tetragon
+// process exec and exit events, including short-lived processes.
+// Processes started before window are included only as parents of
+// processes from window.
+//
+// GET /pods/{pod}/processes
+func (UnimplementedHandler) GetPodProcesses(ctx context.Context, params GetPodProcessesParams) (r *ProcessTree, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetTrace implements getTrace operation.
+//
+// Get trace by id with hubble flows of the same trace.
+// Spans and flows of namespaces that user can not access are omitted.
+//
+// GET /traces/{trace_id}
+func (UnimplementedHandler) GetTrace(ctx context.Context, params GetTraceParams) (r *Trace, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// WatchApplication implements watchApplication operation.
+//
+// Watch application state.
+// Streams server-sent events. Events "pod_added", "pod_updated" and
+// "pod_deleted" carry Pod, event "resources" carries ApplicationSummary
+// with resource sample of all application pods.
+//
+// GET /applications/{name}/watch
+func (UnimplementedHandler) WatchApplication(ctx context.Context, params WatchApplicationParams) (r WatchApplicationOK, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // NewError creates *ErrorStatusCode from error returned by handler.
 //
 // Used for common default response.
@@ -0,0 +1,73 @@
+package promproxy
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/go-faster/errors"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+// LabelNamespace is label that is enforced by proxy.
+const LabelNamespace = "namespace"
+
+// namespaceMatcher returns matcher that selects any of namespaces.
+func namespaceMatcher(namespaces []string) (*labels.Matcher, error) {
+	if len(namespaces) == 1 {
+		return labels.NewMatcher(labels.MatchEqual, LabelNamespace, namespaces[0])
+	}
+	quoted := make([]string, 0, len(namespaces))
+	for _, ns := range namespaces {
+		quoted = append(quoted, regexp.QuoteMeta(ns))
+	}
+	return labels.NewMatcher(labels.MatchRegexp, LabelNamespace, strings.Join(quoted, "|"))
+}
+
+// InjectQuery adds namespace matcher to every vector selector of PromQL query.
+func InjectQuery(query string, namespaces []string) (string, error) {
+	m, err := namespaceMatcher(namespaces)
+	if err != nil {
+		return "", errors.Wrap(err, "matcher")
+	}
+	expr, err := parser.ParseExpr(query)
+	if err != nil {
+		return "", errors.Wrap(err, "parse query")
+	}
+	parser.Inspect(expr, func(node parser.Node, _ []parser.Node) error {
+		if vs, ok := node.(*parser.VectorSelector); ok {
+			vs.LabelMatchers = append(vs.LabelMatchers, m)
+		}
+		return nil
+	})
+	return expr.String(), nil
+}
+
+// InjectSelectors adds namespace matcher to every series selector, like in
+// match[] parameter.
+//
+// If there are no selectors, single selector that matches namespaces is
+// returned, so result is never unrestricted.
+func InjectSelectors(selectors, namespaces []string) ([]string, error) {
+	m, err := namespaceMatcher(namespaces)
+	if err != nil {
+		return nil, errors.Wrap(err, "matcher")
+	}
+	if len(selectors) == 0 {
+		return []string{"{" + m.String() + "}"}, nil
+	}
+	out := make([]string, 0, len(selectors))
+	for _, s := range selectors {
+		matchers, err := parser.ParseMetricSelector(s)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse selector %q", s)
+		}
+		matchers = append(matchers, m)
+		parts := make([]string, 0, len(matchers))
+		for _, v := range matchers {
+			parts = append(parts, v.String())
+		}
+		out = append(out, "{"+strings.Join(parts, ", ")+"}")
+	}
+	return out, nil
+}
@@ -0,0 +1,58 @@
+package promproxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInjectQuery(t *testing.T) {
+	for _, tt := range []struct {
+		Query      string
+		Namespaces []string
+		Result     string
+	}{
+		{`up`, []string{"foo"}, `up{namespace="foo"}`},
+		{`up{job="a"}`, []string{"foo", "bar"}, `up{job="a",namespace=~"foo|bar"}`},
+		{`{__name__="up"}`, []string{"a.b"}, `{__name__="up",namespace="a.b"}`},
+		{
+			`sum(rate(http_requests_total{code=~"5.."}[5m])) by (pod) / on(pod) sum(rate(http_requests_total[5m])) by (pod)`,
+			[]string{"foo"},
+			`sum by (pod) (rate(http_requests_total{code=~"5..",namespace="foo"}[5m])) / on (pod) sum by (pod) (rate(http_requests_total{namespace="foo"}[5m]))`,
+		},
+		{
+			// Caller can not escape restriction with own matcher.
+			`up{namespace="other"}`,
+			[]string{"foo"},
+			`up{namespace="foo",namespace="other"}`,
+		},
+		{
+			`max_over_time(up[1h:5m]) or vector(1)`,
+			[]string{"foo"},
+			`max_over_time(up{namespace="foo"}[1h:5m]) or vector(1)`,
+		},
+		{`1 + 1`, []string{"foo"}, `1 + 1`},
+	} {
+		t.Run(tt.Query, func(t *testing.T) {
+			out, err := InjectQuery(tt.Query, tt.Namespaces)
+			require.NoError(t, err)
+			require.Equal(t, tt.Result, out)
+		})
+	}
+
+	_, err := InjectQuery(`sum(`, []string{"foo"})
+	require.Error(t, err)
+}
+
+func TestInjectSelectors(t *testing.T) {
+	out, err := InjectSelectors(nil, []string{"foo", "bar"})
+	require.NoError(t, err)
+	require.Equal(t, []string{`{namespace=~"foo|bar"}`}, out)
+
+	out, err = InjectSelectors([]string{`up`, `{job="a"}`}, []string{"foo"})
+	require.NoError(t, err)
+	require.Equal(t, []string{`{__name__="up", namespace="foo"}`, `{job="a", namespace="foo"}`}, out)
+
+	_, err = InjectSelectors([]string{`up{`}, []string{"foo"})
+	require.Error(t, err)
+}
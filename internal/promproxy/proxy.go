@@ -0,0 +1,232 @@
+// Package promproxy implements Prometheus API proxy that restricts series
+// to namespaces that user can access.
+package promproxy
+
+import (
+	"context"
+	"net/http"
+	"slices"
+
+	"github.com/go-faster/errors"
+	"github.com/ogen-go/ogen/ogenerrors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/go-faster/vega/internal/auth"
+	"github.com/go-faster/vega/internal/promapi"
+)
+
+var _ promapi.Handler = (*Proxy)(nil)
+
+// Proxy forwards Prometheus API requests to upstream, injecting namespace
+// matchers into every query and series selector.
+//
+// Expects authenticated user in request context, see auth.WithUser.
+type Proxy struct {
+	upstream *promapi.Client
+	kube     kubernetes.Interface
+}
+
+// New initializes new Proxy.
+func New(upstream *promapi.Client, kube kubernetes.Interface) *Proxy {
+	return &Proxy{
+		upstream: upstream,
+		kube:     kube,
+	}
+}
+
+func fail(code int, typ promapi.FailErrorType, msg string) *promapi.FailStatusCode {
+	return &promapi.FailStatusCode{
+		StatusCode: code,
+		Response: promapi.Fail{
+			Status:    "error",
+			ErrorType: typ,
+			Error:     msg,
+		},
+	}
+}
+
+// namespaces returns namespaces that user can access.
+//
+// Returns nil slice if user can access everything and no rewriting is needed.
+func (p *Proxy) namespaces(ctx context.Context) ([]string, error) {
+	user, ok := auth.UserFromContext(ctx)
+	if !ok {
+		return nil, fail(http.StatusUnauthorized, promapi.FailErrorTypeBadData, "unauthenticated")
+	}
+	if user.Admin {
+		return nil, nil
+	}
+	list, err := p.kube.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "list namespaces")
+	}
+	var out []string
+	for _, ns := range list.Items {
+		if user.CanAccess(&ns) {
+			out = append(out, ns.Name)
+		}
+	}
+	if len(out) == 0 {
+		return nil, fail(http.StatusForbidden, promapi.FailErrorTypeBadData, "no accessible namespaces")
+	}
+	slices.Sort(out)
+	return out, nil
+}
+
+func (p *Proxy) query(ctx context.Context, query string) (string, error) {
+	namespaces, err := p.namespaces(ctx)
+	if err != nil || namespaces == nil {
+		return query, err
+	}
+	out, err := InjectQuery(query, namespaces)
+	if err != nil {
+		return "", fail(http.StatusBadRequest, promapi.FailErrorTypeBadData, err.Error())
+	}
+	return out, nil
+}
+
+func (p *Proxy) selectors(ctx context.Context, match []string) ([]string, error) {
+	namespaces, err := p.namespaces(ctx)
+	if err != nil || namespaces == nil {
+		return match, err
+	}
+	out, err := InjectSelectors(match, namespaces)
+	if err != nil {
+		return nil, fail(http.StatusBadRequest, promapi.FailErrorTypeBadData, err.Error())
+	}
+	return out, nil
+}
+
+// GetLabelValues implements promapi.Handler.
+func (p *Proxy) GetLabelValues(ctx context.Context, params promapi.GetLabelValuesParams) (*promapi.LabelValuesResponse, error) {
+	var err error
+	if params.Match, err = p.selectors(ctx, params.Match); err != nil {
+		return nil, err
+	}
+	return p.upstream.GetLabelValues(ctx, params)
+}
+
+// GetLabels implements promapi.Handler.
+func (p *Proxy) GetLabels(ctx context.Context, params promapi.GetLabelsParams) (*promapi.LabelsResponse, error) {
+	var err error
+	if params.Match, err = p.selectors(ctx, params.Match); err != nil {
+		return nil, err
+	}
+	return p.upstream.GetLabels(ctx, params)
+}
+
+// GetMetadata implements promapi.Handler.
+//
+// Metadata does not contain series, so it is not restricted.
+func (p *Proxy) GetMetadata(ctx context.Context, params promapi.GetMetadataParams) (*promapi.MetadataResponse, error) {
+	return p.upstream.GetMetadata(ctx, params)
+}
+
+// GetQuery implements promapi.Handler.
+func (p *Proxy) GetQuery(ctx context.Context, params promapi.GetQueryParams) (*promapi.QueryResponse, error) {
+	var err error
+	if params.Query, err = p.query(ctx, params.Query); err != nil {
+		return nil, err
+	}
+	return p.upstream.GetQuery(ctx, params)
+}
+
+// GetQueryExemplars implements promapi.Handler.
+func (p *Proxy) GetQueryExemplars(ctx context.Context, params promapi.GetQueryExemplarsParams) (*promapi.QueryExemplarsResponse, error) {
+	var err error
+	if params.Query, err = p.query(ctx, params.Query); err != nil {
+		return nil, err
+	}
+	return p.upstream.GetQueryExemplars(ctx, params)
+}
+
+// GetQueryRange implements promapi.Handler.
+func (p *Proxy) GetQueryRange(ctx context.Context, params promapi.GetQueryRangeParams) (*promapi.QueryResponse, error) {
+	var err error
+	if params.Query, err = p.query(ctx, params.Query); err != nil {
+		return nil, err
+	}
+	return p.upstream.GetQueryRange(ctx, params)
+}
+
+// GetRules implements promapi.Handler.
+//
+// Rules expose queries and alerts of every namespace, so only admins
+// can list them.
+func (p *Proxy) GetRules(ctx context.Context, params promapi.GetRulesParams) (*promapi.RulesResponse, error) {
+	namespaces, err := p.namespaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if namespaces != nil {
+		return nil, fail(http.StatusForbidden, promapi.FailErrorTypeBadData, "rules are available only to admins")
+	}
+	return p.upstream.GetRules(ctx, params)
+}
+
+// GetSeries implements promapi.Handler.
+func (p *Proxy) GetSeries(ctx context.Context, params promapi.GetSeriesParams) (*promapi.SeriesResponse, error) {
+	var err error
+	if params.Match, err = p.selectors(ctx, params.Match); err != nil {
+		return nil, err
+	}
+	return p.upstream.GetSeries(ctx, params)
+}
+
+// PostLabels implements promapi.Handler.
+func (p *Proxy) PostLabels(ctx context.Context, req *promapi.LabelsForm) (*promapi.LabelsResponse, error) {
+	var err error
+	if req.Match, err = p.selectors(ctx, req.Match); err != nil {
+		return nil, err
+	}
+	return p.upstream.PostLabels(ctx, req)
+}
+
+// PostQuery implements promapi.Handler.
+func (p *Proxy) PostQuery(ctx context.Context, req *promapi.QueryForm) (*promapi.QueryResponse, error) {
+	var err error
+	if req.Query, err = p.query(ctx, req.Query); err != nil {
+		return nil, err
+	}
+	return p.upstream.PostQuery(ctx, req)
+}
+
+// PostQueryExemplars implements promapi.Handler.
+func (p *Proxy) PostQueryExemplars(ctx context.Context, req *promapi.ExemplarsForm) (*promapi.QueryExemplarsResponse, error) {
+	var err error
+	if req.Query, err = p.query(ctx, req.Query); err != nil {
+		return nil, err
+	}
+	return p.upstream.PostQueryExemplars(ctx, req)
+}
+
+// PostQueryRange implements promapi.Handler.
+func (p *Proxy) PostQueryRange(ctx context.Context, req *promapi.QueryRangeForm) (*promapi.QueryResponse, error) {
+	var err error
+	if req.Query, err = p.query(ctx, req.Query); err != nil {
+		return nil, err
+	}
+	return p.upstream.PostQueryRange(ctx, req)
+}
+
+// PostSeries implements promapi.Handler.
+func (p *Proxy) PostSeries(ctx context.Context, req *promapi.SeriesForm) (*promapi.SeriesResponse, error) {
+	var err error
+	if req.Match, err = p.selectors(ctx, req.Match); err != nil {
+		return nil, err
+	}
+	return p.upstream.PostSeries(ctx, req)
+}
+
+// NewError implements promapi.Handler.
+func (p *Proxy) NewError(ctx context.Context, err error) *promapi.FailStatusCode {
+	// Upstream and proxy errors are passed as is.
+	if v, ok := errors.Into[*promapi.FailStatusCode](err); ok {
+		return v
+	}
+	if v, ok := errors.Into[ogenerrors.Error](err); ok {
+		return fail(v.Code(), promapi.FailErrorTypeBadData, err.Error())
+	}
+	return fail(http.StatusInternalServerError, promapi.FailErrorTypeInternal, err.Error())
+}
@@ -0,0 +1,64 @@
+package promproxy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-faster/errors"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/go-faster/vega"
+	"github.com/go-faster/vega/internal/auth"
+	"github.com/go-faster/vega/internal/promapi"
+)
+
+func TestProxy(t *testing.T) {
+	var queries []string
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query().Get("query"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `{"status":"success","data":{"resultType":"vector","result":[]}}`)
+	}))
+	t.Cleanup(upstream.Close)
+
+	client, err := promapi.NewClient(upstream.URL)
+	require.NoError(t, err)
+	kube := fake.NewClientset(
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:        "team-a",
+			Annotations: map[string]string{vega.AnnotationGitLabGroups: "org/a"},
+		}},
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name: "kube-system",
+		}},
+	)
+	p := New(client, kube)
+
+	query := func(ctx context.Context) error {
+		_, err := p.GetQuery(ctx, promapi.GetQueryParams{Query: "up"})
+		return err
+	}
+	ctx := t.Context()
+
+	require.NoError(t, query(auth.WithUser(ctx, &auth.User{Name: "user", Groups: []string{"org"}})))
+	require.NoError(t, query(auth.WithUser(ctx, &auth.User{Name: "root", Admin: true})))
+	require.Equal(t, []string{`up{namespace="team-a"}`, `up`}, queries)
+
+	codeOf := func(err error) int {
+		v, ok := errors.Into[*promapi.FailStatusCode](err)
+		require.True(t, ok, "%v", err)
+		return v.StatusCode
+	}
+	require.Equal(t, http.StatusUnauthorized, codeOf(query(ctx)))
+	require.Equal(t, http.StatusForbidden, codeOf(query(auth.WithUser(ctx, &auth.User{Name: "stranger"}))))
+
+	_, err = p.GetRules(auth.WithUser(ctx, &auth.User{Name: "user", Groups: []string{"org"}}), promapi.GetRulesParams{})
+	require.Equal(t, http.StatusForbidden, codeOf(err))
+	require.Len(t, queries, 2, "upstream should not be called")
+}
@@ -0,0 +1,186 @@
+// Package redact masks sensitive data of L7 flows, like HTTP headers with
+// credentials and tokens in URL query, before flows are stored.
+package redact
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/cilium/cilium/api/v1/flow"
+	"github.com/go-faster/errors"
+)
+
+// Mask replaces redacted values.
+const Mask = "REDACTED"
+
+// DefaultDenyHeaders are headers that are redacted by default.
+var DefaultDenyHeaders = []string{
+	"Authorization",
+	"Proxy-Authorization",
+	"Cookie",
+	"Set-Cookie",
+	"X-Api-Key",
+	"X-Auth-Token",
+	"X-Csrf-Token",
+}
+
+// DefaultURLParams matches URL query parameters that are redacted by default.
+const DefaultURLParams = `(?i)^(.*token|.*secret|api_?key|key|password|passwd|pwd|signature|sig|code|auth|session|x-amz-.*)$`
+
+// Options of Redactor.
+type Options struct {
+	// AllowHeaders is list of headers to keep, other headers are redacted.
+	// All headers are kept if empty.
+	AllowHeaders []string
+	// DenyHeaders is list of headers to redact, even if allowed.
+	DenyHeaders []string
+	// URLParams matches names of URL query parameters to redact.
+	URLParams *regexp.Regexp
+}
+
+// Stats counts redacted values.
+type Stats struct {
+	Headers   int
+	URLParams int
+}
+
+// Total returns count of all redacted values.
+func (s Stats) Total() int {
+	return s.Headers + s.URLParams
+}
+
+// Redactor masks sensitive data of flows.
+type Redactor struct {
+	allow     map[string]struct{}
+	deny      map[string]struct{}
+	urlParams *regexp.Regexp
+}
+
+func headerSet(headers []string) map[string]struct{} {
+	if len(headers) == 0 {
+		return nil
+	}
+	out := make(map[string]struct{}, len(headers))
+	for _, h := range headers {
+		out[strings.ToLower(strings.TrimSpace(h))] = struct{}{}
+	}
+	return out
+}
+
+// New creates Redactor.
+func New(opt Options) *Redactor {
+	return &Redactor{
+		allow:     headerSet(opt.AllowHeaders),
+		deny:      headerSet(opt.DenyHeaders),
+		urlParams: opt.URLParams,
+	}
+}
+
+// ParseOptions parses comma-separated header lists and URL parameter
+// regular expression, using defaults for empty deny list and expression.
+func ParseOptions(allowHeaders, denyHeaders, urlParams string) (Options, error) {
+	split := func(s string) []string {
+		var out []string
+		for _, v := range strings.Split(s, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				out = append(out, v)
+			}
+		}
+		return out
+	}
+	opt := Options{
+		AllowHeaders: split(allowHeaders),
+		DenyHeaders:  split(denyHeaders),
+	}
+	if len(opt.DenyHeaders) == 0 {
+		opt.DenyHeaders = DefaultDenyHeaders
+	}
+	if urlParams == "" {
+		urlParams = DefaultURLParams
+	}
+	re, err := regexp.Compile(urlParams)
+	if err != nil {
+		return opt, errors.Wrap(err, "url params")
+	}
+	opt.URLParams = re
+	return opt, nil
+}
+
+func (r *Redactor) redactHeader(key string) bool {
+	key = strings.ToLower(key)
+	if _, ok := r.deny[key]; ok {
+		return true
+	}
+	if r.allow == nil {
+		return false
+	}
+	_, ok := r.allow[key]
+	return !ok
+}
+
+// URL returns u with masked user password and query parameters, and count
+// of masked values.
+//
+// Order and encoding of other query parameters are preserved.
+func (r *Redactor) URL(u string) (string, int) {
+	var n int
+	if parsed, err := url.Parse(u); err == nil && parsed.User != nil {
+		if _, ok := parsed.User.Password(); ok {
+			parsed.User = url.UserPassword(parsed.User.Username(), Mask)
+			u = parsed.String()
+			n++
+		}
+	}
+	if r.urlParams == nil {
+		return u, n
+	}
+	base, query, ok := strings.Cut(u, "?")
+	if !ok || query == "" {
+		return u, n
+	}
+	query, fragment, hasFragment := strings.Cut(query, "#")
+	params := strings.Split(query, "&")
+	for i, p := range params {
+		key, _, _ := strings.Cut(p, "=")
+		name, err := url.QueryUnescape(key)
+		if err != nil {
+			name = key
+		}
+		if name == "" || !r.urlParams.MatchString(name) {
+			continue
+		}
+		params[i] = key + "=" + Mask
+		n++
+	}
+	if n == 0 {
+		return u, 0
+	}
+	out := base + "?" + strings.Join(params, "&")
+	if hasFragment {
+		out += "#" + fragment
+	}
+	return out, n
+}
+
+// HTTP masks values of redacted headers and sensitive parts of URL in place.
+func (r *Redactor) HTTP(h *flow.HTTP) Stats {
+	var s Stats
+	if h == nil {
+		return s
+	}
+	for _, header := range h.GetHeaders() {
+		if header.GetValue() == "" || header.GetValue() == Mask || !r.redactHeader(header.GetKey()) {
+			continue
+		}
+		header.Value = Mask
+		s.Headers++
+	}
+	h.Url, s.URLParams = r.URL(h.GetUrl())
+	return s
+}
+
+// Flow redacts L7 record of flow in place.
+func (r *Redactor) Flow(f *flow.Flow) Stats {
+	return r.HTTP(f.GetL7().GetHttp())
+}
@@ -0,0 +1,40 @@
+package route
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ClickHouse/ch-go"
+	"github.com/ClickHouse/ch-go/cht"
+	"github.com/ClickHouse/ch-go/proto"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/go-faster/vega/internal/flow"
+)
+
+func TestIntegrationClickHouseSQL(t *testing.T) {
+	cht.Skip(t)
+	s := cht.New(t)
+	ctx := context.Background()
+	c, err := ch.Dial(ctx, ch.Options{
+		Address: s.TCP,
+		Logger:  zaptest.NewLogger(t),
+	})
+	require.NoError(t, err)
+
+	for _, u := range []string{
+		"http://api.shop/",
+		"http://api.shop/v1/users/42/orders/7?expand=true",
+		"http://api.shop/v1/orders/3F2504E0-4F89-11D3-9A0C-0305E82C3301",
+		"http://api.shop/v1/objects/507f1f77bcf86cd799439011/",
+		"http://api.shop/v2/cafe",
+	} {
+		var out proto.ColStr
+		require.NoError(t, c.Do(ctx, ch.Query{
+			Body:   "SELECT " + SQL(flow.Quote(u)) + " AS route",
+			Result: proto.Results{{Name: "route", Data: &out}},
+		}))
+		require.Equal(t, Normalize(u), out.Row(0), u)
+	}
+}
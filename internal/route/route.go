@@ -0,0 +1,85 @@
+// Package route normalises HTTP URLs to routes, collapsing identifiers in
+// path segments, so requests to the same endpoint can be aggregated.
+package route
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/go-faster/vega/internal/flow"
+)
+
+// Placeholders of collapsed path segments.
+const (
+	UUID = "{uuid}"
+	ID   = "{id}"
+)
+
+// rule replaces whole path segment matching pattern with placeholder.
+type rule struct {
+	pattern     string
+	placeholder string
+	re          *regexp.Regexp
+}
+
+func newRule(pattern, placeholder string) rule {
+	return rule{
+		pattern:     pattern,
+		placeholder: placeholder,
+		re:          regexp.MustCompile(pattern),
+	}
+}
+
+// rules are applied in order, first match wins.
+//
+// Patterns are RE2, so ClickHouse evaluates them in the same way, see SQL.
+var rules = []rule{
+	newRule(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`, UUID),
+	newRule(`^[0-9]+$`, ID),
+	// Hashes and object ids, like 24-char MongoDB ObjectID.
+	newRule(`^[0-9a-fA-F]{16,}$`, ID),
+}
+
+// Path returns normalised route of URL path.
+func Path(p string) string {
+	if p == "" {
+		return "/"
+	}
+	segments := strings.Split(p, "/")
+	for i, s := range segments {
+		for _, r := range rules {
+			if r.re.MatchString(s) {
+				segments[i] = r.placeholder
+				break
+			}
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// Normalize returns normalised route of URL, without scheme, host and query.
+func Normalize(u string) string {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		// Strip query manually.
+		p, _, _ := strings.Cut(u, "?")
+		return Path(p)
+	}
+	return Path(parsed.Path)
+}
+
+// SQL returns ClickHouse expression of normalised route of URL column,
+// equivalent to Normalize.
+func SQL(column string) string {
+	var b strings.Builder
+	b.WriteString("multiIf(")
+	for _, r := range rules {
+		fmt.Fprintf(&b, "match(s, %s), %s, ", flow.Quote(r.pattern), flow.Quote(r.placeholder))
+	}
+	b.WriteString("s)")
+	return fmt.Sprintf("if(path(%[1]s) = '', '/', arrayStringConcat(arrayMap(s -> %[2]s, splitByChar('/', path(%[1]s))), '/'))",
+		column, b.String(),
+	)
+}
@@ -0,0 +1,38 @@
+package route
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalize(t *testing.T) {
+	for _, tt := range []struct {
+		Input  string
+		Output string
+	}{
+		{"", "/"},
+		{"/", "/"},
+		{"http://api.shop/", "/"},
+		{"http://api.shop", "/"},
+		{"http://api.shop/v1/users/42", "/v1/users/{id}"},
+		{"http://api.shop/v1/users/42/orders/7?expand=true", "/v1/users/{id}/orders/{id}"},
+		{"http://api.shop/v1/orders/3F2504E0-4F89-11D3-9A0C-0305E82C3301", "/v1/orders/{uuid}"},
+		{"http://api.shop/v1/objects/507f1f77bcf86cd799439011/", "/v1/objects/{id}/"},
+		{"http://api.shop/v1/users/me", "/v1/users/me"},
+		{"http://api.shop/v2/cafe", "/v2/cafe"},
+		{"/metrics", "/metrics"},
+	} {
+		t.Run(tt.Input, func(t *testing.T) {
+			require.Equal(t, tt.Output, Normalize(tt.Input))
+		})
+	}
+}
+
+func TestSQL(t *testing.T) {
+	const expected = `if(path(url) = '', '/', arrayStringConcat(arrayMap(s -> multiIf(` +
+		`match(s, '^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$'), '{uuid}', ` +
+		`match(s, '^[0-9]+$'), '{id}', ` +
+		`match(s, '^[0-9a-fA-F]{16,}$'), '{id}', s), splitByChar('/', path(url))), '/'))`
+	require.Equal(t, expected, SQL("url"))
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
 	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-faster/vega/internal/chtest"
 )
 
 func TestTable_ResultColumns(t *testing.T) {
@@ -27,6 +29,41 @@ func TestTable_ResultColumns(t *testing.T) {
 	}
 }
 
+func TestNewMigrations(t *testing.T) {
+	chtest.RequireMigrations(t, NewDDL("sec"), NewMigrations("sec"))
+}
+
+func TestTable_Kprobe(t *testing.T) {
+	d := NewTable("sec")
+	require.NoError(t, d.Append(Row{
+		Res: &tetragon.GetEventsResponse{
+			Event: &tetragon.GetEventsResponse_ProcessKprobe{
+				ProcessKprobe: &tetragon.ProcessKprobe{
+					Process:      &tetragon.Process{ExecId: "exec-id"},
+					FunctionName: "tcp_connect",
+					Args: []*tetragon.KprobeArgument{
+						{Arg: &tetragon.KprobeArgument_SockArg{SockArg: &tetragon.KprobeSock{
+							Daddr:  "10.0.0.2",
+							Dport:  5432,
+							Cookie: 42,
+						}}},
+					},
+				},
+			},
+			Time: timestamppb.Now(),
+		},
+	}))
+	require.Equal(t, 1, d.Rows())
+	require.Equal(t, "tcp_connect", d.functionName.Row(0))
+	require.Equal(t, "10.0.0.2", d.sockDaddr.Row(0))
+	require.Equal(t, uint32(5432), d.sockDport.Row(0))
+	require.Equal(t, uint64(42), d.sockCookie.Row(0))
+	require.Equal(t, "exec-id", d.process.processExecID.Row(0))
+	for _, c := range d.Columns() {
+		require.Equal(t, 1, c.Data.Rows(), c.Name)
+	}
+}
+
 func TestIntegrationClickHouseColumns(t *testing.T) {
 	cht.Skip(t)
 	s := cht.New(t)
@@ -35,6 +35,18 @@ CREATE TABLE IF NOT EXISTS %s
       'ProcessTracepoint'= 10,
       'ProcessLoader'    = 11
     ),
+    -- exit status and signal, only for ProcessExit
+    exit_status UInt32,
+    exit_signal LowCardinality(String),
+
+    -- kprobe function and socket argument, only for ProcessKprobe,
+    -- e.g. tcp_connect
+    function_name LowCardinality(String),
+    sock_saddr    String,
+    sock_daddr    String,
+    sock_sport    UInt32,
+    sock_dport    UInt32,
+    sock_cookie   UInt64,
 
     -- processes fields (0 - process, 1 - parent, 2..N - other ancestors, only for ProcessExec)
     process_exec_id        String,
@@ -75,6 +87,20 @@ CREATE TABLE IF NOT EXISTS %s
 // DDL for ClickHouse table.
 var DDL = NewDDL("sec")
 
+// NewMigrations returns queries that upgrade table created by previous DDL.
+func NewMigrations(tableName string) []string {
+	return []string{
+		fmt.Sprintf("ALTER TABLE %s ADD COLUMN IF NOT EXISTS exit_status UInt32 AFTER event_type", tableName),
+		fmt.Sprintf("ALTER TABLE %s ADD COLUMN IF NOT EXISTS exit_signal LowCardinality(String) AFTER exit_status", tableName),
+		fmt.Sprintf("ALTER TABLE %s ADD COLUMN IF NOT EXISTS function_name LowCardinality(String) AFTER exit_signal", tableName),
+		fmt.Sprintf("ALTER TABLE %s ADD COLUMN IF NOT EXISTS sock_saddr String AFTER function_name", tableName),
+		fmt.Sprintf("ALTER TABLE %s ADD COLUMN IF NOT EXISTS sock_daddr String AFTER sock_saddr", tableName),
+		fmt.Sprintf("ALTER TABLE %s ADD COLUMN IF NOT EXISTS sock_sport UInt32 AFTER sock_daddr", tableName),
+		fmt.Sprintf("ALTER TABLE %s ADD COLUMN IF NOT EXISTS sock_dport UInt32 AFTER sock_sport", tableName),
+		fmt.Sprintf("ALTER TABLE %s ADD COLUMN IF NOT EXISTS sock_cookie UInt64 AFTER sock_dport", tableName),
+	}
+}
+
 type Process struct {
 	prefix string
 
@@ -180,6 +206,16 @@ type Table struct {
 	node      proto.ColLowCardinality[string]
 	eventType proto.ColEnum
 
+	exitStatus proto.ColUInt32
+	exitSignal proto.ColLowCardinality[string]
+
+	functionName proto.ColLowCardinality[string]
+	sockSaddr    proto.ColStr
+	sockDaddr    proto.ColStr
+	sockSport    proto.ColUInt32
+	sockDport    proto.ColUInt32
+	sockCookie   proto.ColUInt64
+
 	k8sPod       proto.ColLowCardinality[string]
 	k8sNS        proto.ColLowCardinality[string]
 	k8sContainer proto.ColLowCardinality[string]
@@ -229,6 +265,16 @@ func (t *Table) Columns() []Column {
 	c := []Column{
 		{Name: "timestamp", Data: &t.timestamp},
 		{Name: "node_name", Data: &t.node},
+		{Name: "event_type", Data: &t.eventType},
+		{Name: "exit_status", Data: &t.exitStatus},
+		{Name: "exit_signal", Data: &t.exitSignal},
+
+		{Name: "function_name", Data: &t.functionName},
+		{Name: "sock_saddr", Data: &t.sockSaddr},
+		{Name: "sock_daddr", Data: &t.sockDaddr},
+		{Name: "sock_sport", Data: &t.sockSport},
+		{Name: "sock_dport", Data: &t.sockDport},
+		{Name: "sock_cookie", Data: &t.sockCookie},
 
 		{Name: "k8s_pod", Data: &t.k8sPod},
 		{Name: "k8s_ns", Data: &t.k8sNS},
@@ -265,41 +311,73 @@ func (t *Table) Each(f func(row Row) error) error {
 	return nil
 }
 
+// kprobeSock returns first socket argument of kprobe, if any.
+func kprobeSock(e *tetragon.ProcessKprobe) *tetragon.KprobeSock {
+	for _, arg := range e.GetArgs() {
+		if sock := arg.GetSockArg(); sock != nil {
+			return sock
+		}
+	}
+	return nil
+}
+
+func (t *Table) appendPod(pod *tetragon.Pod) {
+	t.k8sNS.Append(pod.GetNamespace())
+	t.k8sPod.Append(pod.GetName())
+	t.k8sContainer.Append(pod.GetContainer().GetName())
+	t.k8sImage.Append(pod.GetContainer().GetImage().GetId())
+}
+
+func (t *Table) appendKprobe(function string, sock *tetragon.KprobeSock) {
+	t.functionName.Append(function)
+	t.sockSaddr.Append(sock.GetSaddr())
+	t.sockDaddr.Append(sock.GetDaddr())
+	t.sockSport.Append(sock.GetSport())
+	t.sockDport.Append(sock.GetDport())
+	t.sockCookie.Append(sock.GetCookie())
+}
+
 func (t *Table) Append(row Row) error {
 	r := row.Res
 
 	switch v := r.Event.(type) {
 	case *tetragon.GetEventsResponse_ProcessExec:
 		e := v.ProcessExec
-		t.process.Append(e.GetProcess())
-		t.parent.Append(e.GetParent())
-
 		data, err := json.Marshal(e.GetAncestors())
 		if err != nil {
 			return errors.Wrap(err, "marshal ancestors")
 		}
-		t.ancestors.AppendBytes(data)
 
-		pod := e.GetProcess().GetPod()
+		t.process.Append(e.GetProcess())
+		t.parent.Append(e.GetParent())
+		t.appendPod(e.GetProcess().GetPod())
 
-		t.k8sNS.Append(pod.GetNamespace())
-		t.k8sPod.Append(pod.GetName())
-		t.k8sContainer.Append(pod.GetContainer().GetName())
-		t.k8sImage.Append(pod.GetContainer().GetImage().GetId())
+		t.ancestors.AppendBytes(data)
+		t.exitStatus.Append(0)
+		t.exitSignal.Append("")
+		t.appendKprobe("", nil)
 
 	case *tetragon.GetEventsResponse_ProcessExit:
 		e := v.ProcessExit
 		t.process.Append(e.GetProcess())
 		t.parent.Append(e.GetParent())
+		t.appendPod(e.GetProcess().GetPod())
 
-		pod := e.GetProcess().GetPod()
+		t.ancestors.Append("null")
+		t.exitStatus.Append(e.GetStatus())
+		t.exitSignal.Append(e.GetSignal())
+		t.appendKprobe("", nil)
 
-		t.k8sNS.Append(pod.GetNamespace())
-		t.k8sPod.Append(pod.GetName())
-		t.k8sContainer.Append(pod.GetContainer().GetName())
-		t.k8sImage.Append(pod.GetContainer().GetImage().GetId())
+	case *tetragon.GetEventsResponse_ProcessKprobe:
+		e := v.ProcessKprobe
+		t.process.Append(e.GetProcess())
+		t.parent.Append(e.GetParent())
+		t.appendPod(e.GetProcess().GetPod())
 
 		t.ancestors.Append("null")
+		t.exitStatus.Append(0)
+		t.exitSignal.Append("")
+		t.appendKprobe(e.GetFunctionName(), kprobeSock(e))
 	default:
 		return errors.Errorf("unknown event type: %T", r.Event)
 	}
@@ -329,6 +407,9 @@ func NewTable(name string) *Table {
 		k8sContainer: newStrLowCardinality(),
 		k8sImage:     newStrLowCardinality(),
 
+		exitSignal:   newStrLowCardinality(),
+		functionName: newStrLowCardinality(),
+
 		process: NewProcess(""),
 		parent:  NewProcess("parent_"),
 	}
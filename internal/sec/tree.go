@@ -0,0 +1,142 @@
+package sec
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// EventType is type of process lifecycle event.
+type EventType string
+
+// Event types used to build process tree.
+const (
+	EventExec EventType = "ProcessExec"
+	EventExit EventType = "ProcessExit"
+)
+
+// ProcessInfo describes process of event.
+type ProcessInfo struct {
+	ExecID       string
+	ParentExecID string
+	PID          uint32
+	UID          uint32
+	Binary       string
+	Args         string
+	Cwd          string
+	Start        time.Time
+}
+
+// Event is process execution or exit, as stored in process_* and
+// parent_process_* columns.
+type Event struct {
+	Type      EventType
+	Time      time.Time
+	Container string
+	Process   ProcessInfo
+	Parent    ProcessInfo
+
+	// ExitStatus and ExitSignal are set for EventExit.
+	ExitStatus uint32
+	ExitSignal string
+}
+
+// Node of process tree.
+type Node struct {
+	ProcessInfo
+	Container string
+
+	// Exited is set if exit of process was observed.
+	Exited     bool
+	Exit       time.Time
+	ExitStatus uint32
+	ExitSignal string
+
+	// Partial is set if process is known only as parent of other process,
+	// e.g. it was started before the window.
+	Partial bool
+
+	Children []*Node
+}
+
+// Duration of process, until now if it was not exited.
+func (n *Node) Duration(now time.Time) time.Duration {
+	if n.Start.IsZero() {
+		return 0
+	}
+	end := now
+	if n.Exited {
+		end = n.Exit
+	}
+	if end.Before(n.Start) {
+		return 0
+	}
+	return end.Sub(n.Start)
+}
+
+// BuildTree reconstructs process trees from exec and exit events of single
+// pod. Events may be in any order.
+//
+// Parents that have no events are added as partial nodes, so every process
+// with known parent is attached to it. Roots and children are sorted by start
+// time.
+func BuildTree(events []Event) []*Node {
+	nodes := make(map[string]*Node, len(events))
+	get := func(p ProcessInfo, partial bool) *Node {
+		n, ok := nodes[p.ExecID]
+		if !ok {
+			n = &Node{ProcessInfo: p, Partial: partial}
+			nodes[p.ExecID] = n
+			return n
+		}
+		if n.Partial && !partial {
+			n.ProcessInfo = p
+			n.Partial = false
+		}
+		return n
+	}
+	for _, e := range events {
+		if e.Process.ExecID == "" {
+			continue
+		}
+		n := get(e.Process, false)
+		if e.Container != "" {
+			n.Container = e.Container
+		}
+		if e.Type == EventExit {
+			n.Exited = true
+			n.Exit = e.Time
+			n.ExitStatus = e.ExitStatus
+			n.ExitSignal = e.ExitSignal
+		}
+		if e.Parent.ExecID != "" && e.Parent.ExecID != e.Process.ExecID {
+			get(e.Parent, true)
+		}
+	}
+
+	var roots []*Node
+	for id, n := range nodes {
+		parent, ok := nodes[n.ParentExecID]
+		if !ok || n.ParentExecID == id {
+			roots = append(roots, n)
+			continue
+		}
+		parent.Children = append(parent.Children, n)
+	}
+	for _, n := range nodes {
+		sortNodes(n.Children)
+	}
+	sortNodes(roots)
+
+	return roots
+}
+
+func sortNodes(nodes []*Node) {
+	slices.SortFunc(nodes, func(a, b *Node) int {
+		return cmp.Or(
+			a.Start.Compare(b.Start),
+			cmp.Compare(a.PID, b.PID),
+			cmp.Compare(a.ExecID, b.ExecID),
+		)
+	})
+}
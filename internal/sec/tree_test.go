@@ -0,0 +1,82 @@
+package sec
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildTree(t *testing.T) {
+	start := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	var (
+		// Container entrypoint, started before window.
+		api = ProcessInfo{ExecID: "api", ParentExecID: "containerd", PID: 1, Binary: "/usr/bin/api", Start: start}
+		sh  = ProcessInfo{ExecID: "sh", ParentExecID: "api", PID: 10, Binary: "/bin/sh", Args: "-c ls", Start: start.Add(time.Second)}
+		ls  = ProcessInfo{ExecID: "ls", ParentExecID: "sh", PID: 11, Binary: "/bin/ls", Start: start.Add(time.Second + time.Millisecond)}
+		// Short-lived process with only exit observed.
+		curl = ProcessInfo{ExecID: "curl", ParentExecID: "api", PID: 5, Binary: "/usr/bin/curl", Start: start.Add(500 * time.Millisecond)}
+	)
+	events := []Event{
+		{Type: EventExit, Time: ls.Start.Add(3 * time.Millisecond), Process: ls, Parent: sh, ExitStatus: 2},
+		{Type: EventExec, Time: sh.Start, Container: "api", Process: sh, Parent: api},
+		{Type: EventExec, Time: ls.Start, Container: "api", Process: ls, Parent: sh},
+		{Type: EventExit, Time: sh.Start.Add(5 * time.Millisecond), Process: sh, Parent: api, ExitSignal: "SIGKILL"},
+		{Type: EventExit, Time: curl.Start.Add(time.Second), Process: curl, Parent: api, ExitStatus: 0},
+	}
+
+	roots := BuildTree(events)
+	require.Len(t, roots, 1)
+
+	root := roots[0]
+	require.Equal(t, "api", root.ExecID)
+	require.True(t, root.Partial)
+	require.False(t, root.Exited)
+	require.Equal(t, time.Hour, root.Duration(start.Add(time.Hour)))
+	require.Len(t, root.Children, 2)
+
+	// Sorted by start time.
+	c := root.Children[0]
+	require.Equal(t, "curl", c.ExecID)
+	require.False(t, c.Partial)
+	require.True(t, c.Exited)
+	require.Equal(t, time.Second, c.Duration(start.Add(time.Hour)))
+	require.Empty(t, c.Children)
+
+	c = root.Children[1]
+	require.Equal(t, "sh", c.ExecID)
+	require.Equal(t, "api", c.Container)
+	require.True(t, c.Exited)
+	require.Equal(t, "SIGKILL", c.ExitSignal)
+	require.Equal(t, 5*time.Millisecond, c.Duration(start.Add(time.Hour)))
+	require.Len(t, c.Children, 1)
+
+	c = c.Children[0]
+	require.Equal(t, "ls", c.ExecID)
+	require.Equal(t, "-c ls", root.Children[1].Args)
+	require.Equal(t, uint32(2), c.ExitStatus)
+	require.Equal(t, 3*time.Millisecond, c.Duration(start.Add(time.Hour)))
+}
+
+func TestBuildTree_PartialUpgrade(t *testing.T) {
+	start := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	parent := ProcessInfo{ExecID: "p", PID: 1, Binary: "/bin/bash", Start: start}
+	child := ProcessInfo{ExecID: "c", ParentExecID: "p", PID: 2, Binary: "/bin/cat", Start: start.Add(time.Second)}
+
+	// Parent is first seen as partial, then its own exec is observed.
+	roots := BuildTree([]Event{
+		{Type: EventExec, Time: child.Start, Process: child, Parent: ProcessInfo{ExecID: "p"}},
+		{Type: EventExec, Time: parent.Start, Container: "shell", Process: parent},
+	})
+	require.Len(t, roots, 1)
+	require.False(t, roots[0].Partial)
+	require.Equal(t, "/bin/bash", roots[0].Binary)
+	require.Equal(t, "shell", roots[0].Container)
+	require.Len(t, roots[0].Children, 1)
+	require.Equal(t, "c", roots[0].Children[0].ExecID)
+}
+
+func TestBuildTree_Empty(t *testing.T) {
+	require.Empty(t, BuildTree(nil))
+	require.Empty(t, BuildTree([]Event{{Type: EventExec}}))
+}
@@ -3,3 +3,14 @@ package semconv
 
 // LabelVegaApp is the label used to identify the application in logs.
 const LabelVegaApp = "vega.app"
+
+// Application watch event names.
+const (
+	EventPodAdded   = "pod_added"
+	EventPodUpdated = "pod_updated"
+	EventPodDeleted = "pod_deleted"
+	EventResources  = "resources"
+)
+
+// EventLog is name of application log stream event.
+const EventLog = "log"
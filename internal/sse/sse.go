@@ -0,0 +1,153 @@
+// Package sse implements server-sent events encoding and decoding.
+package sse
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/go-faster/errors"
+)
+
+// ContentType of server-sent events stream.
+const ContentType = "text/event-stream"
+
+// Event is single server-sent event.
+type Event struct {
+	Name string
+	Data []byte
+}
+
+// Writer writes server-sent events to underlying writer.
+type Writer struct {
+	w   io.Writer
+	buf bytes.Buffer
+}
+
+// NewWriter initializes and returns new Writer.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+// Write writes single event.
+//
+// Event is written with one Write call to the underlying writer, so
+// it is safe to use with io.Pipe.
+func (s *Writer) Write(name string, data []byte) error {
+	s.buf.Reset()
+	if name != "" {
+		s.buf.WriteString("event: ")
+		s.buf.WriteString(name)
+		s.buf.WriteByte('\n')
+	}
+	for _, line := range bytes.Split(data, []byte{'\n'}) {
+		s.buf.WriteString("data: ")
+		s.buf.Write(line)
+		s.buf.WriteByte('\n')
+	}
+	s.buf.WriteByte('\n')
+	if _, err := s.w.Write(s.buf.Bytes()); err != nil {
+		return errors.Wrap(err, "write")
+	}
+	return nil
+}
+
+// Reader reads server-sent events.
+type Reader struct {
+	s *bufio.Scanner
+}
+
+// NewReader initializes and returns new Reader.
+func NewReader(r io.Reader) *Reader {
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	return &Reader{s: s}
+}
+
+// Next reads next event.
+//
+// Returns io.EOF if stream is finished.
+func (r *Reader) Next() (Event, error) {
+	var (
+		e       Event
+		hasData bool
+	)
+	for r.s.Scan() {
+		line := r.s.Text()
+		if line == "" {
+			if !hasData {
+				// Skip empty events, e.g. keep-alive.
+				e = Event{}
+				continue
+			}
+			return e, nil
+		}
+		if strings.HasPrefix(line, ":") {
+			// Comment.
+			continue
+		}
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+		switch field {
+		case "event":
+			e.Name = value
+		case "data":
+			if hasData {
+				e.Data = append(e.Data, '\n')
+			}
+			e.Data = append(e.Data, value...)
+			hasData = true
+		}
+	}
+	if err := r.s.Err(); err != nil {
+		return Event{}, errors.Wrap(err, "scan")
+	}
+	if hasData {
+		return e, nil
+	}
+	return Event{}, io.EOF
+}
+
+type flushWriter struct {
+	http.ResponseWriter
+	rc *http.ResponseController
+}
+
+func (w *flushWriter) stream() bool {
+	return strings.HasPrefix(w.Header().Get("Content-Type"), ContentType)
+}
+
+func (w *flushWriter) WriteHeader(code int) {
+	w.ResponseWriter.WriteHeader(code)
+	if w.stream() {
+		_ = w.rc.Flush()
+	}
+}
+
+func (w *flushWriter) Write(p []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(p)
+	if err != nil || !w.stream() {
+		return n, err
+	}
+	if err := w.rc.Flush(); err != nil {
+		return n, err
+	}
+	return n, nil
+}
+
+func (w *flushWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// FlushHandler wraps handler, flushing every write of server-sent events
+// response to the client.
+func FlushHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(&flushWriter{
+			ResponseWriter: w,
+			rc:             http.NewResponseController(w),
+		}, r)
+	})
+}
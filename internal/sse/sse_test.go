@@ -0,0 +1,44 @@
+package sse
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriterReader(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	require.NoError(t, w.Write("pod_added", []byte(`{"name":"a"}`)))
+	require.NoError(t, w.Write("", []byte("multi\nline")))
+	require.Equal(t, "event: pod_added\ndata: {\"name\":\"a\"}\n\ndata: multi\ndata: line\n\n", buf.String())
+
+	r := NewReader(strings.NewReader(": keep-alive\n\n" + buf.String()))
+	e, err := r.Next()
+	require.NoError(t, err)
+	require.Equal(t, Event{Name: "pod_added", Data: []byte(`{"name":"a"}`)}, e)
+
+	e, err = r.Next()
+	require.NoError(t, err)
+	require.Equal(t, Event{Data: []byte("multi\nline")}, e)
+
+	_, err = r.Next()
+	require.ErrorIs(t, err, io.EOF)
+}
+
+func TestFlushHandler(t *testing.T) {
+	h := FlushHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", ContentType)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("data: 1\n\n"))
+	}))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", http.NoBody))
+	require.True(t, rec.Flushed)
+	require.Equal(t, "data: 1\n\n", rec.Body.String())
+}
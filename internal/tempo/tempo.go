@@ -0,0 +1,78 @@
+// Package tempo implements minimal client for Tempo-compatible trace API.
+package tempo
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-faster/errors"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrNotFound is returned if trace is not found.
+var ErrNotFound = errors.New("trace not found")
+
+// maxTraceSize limits size of trace response.
+const maxTraceSize = 64 * 1024 * 1024
+
+// Client of Tempo HTTP API.
+type Client struct {
+	url  string
+	http *http.Client
+}
+
+// NewClient initializes new Client for Tempo at given URL.
+//
+// If client is nil, http.DefaultClient is used.
+func NewClient(tempoURL string, client *http.Client) *Client {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Client{
+		url:  strings.TrimRight(tempoURL, "/"),
+		http: client,
+	}
+}
+
+// Trace fetches trace by hex-encoded id.
+//
+// Trace is requested in protobuf encoding, which is wire-compatible with
+// OTLP TracesData.
+func (c *Client) Trace(ctx context.Context, traceID string) (*tracepb.TracesData, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url+"/api/traces/"+url.PathEscape(traceID), http.NoBody)
+	if err != nil {
+		return nil, errors.Wrap(err, "new request")
+	}
+	req.Header.Set("Accept", "application/protobuf")
+	res, err := c.http.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "do")
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, ErrNotFound
+	default:
+		data, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return nil, errors.Errorf("%s: %s", res.Status, strings.TrimSpace(string(data)))
+	}
+	data, err := io.ReadAll(io.LimitReader(res.Body, maxTraceSize))
+	if err != nil {
+		return nil, errors.Wrap(err, "read")
+	}
+	var out tracepb.TracesData
+	if err := proto.Unmarshal(data, &out); err != nil {
+		return nil, errors.Wrap(err, "unmarshal")
+	}
+	if len(out.ResourceSpans) == 0 {
+		return nil, ErrNotFound
+	}
+	return &out, nil
+}
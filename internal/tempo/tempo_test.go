@@ -0,0 +1,40 @@
+package tempo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestClient_Trace(t *testing.T) {
+	const traceID = "0af7651916cd43dd8448eb211c80319c"
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "application/protobuf", r.Header.Get("Accept"))
+		if r.URL.Path != "/api/traces/"+traceID {
+			http.NotFound(w, r)
+			return
+		}
+		data, err := proto.Marshal(&tracepb.TracesData{
+			ResourceSpans: []*tracepb.ResourceSpans{{
+				ScopeSpans: []*tracepb.ScopeSpans{{
+					Spans: []*tracepb.Span{{Name: "root"}},
+				}},
+			}},
+		})
+		require.NoError(t, err)
+		_, _ = w.Write(data)
+	}))
+	t.Cleanup(s.Close)
+
+	c := NewClient(s.URL, nil)
+	trace, err := c.Trace(t.Context(), traceID)
+	require.NoError(t, err)
+	require.Equal(t, "root", trace.ResourceSpans[0].ScopeSpans[0].Spans[0].Name)
+
+	_, err = c.Trace(t.Context(), "00000000000000000000000000000000")
+	require.ErrorIs(t, err, ErrNotFound)
+}
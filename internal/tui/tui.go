@@ -0,0 +1,265 @@
+// Package tui implements minimal full-screen terminal primitives.
+package tui
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/go-faster/errors"
+	"golang.org/x/term"
+)
+
+// Key kind.
+type Key int
+
+// Possible keys.
+const (
+	KeyRune Key = iota
+	KeyUp
+	KeyDown
+	KeyLeft
+	KeyRight
+	KeyPageUp
+	KeyPageDown
+	KeyHome
+	KeyEnd
+	KeyEnter
+	KeyTab
+	KeyBackspace
+	KeyEsc
+	KeyCtrlC
+)
+
+// Event is key press.
+type Event struct {
+	Key  Key
+	Rune rune // only for KeyRune
+}
+
+var escapes = []struct {
+	seq string
+	key Key
+}{
+	{"\x1b[A", KeyUp},
+	{"\x1b[B", KeyDown},
+	{"\x1b[C", KeyRight},
+	{"\x1b[D", KeyLeft},
+	{"\x1bOA", KeyUp},
+	{"\x1bOB", KeyDown},
+	{"\x1bOC", KeyRight},
+	{"\x1bOD", KeyLeft},
+	{"\x1b[5~", KeyPageUp},
+	{"\x1b[6~", KeyPageDown},
+	{"\x1b[H", KeyHome},
+	{"\x1b[F", KeyEnd},
+	{"\x1b[1~", KeyHome},
+	{"\x1b[4~", KeyEnd},
+}
+
+// ParseKeys parses raw terminal input to key events.
+func ParseKeys(b []byte) []Event {
+	var out []Event
+	for len(b) > 0 {
+		if b[0] == 0x1b {
+			matched := false
+			for _, e := range escapes {
+				if bytes.HasPrefix(b, []byte(e.seq)) {
+					out = append(out, Event{Key: e.key})
+					b = b[len(e.seq):]
+					matched = true
+					break
+				}
+			}
+			switch {
+			case matched:
+			case len(b) > 1 && b[1] == '[':
+				// Skip unsupported CSI sequence until final byte.
+				i := 2
+				for i < len(b) && (b[i] < 0x40 || b[i] > 0x7e) {
+					i++
+				}
+				b = b[min(i+1, len(b)):]
+			default:
+				out = append(out, Event{Key: KeyEsc})
+				b = b[1:]
+			}
+			continue
+		}
+		switch b[0] {
+		case '\r', '\n':
+			out = append(out, Event{Key: KeyEnter})
+		case '\t':
+			out = append(out, Event{Key: KeyTab})
+		case 0x7f, 0x08:
+			out = append(out, Event{Key: KeyBackspace})
+		case 0x03:
+			out = append(out, Event{Key: KeyCtrlC})
+		default:
+			r, size := utf8.DecodeRune(b)
+			out = append(out, Event{Key: KeyRune, Rune: r})
+			b = b[size:]
+			continue
+		}
+		b = b[1:]
+	}
+	return out
+}
+
+// Terminal is full-screen terminal in raw mode.
+type Terminal struct {
+	in    *os.File
+	out   *os.File
+	state *term.State
+	buf   bytes.Buffer
+}
+
+// Open switches terminal to raw mode and alternate screen.
+//
+// Call Close to restore terminal state.
+func Open(in, out *os.File) (*Terminal, error) {
+	if !term.IsTerminal(int(in.Fd())) {
+		return nil, errors.New("input is not a terminal")
+	}
+	state, err := term.MakeRaw(int(in.Fd()))
+	if err != nil {
+		return nil, errors.Wrap(err, "make raw")
+	}
+	t := &Terminal{
+		in:    in,
+		out:   out,
+		state: state,
+	}
+	// Enter alternate screen and hide cursor.
+	_, _ = t.out.WriteString("\x1b[?1049h\x1b[?25l")
+	return t, nil
+}
+
+// Close restores terminal state.
+func (t *Terminal) Close() error {
+	_, _ = t.out.WriteString("\x1b[?25h\x1b[?1049l")
+	return term.Restore(int(t.in.Fd()), t.state)
+}
+
+// Size returns terminal width and height.
+func (t *Terminal) Size() (width, height int) {
+	width, height, err := term.GetSize(int(t.out.Fd()))
+	if err != nil || width <= 0 || height <= 0 {
+		return 80, 24
+	}
+	return width, height
+}
+
+// Draw replaces screen content with lines.
+//
+// Lines are expected to fit terminal width, see Fit.
+func (t *Terminal) Draw(lines []string) error {
+	t.buf.Reset()
+	t.buf.WriteString("\x1b[H")
+	for i, line := range lines {
+		if i > 0 {
+			t.buf.WriteString("\r\n")
+		}
+		t.buf.WriteString(line)
+		t.buf.WriteString("\x1b[K")
+	}
+	t.buf.WriteString("\x1b[J")
+	_, err := t.out.Write(t.buf.Bytes())
+	return err
+}
+
+// Keys reads key events from terminal until context is done.
+func (t *Terminal) Keys(ctx context.Context) <-chan Event {
+	ch := make(chan Event)
+	go func() {
+		defer close(ch)
+		buf := make([]byte, 64)
+		for {
+			n, err := t.in.Read(buf)
+			if err != nil {
+				return
+			}
+			for _, e := range ParseKeys(buf[:n]) {
+				select {
+				case ch <- e:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return ch
+}
+
+// Fit truncates or pads s to exactly width runes.
+func Fit(s string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	n := utf8.RuneCountInString(s)
+	if n <= width {
+		return s + strings.Repeat(" ", width-n)
+	}
+	runes := []rune(s)
+	if width == 1 {
+		return string(runes[:1])
+	}
+	return string(runes[:width-1]) + "…"
+}
+
+// Reverse returns s in reverse video.
+func Reverse(s string) string {
+	return "\x1b[7m" + s + "\x1b[0m"
+}
+
+// Bold returns s in bold.
+func Bold(s string) string {
+	return "\x1b[1m" + s + "\x1b[0m"
+}
+
+// Red returns s in red.
+func Red(s string) string {
+	return "\x1b[31m" + s + "\x1b[0m"
+}
+
+var sparks = []rune("▁▂▃▄▅▆▇█")
+
+func finite(v float64) bool {
+	return !math.IsNaN(v) && !math.IsInf(v, 0)
+}
+
+// Sparkline renders last width values as sparkline.
+//
+// Non-finite values (NaN, ±Inf) are rendered as gaps.
+func Sparkline(values []float64, width int) string {
+	if width <= 0 || len(values) == 0 {
+		return ""
+	}
+	if len(values) > width {
+		values = values[len(values)-width:]
+	}
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, v := range values {
+		if !finite(v) {
+			continue
+		}
+		lo = min(lo, v)
+		hi = max(hi, v)
+	}
+	var b strings.Builder
+	for _, v := range values {
+		if !finite(v) {
+			b.WriteByte(' ')
+			continue
+		}
+		idx := 0
+		if hi > lo {
+			idx = int((v - lo) / (hi - lo) * float64(len(sparks)-1))
+		}
+		b.WriteRune(sparks[idx])
+	}
+	return b.String()
+}
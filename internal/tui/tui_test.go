@@ -0,0 +1,39 @@
+package tui
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseKeys(t *testing.T) {
+	for _, tt := range []struct {
+		Input string
+		Out   []Event
+	}{
+		{"q", []Event{{Key: KeyRune, Rune: 'q'}}},
+		{"\x1b[A\x1b[B", []Event{{Key: KeyUp}, {Key: KeyDown}}},
+		{"\x1b", []Event{{Key: KeyEsc}}},
+		{"\x1b[1;5Cj", []Event{{Key: KeyRune, Rune: 'j'}}},
+		{"\r\t\x7f\x03", []Event{{Key: KeyEnter}, {Key: KeyTab}, {Key: KeyBackspace}, {Key: KeyCtrlC}}},
+		{"\x1b[5~ж", []Event{{Key: KeyPageUp}, {Key: KeyRune, Rune: 'ж'}}},
+	} {
+		require.Equal(t, tt.Out, ParseKeys([]byte(tt.Input)), "%q", tt.Input)
+	}
+}
+
+func TestFit(t *testing.T) {
+	require.Equal(t, "ab  ", Fit("ab", 4))
+	require.Equal(t, "abc…", Fit("abcdef", 4))
+	require.Equal(t, "", Fit("abc", 0))
+}
+
+func TestSparkline(t *testing.T) {
+	require.Equal(t, "▁▄█", Sparkline([]float64{0, 1, 2}, 10))
+	require.Equal(t, "▁█", Sparkline([]float64{0, 1, 2}, 2))
+	require.Equal(t, "▁▁", Sparkline([]float64{5, 5}, 2))
+	require.Equal(t, "", Sparkline(nil, 2))
+	require.Equal(t, "▁ █ ", Sparkline([]float64{0, math.NaN(), 2, math.Inf(1)}, 4))
+	require.Equal(t, "  ", Sparkline([]float64{math.NaN(), math.Inf(-1)}, 2))
+}
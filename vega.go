@@ -65,6 +65,7 @@ const (
 	AnnotationGitLabURL         = PlatformPrefix + "gitlab.url"
 	AnnotationGitLabInternalURL = PlatformPrefix + "gitlab.internal.url"
 	AnnotationGitLabName        = PlatformPrefix + "gitlab.name"
+	AnnotationGitLabGroups      = PlatformPrefix + "gitlab.groups" // on namespace, comma-separated group paths with access
 
 	AnnotationRemoteName       = PlatformPrefix + "remote.name"
 	AnnotationRemoteRegion     = PlatformPrefix + "remote.region"
@@ -94,6 +95,12 @@ const (
 
 	EnvAdminList = "VEGA_ADMIN_LIST"
 
+	EnvOIDCIssuer       = "VEGA_OIDC_ISSUER"
+	EnvOIDCDiscoveryURL = "VEGA_OIDC_DISCOVERY_URL" // if issuer is not reachable directly
+	EnvOIDCClientID     = "VEGA_OIDC_CLIENT_ID"
+	EnvOIDCCLIClientID  = "VEGA_OIDC_CLI_CLIENT_ID" // public application of v login
+	EnvAuthDisabled     = "VEGA_AUTH_DISABLED" // for local development only
+
 	EnvClickHouseCA   = "VEGA_CLICKHOUSE_CA"          // issuing CA
 	EnvClickHouseCert = "VEGA_CLICKHOUSE_CERT"        // certificate file
 	EnvClickHouseKey  = "VEGA_CLICKHOUSE_PRIVATE_KEY" // private key file